@@ -0,0 +1,443 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codeenginev2
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/IBM/code-engine-go-sdk/codeenginev2/internal/jsondiff"
+	common "github.com/IBM/code-engine-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// applyCreateAttempts is the number of times an Apply* helper tries to create a resource before it gives up on a
+// resource that is concurrently created and deleted by other clients.
+const applyCreateAttempts = 5
+
+// applier holds the operations of a resource kind that are used by apply.
+type applier[T any] struct {
+	// Reads the live resource and its entity tag.
+	get func(ctx context.Context) (live *T, entityTag string, err error)
+
+	// Creates the resource from the prototype.
+	create func(ctx context.Context) (*T, error)
+
+	// Returns the modification that makes the live resource match the prototype, or nil if it already matches.
+	diff func(live *T) (map[string]interface{}, error)
+
+	// Applies the modification to the resource with the entity tag.
+	update func(ctx context.Context, entityTag string, modification map[string]interface{}) (*T, error)
+}
+
+// apply creates the resource if it does not exist, or updates it if it differs from the prototype. A conflict on
+// create, which means that the resource has been created concurrently, is retried with the fresh resource. A failed
+// precondition on update, which means that the resource has been modified concurrently, is retried with the fresh
+// resource after a bounded exponential backoff.
+func (applier *applier[T]) apply(ctx context.Context) (result *T, changed bool, err error) {
+	err = retryPreconditionFailed(ctx, func(ctx context.Context) error {
+		var err error
+		result, changed, err = applier.applyOnce(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	return
+}
+
+// applyOnce reads the resource and creates or updates it once.
+func (applier *applier[T]) applyOnce(ctx context.Context) (result *T, changed bool, err error) {
+	for attempt := 1; ; attempt++ {
+		var live *T
+		var entityTag string
+		live, entityTag, err = applier.get(ctx)
+		if IsNotFound(err) {
+			result, err = applier.create(ctx)
+			if IsConflict(err) && attempt < applyCreateAttempts {
+				continue
+			}
+			if err != nil {
+				err = core.RepurposeSDKProblem(err, "apply-create-error")
+				return
+			}
+			return result, true, nil
+		}
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "apply-get-error")
+			return
+		}
+
+		var modification map[string]interface{}
+		modification, err = applier.diff(live)
+		if err != nil {
+			err = core.SDKErrorf(err, "", "apply-diff-error", common.GetComponentInfo())
+			return
+		}
+		if len(modification) == 0 {
+			return live, false, nil
+		}
+
+		result, err = applier.update(ctx, entityTag, modification)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "apply-update-error")
+			return
+		}
+		return result, true, nil
+	}
+}
+
+// ApplyApp : Create or update an application
+// Create the application of the prototype if it does not exist yet. Otherwise, patch the fields of the application
+// that are set in the prototype and differ from the live application, using its entity tag. If the application is
+// modified concurrently, the patch is recomputed and retried. Fields that are not set in the prototype are left
+// unchanged. The result reports whether the application has been created or updated.
+func (codeEngine *CodeEngineV2) ApplyApp(ctx context.Context, createAppOptions *CreateAppOptions) (result *App, changed bool, err error) {
+	err = validateApplyOptions(createAppOptions, "createAppOptions")
+	if err != nil {
+		return
+	}
+	options := *createAppOptions
+	options.ProjectID, err = codeEngine.resolveProjectIDPtr(ctx, options.ProjectID)
+	if err != nil {
+		return
+	}
+	applier := &applier[App]{
+		get: func(ctx context.Context) (*App, string, error) {
+			getAppOptions := codeEngine.NewGetAppOptions(*options.ProjectID, *options.Name)
+			getAppOptions.Headers = options.Headers
+			app, _, err := codeEngine.GetAppWithContext(ctx, getAppOptions)
+			if err != nil {
+				return nil, "", err
+			}
+			return app, core.StringNilMapper(app.EntityTag), nil
+		},
+		create: func(ctx context.Context) (*App, error) {
+			app, _, err := codeEngine.CreateAppWithContext(ctx, &options)
+			return app, err
+		},
+		diff: func(live *App) (map[string]interface{}, error) {
+			return patchOf(&options, live)
+		},
+		update: func(ctx context.Context, entityTag string, patch map[string]interface{}) (*App, error) {
+			updateAppOptions := codeEngine.NewUpdateAppOptions(*options.ProjectID, *options.Name, entityTag, patch)
+			updateAppOptions.Headers = options.Headers
+			app, _, err := codeEngine.UpdateAppWithContext(ctx, updateAppOptions)
+			return app, err
+		},
+	}
+	return applier.apply(ctx)
+}
+
+// ApplyJob : Create or update a job
+// Create the job of the prototype if it does not exist yet. Otherwise, patch the fields of the job that are set in
+// the prototype and differ from the live job, using its entity tag. If the job is modified concurrently, the patch is
+// recomputed and retried. Fields that are not set in the prototype are left unchanged. The result reports whether the
+// job has been created or updated.
+func (codeEngine *CodeEngineV2) ApplyJob(ctx context.Context, createJobOptions *CreateJobOptions) (result *Job, changed bool, err error) {
+	err = validateApplyOptions(createJobOptions, "createJobOptions")
+	if err != nil {
+		return
+	}
+	options := *createJobOptions
+	options.ProjectID, err = codeEngine.resolveProjectIDPtr(ctx, options.ProjectID)
+	if err != nil {
+		return
+	}
+	applier := &applier[Job]{
+		get: func(ctx context.Context) (*Job, string, error) {
+			getJobOptions := codeEngine.NewGetJobOptions(*options.ProjectID, *options.Name)
+			getJobOptions.Headers = options.Headers
+			job, _, err := codeEngine.GetJobWithContext(ctx, getJobOptions)
+			if err != nil {
+				return nil, "", err
+			}
+			return job, core.StringNilMapper(job.EntityTag), nil
+		},
+		create: func(ctx context.Context) (*Job, error) {
+			job, _, err := codeEngine.CreateJobWithContext(ctx, &options)
+			return job, err
+		},
+		diff: func(live *Job) (map[string]interface{}, error) {
+			return patchOf(&options, live)
+		},
+		update: func(ctx context.Context, entityTag string, patch map[string]interface{}) (*Job, error) {
+			updateJobOptions := codeEngine.NewUpdateJobOptions(*options.ProjectID, *options.Name, entityTag, patch)
+			updateJobOptions.Headers = options.Headers
+			job, _, err := codeEngine.UpdateJobWithContext(ctx, updateJobOptions)
+			return job, err
+		},
+	}
+	return applier.apply(ctx)
+}
+
+// ApplyFunction : Create or update a function
+// Create the function of the prototype if it does not exist yet. Otherwise, patch the fields of the function that are
+// set in the prototype and differ from the live function, using its entity tag. If the function is modified
+// concurrently, the patch is recomputed and retried. Fields that are not set in the prototype are left unchanged. The
+// result reports whether the function has been created or updated.
+func (codeEngine *CodeEngineV2) ApplyFunction(ctx context.Context, createFunctionOptions *CreateFunctionOptions) (result *Function, changed bool, err error) {
+	err = validateApplyOptions(createFunctionOptions, "createFunctionOptions")
+	if err != nil {
+		return
+	}
+	options := *createFunctionOptions
+	options.ProjectID, err = codeEngine.resolveProjectIDPtr(ctx, options.ProjectID)
+	if err != nil {
+		return
+	}
+	applier := &applier[Function]{
+		get: func(ctx context.Context) (*Function, string, error) {
+			getFunctionOptions := codeEngine.NewGetFunctionOptions(*options.ProjectID, *options.Name)
+			getFunctionOptions.Headers = options.Headers
+			function, _, err := codeEngine.GetFunctionWithContext(ctx, getFunctionOptions)
+			if err != nil {
+				return nil, "", err
+			}
+			return function, core.StringNilMapper(function.EntityTag), nil
+		},
+		create: func(ctx context.Context) (*Function, error) {
+			function, _, err := codeEngine.CreateFunctionWithContext(ctx, &options)
+			return function, err
+		},
+		diff: func(live *Function) (map[string]interface{}, error) {
+			return patchOf(&options, live)
+		},
+		update: func(ctx context.Context, entityTag string, patch map[string]interface{}) (*Function, error) {
+			updateFunctionOptions := codeEngine.NewUpdateFunctionOptions(*options.ProjectID, *options.Name, entityTag, patch)
+			updateFunctionOptions.Headers = options.Headers
+			function, _, err := codeEngine.UpdateFunctionWithContext(ctx, updateFunctionOptions)
+			return function, err
+		},
+	}
+	return applier.apply(ctx)
+}
+
+// ApplyBuild : Create or update a build
+// Create the build of the prototype if it does not exist yet. Otherwise, patch the fields of the build that are set in
+// the prototype and differ from the live build, using its entity tag. If the build is modified concurrently, the patch
+// is recomputed and retried. Fields that are not set in the prototype are left unchanged. The result reports whether
+// the build has been created or updated.
+func (codeEngine *CodeEngineV2) ApplyBuild(ctx context.Context, createBuildOptions *CreateBuildOptions) (result *Build, changed bool, err error) {
+	err = validateApplyOptions(createBuildOptions, "createBuildOptions")
+	if err != nil {
+		return
+	}
+	options := *createBuildOptions
+	options.ProjectID, err = codeEngine.resolveProjectIDPtr(ctx, options.ProjectID)
+	if err != nil {
+		return
+	}
+	applier := &applier[Build]{
+		get: func(ctx context.Context) (*Build, string, error) {
+			getBuildOptions := codeEngine.NewGetBuildOptions(*options.ProjectID, *options.Name)
+			getBuildOptions.Headers = options.Headers
+			build, _, err := codeEngine.GetBuildWithContext(ctx, getBuildOptions)
+			if err != nil {
+				return nil, "", err
+			}
+			return build, core.StringNilMapper(build.EntityTag), nil
+		},
+		create: func(ctx context.Context) (*Build, error) {
+			build, _, err := codeEngine.CreateBuildWithContext(ctx, &options)
+			return build, err
+		},
+		diff: func(live *Build) (map[string]interface{}, error) {
+			return patchOf(&options, live)
+		},
+		update: func(ctx context.Context, entityTag string, patch map[string]interface{}) (*Build, error) {
+			updateBuildOptions := codeEngine.NewUpdateBuildOptions(*options.ProjectID, *options.Name, entityTag, patch)
+			updateBuildOptions.Headers = options.Headers
+			build, _, err := codeEngine.UpdateBuildWithContext(ctx, updateBuildOptions)
+			return build, err
+		},
+	}
+	return applier.apply(ctx)
+}
+
+// ApplyDomainMapping : Create or update a domain mapping
+// Create the domain mapping of the prototype if it does not exist yet. Otherwise, patch the fields of the domain
+// mapping that are set in the prototype and differ from the live domain mapping, using its entity tag. If the domain
+// mapping is modified concurrently, the patch is recomputed and retried. The result reports whether the domain mapping
+// has been created or updated.
+func (codeEngine *CodeEngineV2) ApplyDomainMapping(ctx context.Context, createDomainMappingOptions *CreateDomainMappingOptions) (result *DomainMapping, changed bool, err error) {
+	err = validateApplyOptions(createDomainMappingOptions, "createDomainMappingOptions")
+	if err != nil {
+		return
+	}
+	options := *createDomainMappingOptions
+	options.ProjectID, err = codeEngine.resolveProjectIDPtr(ctx, options.ProjectID)
+	if err != nil {
+		return
+	}
+	applier := &applier[DomainMapping]{
+		get: func(ctx context.Context) (*DomainMapping, string, error) {
+			getDomainMappingOptions := codeEngine.NewGetDomainMappingOptions(*options.ProjectID, *options.Name)
+			getDomainMappingOptions.Headers = options.Headers
+			domainMapping, _, err := codeEngine.GetDomainMappingWithContext(ctx, getDomainMappingOptions)
+			if err != nil {
+				return nil, "", err
+			}
+			return domainMapping, core.StringNilMapper(domainMapping.EntityTag), nil
+		},
+		create: func(ctx context.Context) (*DomainMapping, error) {
+			domainMapping, _, err := codeEngine.CreateDomainMappingWithContext(ctx, &options)
+			return domainMapping, err
+		},
+		diff: func(live *DomainMapping) (map[string]interface{}, error) {
+			return patchOf(&options, live)
+		},
+		update: func(ctx context.Context, entityTag string, patch map[string]interface{}) (*DomainMapping, error) {
+			updateDomainMappingOptions := codeEngine.NewUpdateDomainMappingOptions(*options.ProjectID, *options.Name, entityTag, patch)
+			updateDomainMappingOptions.Headers = options.Headers
+			domainMapping, _, err := codeEngine.UpdateDomainMappingWithContext(ctx, updateDomainMappingOptions)
+			return domainMapping, err
+		},
+	}
+	return applier.apply(ctx)
+}
+
+// ApplyConfigMap : Create or replace a config map
+// Create the config map of the prototype if it does not exist yet. Otherwise, replace the data of the config map with
+// the data of the prototype if they differ, using its entity tag. If the config map is modified concurrently, the
+// comparison is repeated and the replacement retried. The result reports whether the config map has been created or
+// replaced.
+func (codeEngine *CodeEngineV2) ApplyConfigMap(ctx context.Context, createConfigMapOptions *CreateConfigMapOptions) (result *ConfigMap, changed bool, err error) {
+	err = validateApplyOptions(createConfigMapOptions, "createConfigMapOptions")
+	if err != nil {
+		return
+	}
+	options := *createConfigMapOptions
+	options.ProjectID, err = codeEngine.resolveProjectIDPtr(ctx, options.ProjectID)
+	if err != nil {
+		return
+	}
+	applier := &applier[ConfigMap]{
+		get: func(ctx context.Context) (*ConfigMap, string, error) {
+			getConfigMapOptions := codeEngine.NewGetConfigMapOptions(*options.ProjectID, *options.Name)
+			getConfigMapOptions.Headers = options.Headers
+			configMap, _, err := codeEngine.GetConfigMapWithContext(ctx, getConfigMapOptions)
+			if err != nil {
+				return nil, "", err
+			}
+			return configMap, core.StringNilMapper(configMap.EntityTag), nil
+		},
+		create: func(ctx context.Context) (*ConfigMap, error) {
+			configMap, _, err := codeEngine.CreateConfigMapWithContext(ctx, &options)
+			return configMap, err
+		},
+		diff: func(live *ConfigMap) (map[string]interface{}, error) {
+			if len(options.Data) == 0 && len(live.Data) == 0 || reflect.DeepEqual(options.Data, live.Data) {
+				return nil, nil
+			}
+			return map[string]interface{}{"data": options.Data}, nil
+		},
+		update: func(ctx context.Context, entityTag string, _ map[string]interface{}) (*ConfigMap, error) {
+			replaceConfigMapOptions := codeEngine.NewReplaceConfigMapOptions(*options.ProjectID, *options.Name, entityTag)
+			replaceConfigMapOptions.Data = options.Data
+			replaceConfigMapOptions.Headers = options.Headers
+			configMap, _, err := codeEngine.ReplaceConfigMapWithContext(ctx, replaceConfigMapOptions)
+			return configMap, err
+		},
+	}
+	return applier.apply(ctx)
+}
+
+// ApplySecret : Create or replace a secret
+// Create the secret of the prototype if it does not exist yet. Otherwise, replace the data of the secret with the data
+// of the prototype if they differ, using its entity tag. If the secret is modified concurrently, the comparison is
+// repeated and the replacement retried. The format of an existing secret cannot be changed. The data of service access
+// and service operator secrets is generated by Code Engine and is therefore not compared. The result reports whether
+// the secret has been created or replaced.
+func (codeEngine *CodeEngineV2) ApplySecret(ctx context.Context, createSecretOptions *CreateSecretOptions) (result *Secret, changed bool, err error) {
+	err = validateApplyOptions(createSecretOptions, "createSecretOptions")
+	if err != nil {
+		return
+	}
+	options := *createSecretOptions
+	options.ProjectID, err = codeEngine.resolveProjectIDPtr(ctx, options.ProjectID)
+	if err != nil {
+		return
+	}
+	applier := &applier[Secret]{
+		get: func(ctx context.Context) (*Secret, string, error) {
+			getSecretOptions := codeEngine.NewGetSecretOptions(*options.ProjectID, *options.Name)
+			getSecretOptions.Headers = options.Headers
+			secret, _, err := codeEngine.GetSecretWithContext(ctx, getSecretOptions)
+			if err != nil {
+				return nil, "", err
+			}
+			return secret, core.StringNilMapper(secret.EntityTag), nil
+		},
+		create: func(ctx context.Context) (*Secret, error) {
+			secret, _, err := codeEngine.CreateSecretWithContext(ctx, &options)
+			return secret, err
+		},
+		diff: func(live *Secret) (map[string]interface{}, error) {
+			if core.StringNilMapper(live.Format) != *options.Format {
+				return nil, fmt.Errorf("the format of secret '%s' is '%s' and cannot be changed to '%s'", *options.Name, core.StringNilMapper(live.Format), *options.Format)
+			}
+			switch *options.Format {
+			case Secret_Format_ServiceAccess, Secret_Format_ServiceOperator:
+				return nil, nil
+			}
+			desired, err := jsondiff.ToObject(options.Data)
+			if err != nil {
+				return nil, err
+			}
+			current := map[string]interface{}{}
+			for key, value := range live.Data {
+				current[key] = value
+			}
+			if jsondiff.Equal(desired, current) {
+				return nil, nil
+			}
+			return map[string]interface{}{"data": desired}, nil
+		},
+		update: func(ctx context.Context, entityTag string, _ map[string]interface{}) (*Secret, error) {
+			replaceSecretOptions := codeEngine.NewReplaceSecretOptions(*options.ProjectID, *options.Name, entityTag, *options.Format)
+			replaceSecretOptions.Data = options.Data
+			replaceSecretOptions.Headers = options.Headers
+			secret, _, err := codeEngine.ReplaceSecretWithContext(ctx, replaceSecretOptions)
+			return secret, err
+		},
+	}
+	return applier.apply(ctx)
+}
+
+// validateApplyOptions validates the prototype of an Apply* helper.
+func validateApplyOptions(options interface{}, name string) error {
+	err := core.ValidateNotNil(options, name+" cannot be nil")
+	if err != nil {
+		return core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+	}
+	err = core.ValidateStruct(options, name)
+	if err != nil {
+		return core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+	}
+	return nil
+}
+
+// patchOf returns a merge patch with the fields of the prototype that differ from the live resource. The project ID
+// and name of the prototype, which identify the resource, are ignored. A field is considered equal if the live value
+// contains the value of the prototype, since the live resource has additional fields that are set by Code Engine, e.g.
+// the default values of environment variables.
+func patchOf(prototype interface{}, live interface{}) (map[string]interface{}, error) {
+	_, patch, err := jsondiff.Diff(prototype, live, []string{"project_id", "name", "Headers"}, nil)
+	return patch, err
+}
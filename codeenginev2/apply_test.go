@@ -0,0 +1,155 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codeenginev2_test
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/IBM/code-engine-go-sdk/codeenginev2"
+	"github.com/IBM/code-engine-go-sdk/codeenginev2/codeenginev2test"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`CodeEngineV2 apply helpers`, func() {
+	var server *codeenginev2test.Server
+	var codeEngineService *codeenginev2.CodeEngineV2
+	var projectID string
+	ctx := context.Background()
+
+	BeforeEach(func() {
+		server = codeenginev2test.NewServer()
+		var err error
+		codeEngineService, err = server.NewCodeEngineV2()
+		Expect(err).To(BeNil())
+		projectID = server.AddProject("my-project")
+	})
+	AfterEach(func() {
+		server.Close()
+	})
+
+	Describe(`ApplyApp(ctx, createAppOptions)`, func() {
+		It(`Creates, keeps and patches the app`, func() {
+			prototype := codeEngineService.NewCreateAppOptions(projectID, "icr.io/codeengine/helloworld", "my-app").
+				SetScaleMinInstances(1).
+				SetRunEnvVariables([]codeenginev2.EnvVarPrototype{{Type: core.StringPtr("literal"), Name: core.StringPtr("LEVEL"), Value: core.StringPtr("info")}})
+
+			app, changed, err := codeEngineService.ApplyApp(ctx, prototype)
+			Expect(err).To(BeNil())
+			Expect(changed).To(BeTrue())
+			Expect(*app.ScaleMinInstances).To(Equal(int64(1)))
+
+			app, changed, err = codeEngineService.ApplyApp(ctx, prototype)
+			Expect(err).To(BeNil())
+			Expect(changed).To(BeFalse())
+			entityTag := *app.EntityTag
+
+			Expect(server.ModifyResource(projectID, "apps", "my-app", map[string]interface{}{"scale_max_instances": 3})).To(BeTrue())
+			app, changed, err = codeEngineService.ApplyApp(ctx, prototype.SetImageReference("icr.io/codeengine/other"))
+			Expect(err).To(BeNil())
+			Expect(changed).To(BeTrue())
+			Expect(*app.ImageReference).To(Equal("icr.io/codeengine/other"))
+			Expect(*app.ScaleMaxInstances).To(Equal(int64(3)))
+			Expect(*app.EntityTag).ToNot(Equal(entityTag))
+		})
+		It(`Retries the patch with a fresh entity tag after a backoff if the precondition fails`, func() {
+			_, _, err := codeEngineService.CreateApp(codeEngineService.NewCreateAppOptions(projectID, "icr.io/codeengine/helloworld", "my-app"))
+			Expect(err).To(BeNil())
+			server.FailNext(http.MethodPatch, "/projects/"+projectID+"/apps/my-app", http.StatusPreconditionFailed, nil)
+
+			start := time.Now()
+			app, changed, err := codeEngineService.ApplyApp(ctx, codeEngineService.NewCreateAppOptions(projectID, "icr.io/codeengine/other", "my-app"))
+			Expect(err).To(BeNil())
+			Expect(time.Since(start)).To(BeNumerically(">=", 100*time.Millisecond))
+			Expect(changed).To(BeTrue())
+			Expect(*app.ImageReference).To(Equal("icr.io/codeengine/other"))
+		})
+		It(`Returns other errors`, func() {
+			server.FailNext(http.MethodGet, "/projects/"+projectID+"/apps/my-app", http.StatusForbidden, nil)
+
+			_, _, err := codeEngineService.ApplyApp(ctx, codeEngineService.NewCreateAppOptions(projectID, "icr.io/codeengine/helloworld", "my-app"))
+			Expect(err).ToNot(BeNil())
+			apiErr, ok := codeenginev2.AsAPIError(err)
+			Expect(ok).To(BeTrue())
+			Expect(apiErr.StatusCode).To(Equal(403))
+
+			_, _, err = codeEngineService.ApplyApp(ctx, nil)
+			Expect(err).ToNot(BeNil())
+		})
+	})
+
+	Describe(`ApplyJob(ctx, createJobOptions)`, func() {
+		It(`Patches only the fields of the prototype`, func() {
+			prototype := codeEngineService.NewCreateJobOptions(projectID, "icr.io/codeengine/helloworld", "my-job").SetScaleArraySpec("0-9")
+			_, changed, err := codeEngineService.ApplyJob(ctx, prototype)
+			Expect(err).To(BeNil())
+			Expect(changed).To(BeTrue())
+
+			job, changed, err := codeEngineService.ApplyJob(ctx, prototype.SetScaleRetryLimit(1))
+			Expect(err).To(BeNil())
+			Expect(changed).To(BeTrue())
+			Expect(*job.ScaleRetryLimit).To(Equal(int64(1)))
+			Expect(*job.ScaleArraySpec).To(Equal("0-9"))
+		})
+	})
+
+	Describe(`ApplyConfigMap(ctx, createConfigMapOptions)`, func() {
+		It(`Replaces the data if it differs`, func() {
+			prototype := codeEngineService.NewCreateConfigMapOptions(projectID, "my-config").SetData(map[string]string{"key": "value"})
+			_, changed, err := codeEngineService.ApplyConfigMap(ctx, prototype)
+			Expect(err).To(BeNil())
+			Expect(changed).To(BeTrue())
+
+			_, changed, err = codeEngineService.ApplyConfigMap(ctx, prototype)
+			Expect(err).To(BeNil())
+			Expect(changed).To(BeFalse())
+
+			configMap, changed, err := codeEngineService.ApplyConfigMap(ctx, prototype.SetData(map[string]string{"other": "value"}))
+			Expect(err).To(BeNil())
+			Expect(changed).To(BeTrue())
+			Expect(configMap.Data).To(Equal(map[string]string{"other": "value"}))
+		})
+	})
+
+	Describe(`ApplySecret(ctx, createSecretOptions)`, func() {
+		It(`Replaces the data if it differs and refuses to change the format`, func() {
+			data := &codeenginev2.SecretDataGenericSecretData{}
+			data.SetProperty("password", core.StringPtr("s3cr3t"))
+			prototype := codeEngineService.NewCreateSecretOptions(projectID, codeenginev2.Secret_Format_Generic, "my-secret").SetData(data)
+			_, changed, err := codeEngineService.ApplySecret(ctx, prototype)
+			Expect(err).To(BeNil())
+			Expect(changed).To(BeTrue())
+
+			_, changed, err = codeEngineService.ApplySecret(ctx, prototype)
+			Expect(err).To(BeNil())
+			Expect(changed).To(BeFalse())
+
+			data.SetProperty("password", core.StringPtr("rotated"))
+			secret, changed, err := codeEngineService.ApplySecret(ctx, prototype)
+			Expect(err).To(BeNil())
+			Expect(changed).To(BeTrue())
+			Expect(secret.Data).To(Equal(map[string]string{"password": "rotated"}))
+
+			_, _, err = codeEngineService.ApplySecret(ctx, codeEngineService.NewCreateSecretOptions(projectID, codeenginev2.Secret_Format_Other, "my-secret"))
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("cannot be changed to 'other'"))
+		})
+	})
+})
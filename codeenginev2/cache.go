@@ -0,0 +1,549 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codeenginev2
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"time"
+
+	common "github.com/IBM/code-engine-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// DefaultCacheResyncInterval is the default delay between two relists of a cache.
+const DefaultCacheResyncInterval = 30 * time.Second
+
+// ResourceKind : The kind of a resource held by a cache.
+type ResourceKind string
+
+// Constants associated with the ResourceKind.
+const (
+	ResourceKindApp       ResourceKind = "app"
+	ResourceKindBinding   ResourceKind = "binding"
+	ResourceKindConfigMap ResourceKind = "config_map"
+	ResourceKindJob       ResourceKind = "job"
+	ResourceKindSecret    ResourceKind = "secret"
+)
+
+// CacheEvent : A change of a resource that has been observed by a relist of a cache.
+type CacheEvent struct {
+	// The kind of the resource.
+	Kind ResourceKind
+
+	// The type of the event.
+	Type EventType
+
+	// The resource after the change, i.e. an *App, *Job, *Secret, *ConfigMap or *Binding. For deleted resources, the
+	// last observed resource.
+	Object interface{}
+
+	// The resource before the change, for modified resources.
+	Previous interface{}
+
+	// The error of a failed relist, for error events.
+	Err error
+}
+
+// CacheEventHandler : A function that is invoked with the events of a cache.
+type CacheEventHandler func(event CacheEvent)
+
+// CacheOptions : The NewCache options.
+type CacheOptions struct {
+	// The ID of the project.
+	ProjectID *string `validate:"required,ne="`
+
+	// The delay between two relists of Run. Defaults to DefaultCacheResyncInterval.
+	ResyncInterval time.Duration
+
+	// Allows users to set headers on the API requests issued while relisting.
+	Headers map[string]string
+}
+
+// NewCacheOptions : Instantiate CacheOptions
+func (*CodeEngineV2) NewCacheOptions(projectID string) *CacheOptions {
+	return &CacheOptions{
+		ProjectID: core.StringPtr(projectID),
+	}
+}
+
+// SetProjectID : Allow user to set ProjectID
+func (_options *CacheOptions) SetProjectID(projectID string) *CacheOptions {
+	_options.ProjectID = core.StringPtr(projectID)
+	return _options
+}
+
+// SetResyncInterval : Allow user to set ResyncInterval
+func (_options *CacheOptions) SetResyncInterval(resyncInterval time.Duration) *CacheOptions {
+	_options.ResyncInterval = resyncInterval
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *CacheOptions) SetHeaders(param map[string]string) *CacheOptions {
+	options.Headers = param
+	return options
+}
+
+// Cache : An in-memory cache of the apps, jobs, secrets, config maps and bindings of a project.
+//
+// The cache is filled and refreshed by relisting all resources, either periodically by Run or on demand by Resync.
+// Lookups and the index of the resources that reference a secret or config map are served from memory without API
+// calls. The registered event handlers are invoked with the changes that a relist observes, in the order of the
+// relists. The resources returned by the cache are shared and must not be modified.
+//
+//	cache, _ := codeEngineService.NewCache(codeEngineService.NewCacheOptions(projectID))
+//	cache.AddEventHandler(func(event codeenginev2.CacheEvent) { ... })
+//	go cache.Run(ctx)
+//	if err := cache.WaitForSync(ctx); err != nil { ... }
+//	apps := cache.AppsReferencingSecret("credentials")
+type Cache struct {
+	resyncInterval time.Duration
+
+	apps       *cacheStore[App]
+	jobs       *cacheStore[Job]
+	secrets    *cacheStore[Secret]
+	configMaps *cacheStore[ConfigMap]
+	bindings   *cacheStore[Binding]
+	stores     []cacheRelister
+
+	// mutex guards the contents of the stores.
+	mutex sync.RWMutex
+
+	// dispatchMutex serializes the relists with the dispatch of their events and guards the handlers.
+	dispatchMutex sync.Mutex
+	handlers      []CacheEventHandler
+
+	synced     chan struct{}
+	syncedOnce sync.Once
+}
+
+// NewCache : Create a cache of the resources of a project
+// The cache is empty until it has been synced by Run or Resync.
+func (codeEngine *CodeEngineV2) NewCache(cacheOptions *CacheOptions) (*Cache, error) {
+	err := validateApplyOptions(cacheOptions, "cacheOptions")
+	if err != nil {
+		return nil, err
+	}
+	projectID := *cacheOptions.ProjectID
+	headers := cacheOptions.Headers
+
+	cache := &Cache{
+		resyncInterval: cacheOptions.ResyncInterval,
+		synced:         make(chan struct{}),
+	}
+	if cache.resyncInterval <= 0 {
+		cache.resyncInterval = DefaultCacheResyncInterval
+	}
+
+	cache.apps = newCacheStore(ResourceKindApp, func(ctx context.Context) ([]App, error) {
+		id, err := codeEngine.ResolveProjectID(ctx, projectID)
+		if err != nil {
+			return nil, err
+		}
+		listAppsOptions := codeEngine.NewListAppsOptions(id).SetLimit(watchPageLimit)
+		listAppsOptions.Headers = headers
+		pager, err := codeEngine.NewAppsPager(listAppsOptions)
+		if err != nil {
+			return nil, err
+		}
+		return pager.GetAllWithContext(ctx)
+	})
+	cache.jobs = newCacheStore(ResourceKindJob, func(ctx context.Context) ([]Job, error) {
+		id, err := codeEngine.ResolveProjectID(ctx, projectID)
+		if err != nil {
+			return nil, err
+		}
+		listJobsOptions := codeEngine.NewListJobsOptions(id).SetLimit(watchPageLimit)
+		listJobsOptions.Headers = headers
+		pager, err := codeEngine.NewJobsPager(listJobsOptions)
+		if err != nil {
+			return nil, err
+		}
+		return pager.GetAllWithContext(ctx)
+	})
+	cache.secrets = newCacheStore(ResourceKindSecret, func(ctx context.Context) ([]Secret, error) {
+		id, err := codeEngine.ResolveProjectID(ctx, projectID)
+		if err != nil {
+			return nil, err
+		}
+		listSecretsOptions := codeEngine.NewListSecretsOptions(id).SetLimit(watchPageLimit)
+		listSecretsOptions.Headers = headers
+		pager, err := codeEngine.NewSecretsPager(listSecretsOptions)
+		if err != nil {
+			return nil, err
+		}
+		return pager.GetAllWithContext(ctx)
+	})
+	cache.configMaps = newCacheStore(ResourceKindConfigMap, func(ctx context.Context) ([]ConfigMap, error) {
+		id, err := codeEngine.ResolveProjectID(ctx, projectID)
+		if err != nil {
+			return nil, err
+		}
+		listConfigMapsOptions := codeEngine.NewListConfigMapsOptions(id).SetLimit(watchPageLimit)
+		listConfigMapsOptions.Headers = headers
+		pager, err := codeEngine.NewConfigMapsPager(listConfigMapsOptions)
+		if err != nil {
+			return nil, err
+		}
+		return pager.GetAllWithContext(ctx)
+	})
+	cache.bindings = newCacheStore(ResourceKindBinding, func(ctx context.Context) ([]Binding, error) {
+		id, err := codeEngine.ResolveProjectID(ctx, projectID)
+		if err != nil {
+			return nil, err
+		}
+		listBindingsOptions := codeEngine.NewListBindingsOptions(id).SetLimit(watchPageLimit)
+		listBindingsOptions.Headers = headers
+		pager, err := codeEngine.NewBindingsPager(listBindingsOptions)
+		if err != nil {
+			return nil, err
+		}
+		return pager.GetAllWithContext(ctx)
+	})
+	cache.stores = []cacheRelister{cache.apps, cache.jobs, cache.secrets, cache.configMaps, cache.bindings}
+	return cache, nil
+}
+
+// AddEventHandler registers a handler for the events of the cache. If the cache has already been synced, the handler
+// is first invoked with an added event for each cached resource. Handlers are invoked sequentially by the goroutine
+// that relists the resources and must not register other handlers.
+func (cache *Cache) AddEventHandler(handler CacheEventHandler) {
+	cache.dispatchMutex.Lock()
+	defer cache.dispatchMutex.Unlock()
+
+	cache.mutex.RLock()
+	var events []CacheEvent
+	for _, store := range cache.stores {
+		events = append(events, store.added()...)
+	}
+	cache.mutex.RUnlock()
+
+	for _, event := range events {
+		handler(event)
+	}
+	cache.handlers = append(cache.handlers, handler)
+}
+
+// Run relists the resources every resync interval until the context is done. Failed relists are reported to the
+// event handlers as error events and the cache keeps the resources of the last successful relist.
+func (cache *Cache) Run(ctx context.Context) {
+	for {
+		_ = cache.Resync(ctx)
+
+		timer := time.NewTimer(cache.resyncInterval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+	}
+}
+
+// Resync relists the resources once, updates the cache and invokes the event handlers with the changes. It returns the
+// errors of the resource kinds that could not be listed; the other kinds are updated regardless.
+func (cache *Cache) Resync(ctx context.Context) error {
+	cache.dispatchMutex.Lock()
+	defer cache.dispatchMutex.Unlock()
+
+	var events []CacheEvent
+	var errs []error
+	for _, store := range cache.stores {
+		storeEvents, err := store.relist(ctx, &cache.mutex)
+		events = append(events, storeEvents...)
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if len(errs) == 0 {
+		cache.syncedOnce.Do(func() {
+			close(cache.synced)
+		})
+	}
+	for _, event := range events {
+		for _, handler := range cache.handlers {
+			handler(event)
+		}
+	}
+
+	if len(errs) > 0 {
+		return core.SDKErrorf(errors.Join(errs...), "", "cache-resync-error", common.GetComponentInfo())
+	}
+	return nil
+}
+
+// HasSynced returns true once all resource kinds have been listed successfully.
+func (cache *Cache) HasSynced() bool {
+	select {
+	case <-cache.synced:
+		return true
+	default:
+		return false
+	}
+}
+
+// WaitForSync waits until all resource kinds have been listed successfully, or until the context is done.
+func (cache *Cache) WaitForSync(ctx context.Context) error {
+	select {
+	case <-cache.synced:
+		return nil
+	case <-ctx.Done():
+		return core.SDKErrorf(ctx.Err(), "", "cache-sync-error", common.GetComponentInfo())
+	}
+}
+
+// GetApp returns the cached app with the name.
+func (cache *Cache) GetApp(name string) (*App, bool) {
+	cache.mutex.RLock()
+	defer cache.mutex.RUnlock()
+	return cache.apps.get(name)
+}
+
+// ListApps returns the cached apps.
+func (cache *Cache) ListApps() []*App {
+	cache.mutex.RLock()
+	defer cache.mutex.RUnlock()
+	return cache.apps.list()
+}
+
+// GetJob returns the cached job with the name.
+func (cache *Cache) GetJob(name string) (*Job, bool) {
+	cache.mutex.RLock()
+	defer cache.mutex.RUnlock()
+	return cache.jobs.get(name)
+}
+
+// ListJobs returns the cached jobs.
+func (cache *Cache) ListJobs() []*Job {
+	cache.mutex.RLock()
+	defer cache.mutex.RUnlock()
+	return cache.jobs.list()
+}
+
+// GetSecret returns the cached secret with the name.
+func (cache *Cache) GetSecret(name string) (*Secret, bool) {
+	cache.mutex.RLock()
+	defer cache.mutex.RUnlock()
+	return cache.secrets.get(name)
+}
+
+// ListSecrets returns the cached secrets.
+func (cache *Cache) ListSecrets() []*Secret {
+	cache.mutex.RLock()
+	defer cache.mutex.RUnlock()
+	return cache.secrets.list()
+}
+
+// GetConfigMap returns the cached config map with the name.
+func (cache *Cache) GetConfigMap(name string) (*ConfigMap, bool) {
+	cache.mutex.RLock()
+	defer cache.mutex.RUnlock()
+	return cache.configMaps.get(name)
+}
+
+// ListConfigMaps returns the cached config maps.
+func (cache *Cache) ListConfigMaps() []*ConfigMap {
+	cache.mutex.RLock()
+	defer cache.mutex.RUnlock()
+	return cache.configMaps.list()
+}
+
+// GetBinding returns the cached binding with the ID.
+func (cache *Cache) GetBinding(id string) (*Binding, bool) {
+	cache.mutex.RLock()
+	defer cache.mutex.RUnlock()
+	return cache.bindings.get(id)
+}
+
+// ListBindings returns the cached bindings.
+func (cache *Cache) ListBindings() []*Binding {
+	cache.mutex.RLock()
+	defer cache.mutex.RUnlock()
+	return cache.bindings.list()
+}
+
+// AppsReferencingSecret returns the cached apps that use the secret, e.g. as image pull secret, as environment
+// variables or as volume mount, or that are bound to it by a cached binding.
+func (cache *Cache) AppsReferencingSecret(secret string) []*App {
+	cache.mutex.RLock()
+	defer cache.mutex.RUnlock()
+	return cache.apps.referencingSecret(secret, cache.bindings.secretIndex[secret], App_ResourceType_AppV2)
+}
+
+// JobsReferencingSecret returns the cached jobs that use the secret, e.g. as image pull secret, as environment
+// variables or as volume mount, or that are bound to it by a cached binding.
+func (cache *Cache) JobsReferencingSecret(secret string) []*Job {
+	cache.mutex.RLock()
+	defer cache.mutex.RUnlock()
+	return cache.jobs.referencingSecret(secret, cache.bindings.secretIndex[secret], Job_ResourceType_JobV2)
+}
+
+// BindingsReferencingSecret returns the cached bindings of the secret.
+func (cache *Cache) BindingsReferencingSecret(secret string) []*Binding {
+	cache.mutex.RLock()
+	defer cache.mutex.RUnlock()
+	return slices.Clone(cache.bindings.secretIndex[secret])
+}
+
+// AppsReferencingConfigMap returns the cached apps that use the config map as environment variables or as volume
+// mount.
+func (cache *Cache) AppsReferencingConfigMap(configMap string) []*App {
+	cache.mutex.RLock()
+	defer cache.mutex.RUnlock()
+	return slices.Clone(cache.apps.configMapIndex[configMap])
+}
+
+// JobsReferencingConfigMap returns the cached jobs that use the config map as environment variables or as volume
+// mount.
+func (cache *Cache) JobsReferencingConfigMap(configMap string) []*Job {
+	cache.mutex.RLock()
+	defer cache.mutex.RUnlock()
+	return slices.Clone(cache.jobs.configMapIndex[configMap])
+}
+
+// cacheRelister is the part of a cacheStore that does not depend on its resource type.
+type cacheRelister interface {
+	relist(ctx context.Context, mutex *sync.RWMutex) ([]CacheEvent, error)
+	added() []CacheEvent
+}
+
+// cacheStore holds the resources of one kind of a cache, indexed by their name, or by their ID if they have no name,
+// and by the secrets and config maps they reference.
+type cacheStore[T any] struct {
+	kind     ResourceKind
+	listFunc func(ctx context.Context) ([]T, error)
+
+	snapshot       []watchedItem[T]
+	byKey          map[string]*T
+	secretIndex    map[string][]*T
+	configMapIndex map[string][]*T
+}
+
+func newCacheStore[T any](kind ResourceKind, listFunc func(ctx context.Context) ([]T, error)) *cacheStore[T] {
+	return &cacheStore[T]{kind: kind, listFunc: listFunc}
+}
+
+// relist lists the resources and replaces the contents of the store while holding the mutex. It returns the changes
+// since the previous relist, or an error event if the resources cannot be listed. A relist that is interrupted by the end
+// of the context returns the error without an event.
+func (store *cacheStore[T]) relist(ctx context.Context, mutex *sync.RWMutex) ([]CacheEvent, error) {
+	items, err := store.listFunc(ctx)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "cache-list-error")
+		if ctx.Err() != nil {
+			return nil, err
+		}
+		return []CacheEvent{{Kind: store.kind, Type: EventError, Err: err}}, err
+	}
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	snapshot, changes := diffSnapshots(store.snapshot, items)
+	store.snapshot = snapshot
+	store.byKey = make(map[string]*T, len(snapshot))
+	store.secretIndex = map[string][]*T{}
+	store.configMapIndex = map[string][]*T{}
+	for i := range snapshot {
+		item := &snapshot[i].item
+		store.byKey[cacheKey(item)] = item
+		if references, ok := interface{}(item).(interface{ ReferencedSecrets() []string }); ok {
+			for _, secret := range uniqueStrings(references.ReferencedSecrets()) {
+				store.secretIndex[secret] = append(store.secretIndex[secret], item)
+			}
+		}
+		if references, ok := interface{}(item).(interface{ ReferencedConfigMaps() []string }); ok {
+			for _, configMap := range uniqueStrings(references.ReferencedConfigMaps()) {
+				store.configMapIndex[configMap] = append(store.configMapIndex[configMap], item)
+			}
+		}
+	}
+
+	events := make([]CacheEvent, 0, len(changes))
+	for _, change := range changes {
+		event := CacheEvent{Kind: store.kind, Type: change.Type, Object: &change.Object}
+		if change.Type == EventModified {
+			event.Previous = &change.Previous
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+// added returns an added event for each resource of the store. The caller must hold the mutex of the cache.
+func (store *cacheStore[T]) added() []CacheEvent {
+	events := make([]CacheEvent, 0, len(store.snapshot))
+	for i := range store.snapshot {
+		events = append(events, CacheEvent{Kind: store.kind, Type: EventAdded, Object: &store.snapshot[i].item})
+	}
+	return events
+}
+
+func (store *cacheStore[T]) get(key string) (*T, bool) {
+	item, found := store.byKey[key]
+	return item, found
+}
+
+// referencingSecret returns the resources that use the secret, followed by the resources of the resource type that
+// are the components of the bindings of the secret. The caller must hold the mutex of the cache.
+func (store *cacheStore[T]) referencingSecret(secret string, bindings []*Binding, resourceType string) []*T {
+	items := slices.Clone(store.secretIndex[secret])
+	for _, binding := range bindings {
+		if binding.Component == nil || core.StringNilMapper(binding.Component.ResourceType) != resourceType {
+			continue
+		}
+		item, found := store.byKey[core.StringNilMapper(binding.Component.Name)]
+		if found && !slices.Contains(items, item) {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+func (store *cacheStore[T]) list() []*T {
+	items := make([]*T, 0, len(store.snapshot))
+	for i := range store.snapshot {
+		items = append(items, &store.snapshot[i].item)
+	}
+	return items
+}
+
+// cacheKey returns the name of a resource, or its ID if it has no name.
+func cacheKey(item interface{}) string {
+	if name, ok := stringField(item, "Name"); ok {
+		return name
+	}
+	id, _ := stringField(item, "ID")
+	return id
+}
+
+// uniqueStrings returns the values without duplicates, in the order of their first occurrence.
+func uniqueStrings(values []string) []string {
+	seen := make(map[string]bool, len(values))
+	unique := values[:0]
+	for _, value := range values {
+		if !seen[value] {
+			seen[value] = true
+			unique = append(unique, value)
+		}
+	}
+	return unique
+}
@@ -0,0 +1,208 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codeenginev2_test
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/IBM/code-engine-go-sdk/codeenginev2"
+	"github.com/IBM/code-engine-go-sdk/codeenginev2/codeenginev2test"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`CodeEngineV2 cache`, func() {
+	var server *codeenginev2test.Server
+	var codeEngineService *codeenginev2.CodeEngineV2
+	var projectID string
+	var cache *codeenginev2.Cache
+	ctx := context.Background()
+
+	createApp := func(name string, secret string) {
+		createAppOptions := codeEngineService.NewCreateAppOptions(projectID, "icr.io/codeengine/helloworld", name).
+			SetRunEnvVariables([]codeenginev2.EnvVarPrototype{
+				{Type: core.StringPtr(codeenginev2.EnvVarPrototype_Type_SecretFullReference), Reference: core.StringPtr(secret)},
+			}).
+			SetRunVolumeMounts([]codeenginev2.VolumeMountPrototype{
+				{Type: core.StringPtr(codeenginev2.VolumeMountPrototype_Type_ConfigMap), Reference: core.StringPtr("settings"), MountPath: core.StringPtr("/settings")},
+			})
+		_, _, err := codeEngineService.CreateApp(createAppOptions)
+		Expect(err).To(BeNil())
+	}
+
+	BeforeEach(func() {
+		server = codeenginev2test.NewServer()
+		var err error
+		codeEngineService, err = server.NewCodeEngineV2()
+		Expect(err).To(BeNil())
+		projectID = server.AddProject("my-project")
+		cache, err = codeEngineService.NewCache(codeEngineService.NewCacheOptions(projectID))
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It(`Serves lookups and references from the last relist`, func() {
+		createApp("frontend", "credentials")
+		createApp("backend", "other-credentials")
+		_, _, err := codeEngineService.CreateConfigMap(codeEngineService.NewCreateConfigMapOptions(projectID, "settings").
+			SetData(map[string]string{"level": "info"}))
+		Expect(err).To(BeNil())
+
+		_, found := cache.GetApp("frontend")
+		Expect(found).To(BeFalse())
+		Expect(cache.HasSynced()).To(BeFalse())
+
+		Expect(cache.Resync(ctx)).To(Succeed())
+		Expect(cache.HasSynced()).To(BeTrue())
+		Expect(cache.WaitForSync(ctx)).To(Succeed())
+
+		app, found := cache.GetApp("frontend")
+		Expect(found).To(BeTrue())
+		Expect(*app.Name).To(Equal("frontend"))
+		Expect(cache.ListApps()).To(HaveLen(2))
+		configMap, found := cache.GetConfigMap("settings")
+		Expect(found).To(BeTrue())
+		Expect(configMap.Data).To(HaveKeyWithValue("level", "info"))
+
+		apps := cache.AppsReferencingSecret("credentials")
+		Expect(apps).To(HaveLen(1))
+		Expect(*apps[0].Name).To(Equal("frontend"))
+		Expect(cache.AppsReferencingConfigMap("settings")).To(HaveLen(2))
+		Expect(cache.AppsReferencingSecret("unknown")).To(BeEmpty())
+		Expect(cache.JobsReferencingSecret("credentials")).To(BeEmpty())
+
+		_, err = codeEngineService.DeleteApp(codeEngineService.NewDeleteAppOptions(projectID, "frontend"))
+		Expect(err).To(BeNil())
+		Expect(cache.Resync(ctx)).To(Succeed())
+		_, found = cache.GetApp("frontend")
+		Expect(found).To(BeFalse())
+		Expect(cache.AppsReferencingSecret("credentials")).To(BeEmpty())
+	})
+	It(`Returns the apps and jobs that are bound to a secret`, func() {
+		createApp("frontend", "credentials")
+		createApp("backend", "other-credentials")
+		_, _, err := codeEngineService.CreateJob(codeEngineService.NewCreateJobOptions(projectID, "icr.io/codeengine/helloworld", "importer"))
+		Expect(err).To(BeNil())
+		for _, component := range []*codeenginev2.ComponentRef{
+			{Name: core.StringPtr("frontend"), ResourceType: core.StringPtr(codeenginev2.App_ResourceType_AppV2)},
+			{Name: core.StringPtr("backend"), ResourceType: core.StringPtr(codeenginev2.App_ResourceType_AppV2)},
+			{Name: core.StringPtr("importer"), ResourceType: core.StringPtr(codeenginev2.Job_ResourceType_JobV2)},
+		} {
+			_, _, err = codeEngineService.CreateBinding(codeEngineService.NewCreateBindingOptions(projectID, component, "MY_COS", "credentials"))
+			Expect(err).To(BeNil())
+		}
+		Expect(cache.Resync(ctx)).To(Succeed())
+
+		apps := cache.AppsReferencingSecret("credentials")
+		Expect(apps).To(HaveLen(2))
+		Expect(*apps[0].Name).To(Equal("frontend"))
+		Expect(*apps[1].Name).To(Equal("backend"))
+		jobs := cache.JobsReferencingSecret("credentials")
+		Expect(jobs).To(HaveLen(1))
+		Expect(*jobs[0].Name).To(Equal("importer"))
+		Expect(cache.BindingsReferencingSecret("credentials")).To(HaveLen(3))
+		Expect(cache.AppsReferencingSecret("other-credentials")).To(HaveLen(1))
+	})
+	It(`Invokes the event handlers with the changes of each relist`, func() {
+		createApp("frontend", "credentials")
+		Expect(cache.Resync(ctx)).To(Succeed())
+
+		var events []codeenginev2.CacheEvent
+		cache.AddEventHandler(func(event codeenginev2.CacheEvent) {
+			events = append(events, event)
+		})
+		Expect(events).To(HaveLen(1))
+		Expect(events[0].Kind).To(Equal(codeenginev2.ResourceKindApp))
+		Expect(events[0].Type).To(Equal(codeenginev2.EventAdded))
+
+		Expect(server.ModifyResource(projectID, "apps", "frontend", map[string]interface{}{"scale_max_instances": 3})).To(BeTrue())
+		_, _, err := codeEngineService.CreateSecret(codeEngineService.NewCreateSecretOptions(projectID, codeenginev2.CreateSecretOptions_Format_Generic, "credentials"))
+		Expect(err).To(BeNil())
+		Expect(cache.Resync(ctx)).To(Succeed())
+		Expect(events).To(HaveLen(3))
+		Expect(events[1].Type).To(Equal(codeenginev2.EventModified))
+		Expect(*events[1].Object.(*codeenginev2.App).ScaleMaxInstances).To(Equal(int64(3)))
+		Expect(*events[1].Previous.(*codeenginev2.App).EntityTag).ToNot(Equal(*events[1].Object.(*codeenginev2.App).EntityTag))
+		Expect(events[2].Kind).To(Equal(codeenginev2.ResourceKindSecret))
+		Expect(events[2].Type).To(Equal(codeenginev2.EventAdded))
+
+		_, err = codeEngineService.DeleteSecret(codeEngineService.NewDeleteSecretOptions(projectID, "credentials"))
+		Expect(err).To(BeNil())
+		Expect(cache.Resync(ctx)).To(Succeed())
+		Expect(events).To(HaveLen(4))
+		Expect(events[3].Type).To(Equal(codeenginev2.EventDeleted))
+		Expect(*events[3].Object.(*codeenginev2.Secret).Name).To(Equal("credentials"))
+	})
+	It(`Keeps the last resources and reports an error event if a relist fails`, func() {
+		createApp("frontend", "credentials")
+		Expect(cache.Resync(ctx)).To(Succeed())
+
+		var events []codeenginev2.CacheEvent
+		cache.AddEventHandler(func(event codeenginev2.CacheEvent) {
+			events = append(events, event)
+		})
+		server.FailNext(http.MethodGet, "/projects/"+projectID+"/apps", http.StatusInternalServerError, nil)
+		err := cache.Resync(ctx)
+		Expect(err).ToNot(BeNil())
+		Expect(events).To(HaveLen(2))
+		Expect(events[1].Kind).To(Equal(codeenginev2.ResourceKindApp))
+		Expect(events[1].Type).To(Equal(codeenginev2.EventError))
+		_, found := cache.GetApp("frontend")
+		Expect(found).To(BeTrue())
+	})
+	It(`Relists periodically until the context is done`, func() {
+		cache, err := codeEngineService.NewCache(codeEngineService.NewCacheOptions(projectID).SetResyncInterval(10 * time.Millisecond))
+		Expect(err).To(BeNil())
+		var mutex sync.Mutex
+		var added []string
+		cache.AddEventHandler(func(event codeenginev2.CacheEvent) {
+			mutex.Lock()
+			defer mutex.Unlock()
+			Expect(event.Type).To(Equal(codeenginev2.EventAdded))
+			added = append(added, *event.Object.(*codeenginev2.App).Name)
+		})
+
+		runCtx, cancel := context.WithCancel(ctx)
+		done := make(chan struct{})
+		go func() {
+			defer GinkgoRecover()
+			defer close(done)
+			cache.Run(runCtx)
+		}()
+		Expect(cache.WaitForSync(ctx)).To(Succeed())
+
+		createApp("frontend", "credentials")
+		Eventually(func() []string {
+			mutex.Lock()
+			defer mutex.Unlock()
+			return added
+		}).Should(Equal([]string{"frontend"}))
+		cancel()
+		Eventually(done).Should(BeClosed())
+	})
+	It(`Returns an error if the project ID is missing`, func() {
+		cache, err := codeEngineService.NewCache(&codeenginev2.CacheOptions{})
+		Expect(err).ToNot(BeNil())
+		Expect(cache).To(BeNil())
+	})
+})
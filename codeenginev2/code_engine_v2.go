@@ -42,6 +42,9 @@ type CodeEngineV2 struct {
 	// The API version, in format `YYYY-MM-DD`. For the API behavior documented here, specify any date between `2021-03-31`
 	// and `2026-05-14`.
 	Version *string
+
+	// The cache of the project names that the helpers resolve, shared with all clones of the service.
+	projectResolver *ProjectResolver
 }
 
 // DefaultServiceURL is the default URL to make service requests to.
@@ -59,6 +62,9 @@ type CodeEngineV2Options struct {
 	// The API version, in format `YYYY-MM-DD`. For the API behavior documented here, specify any date between `2021-03-31`
 	// and `2026-05-14`.
 	Version *string
+
+	// The client-side limit of the rate and concurrency of the requests, shared with all clones of the service.
+	RateLimiter *RateLimiter
 }
 
 // NewCodeEngineV2UsingExternalConfig : constructs an instance of CodeEngineV2 with passed in options and external configuration.
@@ -81,7 +87,7 @@ func NewCodeEngineV2UsingExternalConfig(options *CodeEngineV2Options) (codeEngin
 		return
 	}
 
-	err = codeEngine.Service.ConfigureService(options.ServiceName)
+	err = codeEngine.configureService(options.ServiceName)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "client-config-error", common.GetComponentInfo())
 		return
@@ -122,8 +128,12 @@ func NewCodeEngineV2(options *CodeEngineV2Options) (service *CodeEngineV2, err e
 	}
 
 	service = &CodeEngineV2{
-		Service: baseService,
-		Version: options.Version,
+		Service:         baseService,
+		Version:         options.Version,
+		projectResolver: NewProjectResolver(""),
+	}
+	if options.RateLimiter != nil {
+		service.SetRateLimiter(options.RateLimiter)
 	}
 
 	return
@@ -131,7 +141,23 @@ func NewCodeEngineV2(options *CodeEngineV2Options) (service *CodeEngineV2, err e
 
 // GetServiceURLForRegion returns the service URL to be used for the specified region
 func GetServiceURLForRegion(region string) (string, error) {
-	return "", core.SDKErrorf(nil, "service does not support regional URLs", "no-regional-support", common.GetComponentInfo())
+	var endpoints = map[string]string{
+		"au-syd":   "https://api.au-syd.codeengine.cloud.ibm.com/v2",   // The server for IBM Cloud Code Engine in the au-syd region.
+		"br-sao":   "https://api.br-sao.codeengine.cloud.ibm.com/v2",   // The server for IBM Cloud Code Engine in the br-sao region.
+		"ca-tor":   "https://api.ca-tor.codeengine.cloud.ibm.com/v2",   // The server for IBM Cloud Code Engine in the ca-tor region.
+		"eu-de":    "https://api.eu-de.codeengine.cloud.ibm.com/v2",    // The server for IBM Cloud Code Engine in the eu-de region.
+		"eu-es":    "https://api.eu-es.codeengine.cloud.ibm.com/v2",    // The server for IBM Cloud Code Engine in the eu-es region.
+		"eu-gb":    "https://api.eu-gb.codeengine.cloud.ibm.com/v2",    // The server for IBM Cloud Code Engine in the eu-gb region.
+		"jp-osa":   "https://api.jp-osa.codeengine.cloud.ibm.com/v2",   // The server for IBM Cloud Code Engine in the jp-osa region.
+		"jp-tok":   "https://api.jp-tok.codeengine.cloud.ibm.com/v2",   // The server for IBM Cloud Code Engine in the jp-tok region.
+		"us-east":  "https://api.us-east.codeengine.cloud.ibm.com/v2",  // The server for IBM Cloud Code Engine in the us-east region.
+		"us-south": "https://api.us-south.codeengine.cloud.ibm.com/v2", // The server for IBM Cloud Code Engine in the us-south region.
+	}
+
+	if url, ok := endpoints[region]; ok {
+		return url, nil
+	}
+	return "", core.SDKErrorf(nil, fmt.Sprintf("service URL for region '%s' not found", region), "invalid-region", common.GetComponentInfo())
 }
 
 // Clone makes a copy of "codeEngine" suitable for processing requests.
@@ -146,11 +172,17 @@ func (codeEngine *CodeEngineV2) Clone() *CodeEngineV2 {
 
 // SetServiceURL sets the service URL
 func (codeEngine *CodeEngineV2) SetServiceURL(url string) error {
+	previousURL := codeEngine.Service.GetServiceURL()
 	err := codeEngine.Service.SetServiceURL(url)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "url-set-error", common.GetComponentInfo())
+		return err
+	}
+	// The cached project IDs belong to the previous endpoint, so the service gets a new resolver of the same region.
+	if codeEngine.projectResolver != nil && codeEngine.Service.GetServiceURL() != previousURL {
+		codeEngine.projectResolver = NewProjectResolver(codeEngine.projectResolver.region)
 	}
-	return err
+	return nil
 }
 
 // GetServiceURL returns the service URL
@@ -177,6 +209,8 @@ func (codeEngine *CodeEngineV2) GetEnableGzipCompression() bool {
 // If either parameter is specified as 0, then a default value is used instead.
 func (codeEngine *CodeEngineV2) EnableRetries(maxRetries int, maxRetryInterval time.Duration) {
 	codeEngine.Service.EnableRetries(maxRetries, maxRetryInterval)
+	// The transport layers, e.g. the tracing, observe the attempts of each call through the hooks of the retryable client.
+	codeEngine.hookRetries()
 }
 
 // DisableRetries disables automatic retries for requests invoked for this service instance.
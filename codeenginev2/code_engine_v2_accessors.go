@@ -0,0 +1,328 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by apigen from code_engine_v2.go. DO NOT EDIT.
+
+package codeenginev2
+
+import (
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// GetName returns the Name field of the project, or an empty string if it is not set.
+func (project Project) GetName() string {
+	return core.StringNilMapper(project.Name)
+}
+
+// GetStatus returns the Status field of the project, or an empty string if it is not set.
+func (project Project) GetStatus() string {
+	return core.StringNilMapper(project.Status)
+}
+
+// GetCreatedAt returns the CreatedAt field of the project, or an empty string if it is not set.
+func (project Project) GetCreatedAt() string {
+	return core.StringNilMapper(project.CreatedAt)
+}
+
+// GetName returns the Name field of the app, or an empty string if it is not set.
+func (app App) GetName() string {
+	return core.StringNilMapper(app.Name)
+}
+
+// GetStatus returns the Status field of the app, or an empty string if it is not set.
+func (app App) GetStatus() string {
+	return core.StringNilMapper(app.Status)
+}
+
+// GetCreatedAt returns the CreatedAt field of the app, or an empty string if it is not set.
+func (app App) GetCreatedAt() string {
+	return core.StringNilMapper(app.CreatedAt)
+}
+
+// ReferencedImages returns the images that the app runs or builds.
+func (app App) ReferencedImages() []string {
+	return referencedNames(app.ImageReference)
+}
+
+// ReferencedBuilds returns the builds that the app has been created from.
+func (app App) ReferencedBuilds() []string {
+	return referencedNames(app.Build)
+}
+
+// ReferencedSecrets returns the secrets that the app uses, with duplicates.
+func (app App) ReferencedSecrets() []string {
+	return append(referencedNames(app.ImageSecret),
+		mountedReferences(app.RunEnvVariables, app.RunVolumeMounts, EnvVar_Type_SecretFullReference, EnvVar_Type_SecretKeyReference, VolumeMount_Type_Secret)...)
+}
+
+// ReferencedConfigMaps returns the config maps that the app uses, with duplicates.
+func (app App) ReferencedConfigMaps() []string {
+	return mountedReferences(app.RunEnvVariables, app.RunVolumeMounts, EnvVar_Type_ConfigMapFullReference, EnvVar_Type_ConfigMapKeyReference, VolumeMount_Type_ConfigMap)
+}
+
+// GetName returns the Name field of the app instance, or an empty string if it is not set.
+func (appInstance AppInstance) GetName() string {
+	return core.StringNilMapper(appInstance.Name)
+}
+
+// GetStatus returns the Status field of the app instance, or an empty string if it is not set.
+func (appInstance AppInstance) GetStatus() string {
+	return core.StringNilMapper(appInstance.Status)
+}
+
+// GetCreatedAt returns the CreatedAt field of the app instance, or an empty string if it is not set.
+func (appInstance AppInstance) GetCreatedAt() string {
+	return core.StringNilMapper(appInstance.CreatedAt)
+}
+
+// GetName returns the Name field of the app revision, or an empty string if it is not set.
+func (appRevision AppRevision) GetName() string {
+	return core.StringNilMapper(appRevision.Name)
+}
+
+// GetStatus returns the Status field of the app revision, or an empty string if it is not set.
+func (appRevision AppRevision) GetStatus() string {
+	return core.StringNilMapper(appRevision.Status)
+}
+
+// GetCreatedAt returns the CreatedAt field of the app revision, or an empty string if it is not set.
+func (appRevision AppRevision) GetCreatedAt() string {
+	return core.StringNilMapper(appRevision.CreatedAt)
+}
+
+// ReferencedImages returns the images that the app revision runs or builds.
+func (appRevision AppRevision) ReferencedImages() []string {
+	return referencedNames(appRevision.ImageReference)
+}
+
+// ReferencedSecrets returns the secrets that the app revision uses, with duplicates.
+func (appRevision AppRevision) ReferencedSecrets() []string {
+	return append(referencedNames(appRevision.ImageSecret),
+		mountedReferences(appRevision.RunEnvVariables, appRevision.RunVolumeMounts, EnvVar_Type_SecretFullReference, EnvVar_Type_SecretKeyReference, VolumeMount_Type_Secret)...)
+}
+
+// ReferencedConfigMaps returns the config maps that the app revision uses, with duplicates.
+func (appRevision AppRevision) ReferencedConfigMaps() []string {
+	return mountedReferences(appRevision.RunEnvVariables, appRevision.RunVolumeMounts, EnvVar_Type_ConfigMapFullReference, EnvVar_Type_ConfigMapKeyReference, VolumeMount_Type_ConfigMap)
+}
+
+// GetName returns the Name field of the job run, or an empty string if it is not set.
+func (jobRun JobRun) GetName() string {
+	return core.StringNilMapper(jobRun.Name)
+}
+
+// GetStatus returns the Status field of the job run, or an empty string if it is not set.
+func (jobRun JobRun) GetStatus() string {
+	return core.StringNilMapper(jobRun.Status)
+}
+
+// GetCreatedAt returns the CreatedAt field of the job run, or an empty string if it is not set.
+func (jobRun JobRun) GetCreatedAt() string {
+	return core.StringNilMapper(jobRun.CreatedAt)
+}
+
+// GetJobName returns the JobName field of the job run, or an empty string if it is not set.
+func (jobRun JobRun) GetJobName() string {
+	return core.StringNilMapper(jobRun.JobName)
+}
+
+// ReferencedImages returns the images that the job run runs or builds.
+func (jobRun JobRun) ReferencedImages() []string {
+	return referencedNames(jobRun.ImageReference)
+}
+
+// ReferencedSecrets returns the secrets that the job run uses, with duplicates.
+func (jobRun JobRun) ReferencedSecrets() []string {
+	return append(referencedNames(jobRun.ImageSecret),
+		mountedReferences(jobRun.RunEnvVariables, jobRun.RunVolumeMounts, EnvVar_Type_SecretFullReference, EnvVar_Type_SecretKeyReference, VolumeMount_Type_Secret)...)
+}
+
+// ReferencedConfigMaps returns the config maps that the job run uses, with duplicates.
+func (jobRun JobRun) ReferencedConfigMaps() []string {
+	return mountedReferences(jobRun.RunEnvVariables, jobRun.RunVolumeMounts, EnvVar_Type_ConfigMapFullReference, EnvVar_Type_ConfigMapKeyReference, VolumeMount_Type_ConfigMap)
+}
+
+// GetName returns the Name field of the job, or an empty string if it is not set.
+func (job Job) GetName() string {
+	return core.StringNilMapper(job.Name)
+}
+
+// GetCreatedAt returns the CreatedAt field of the job, or an empty string if it is not set.
+func (job Job) GetCreatedAt() string {
+	return core.StringNilMapper(job.CreatedAt)
+}
+
+// ReferencedImages returns the images that the job runs or builds.
+func (job Job) ReferencedImages() []string {
+	return referencedNames(job.ImageReference)
+}
+
+// ReferencedBuilds returns the builds that the job has been created from.
+func (job Job) ReferencedBuilds() []string {
+	return referencedNames(job.Build)
+}
+
+// ReferencedSecrets returns the secrets that the job uses, with duplicates.
+func (job Job) ReferencedSecrets() []string {
+	return append(referencedNames(job.ImageSecret),
+		mountedReferences(job.RunEnvVariables, job.RunVolumeMounts, EnvVar_Type_SecretFullReference, EnvVar_Type_SecretKeyReference, VolumeMount_Type_Secret)...)
+}
+
+// ReferencedConfigMaps returns the config maps that the job uses, with duplicates.
+func (job Job) ReferencedConfigMaps() []string {
+	return mountedReferences(job.RunEnvVariables, job.RunVolumeMounts, EnvVar_Type_ConfigMapFullReference, EnvVar_Type_ConfigMapKeyReference, VolumeMount_Type_ConfigMap)
+}
+
+// GetName returns the Name field of the function, or an empty string if it is not set.
+func (function Function) GetName() string {
+	return core.StringNilMapper(function.Name)
+}
+
+// GetStatus returns the Status field of the function, or an empty string if it is not set.
+func (function Function) GetStatus() string {
+	return core.StringNilMapper(function.Status)
+}
+
+// GetCreatedAt returns the CreatedAt field of the function, or an empty string if it is not set.
+func (function Function) GetCreatedAt() string {
+	return core.StringNilMapper(function.CreatedAt)
+}
+
+// ReferencedSecrets returns the secrets that the function uses, with duplicates.
+func (function Function) ReferencedSecrets() []string {
+	return append(referencedNames(function.CodeSecret),
+		mountedReferences(function.RunEnvVariables, nil, EnvVar_Type_SecretFullReference, EnvVar_Type_SecretKeyReference, VolumeMount_Type_Secret)...)
+}
+
+// ReferencedConfigMaps returns the config maps that the function uses, with duplicates.
+func (function Function) ReferencedConfigMaps() []string {
+	return mountedReferences(function.RunEnvVariables, nil, EnvVar_Type_ConfigMapFullReference, EnvVar_Type_ConfigMapKeyReference, VolumeMount_Type_ConfigMap)
+}
+
+// GetStatus returns the Status field of the binding, or an empty string if it is not set.
+func (binding Binding) GetStatus() string {
+	return core.StringNilMapper(binding.Status)
+}
+
+// ReferencedSecrets returns the secrets that the binding uses, with duplicates.
+func (binding Binding) ReferencedSecrets() []string {
+	return referencedNames(binding.SecretName)
+}
+
+// GetName returns the Name field of the build run, or an empty string if it is not set.
+func (buildRun BuildRun) GetName() string {
+	return core.StringNilMapper(buildRun.Name)
+}
+
+// GetStatus returns the Status field of the build run, or an empty string if it is not set.
+func (buildRun BuildRun) GetStatus() string {
+	return core.StringNilMapper(buildRun.Status)
+}
+
+// GetCreatedAt returns the CreatedAt field of the build run, or an empty string if it is not set.
+func (buildRun BuildRun) GetCreatedAt() string {
+	return core.StringNilMapper(buildRun.CreatedAt)
+}
+
+// ReferencedImages returns the images that the build run runs or builds.
+func (buildRun BuildRun) ReferencedImages() []string {
+	return referencedNames(buildRun.OutputImage)
+}
+
+// ReferencedBuilds returns the builds that the build run has been created from.
+func (buildRun BuildRun) ReferencedBuilds() []string {
+	return referencedNames(buildRun.BuildName)
+}
+
+// ReferencedSecrets returns the secrets that the build run uses, with duplicates.
+func (buildRun BuildRun) ReferencedSecrets() []string {
+	return referencedNames(buildRun.OutputSecret, buildRun.SourceSecret)
+}
+
+// GetName returns the Name field of the build, or an empty string if it is not set.
+func (build Build) GetName() string {
+	return core.StringNilMapper(build.Name)
+}
+
+// GetStatus returns the Status field of the build, or an empty string if it is not set.
+func (build Build) GetStatus() string {
+	return core.StringNilMapper(build.Status)
+}
+
+// GetCreatedAt returns the CreatedAt field of the build, or an empty string if it is not set.
+func (build Build) GetCreatedAt() string {
+	return core.StringNilMapper(build.CreatedAt)
+}
+
+// ReferencedImages returns the images that the build runs or builds.
+func (build Build) ReferencedImages() []string {
+	return referencedNames(build.OutputImage)
+}
+
+// ReferencedSecrets returns the secrets that the build uses, with duplicates.
+func (build Build) ReferencedSecrets() []string {
+	return referencedNames(build.OutputSecret, build.SourceSecret)
+}
+
+// GetName returns the Name field of the domain mapping, or an empty string if it is not set.
+func (domainMapping DomainMapping) GetName() string {
+	return core.StringNilMapper(domainMapping.Name)
+}
+
+// GetStatus returns the Status field of the domain mapping, or an empty string if it is not set.
+func (domainMapping DomainMapping) GetStatus() string {
+	return core.StringNilMapper(domainMapping.Status)
+}
+
+// GetCreatedAt returns the CreatedAt field of the domain mapping, or an empty string if it is not set.
+func (domainMapping DomainMapping) GetCreatedAt() string {
+	return core.StringNilMapper(domainMapping.CreatedAt)
+}
+
+// ReferencedSecrets returns the secrets that the domain mapping uses, with duplicates.
+func (domainMapping DomainMapping) ReferencedSecrets() []string {
+	return referencedNames(domainMapping.TlsSecret)
+}
+
+// GetName returns the Name field of the config map, or an empty string if it is not set.
+func (configMap ConfigMap) GetName() string {
+	return core.StringNilMapper(configMap.Name)
+}
+
+// GetCreatedAt returns the CreatedAt field of the config map, or an empty string if it is not set.
+func (configMap ConfigMap) GetCreatedAt() string {
+	return core.StringNilMapper(configMap.CreatedAt)
+}
+
+// GetName returns the Name field of the secret, or an empty string if it is not set.
+func (secret Secret) GetName() string {
+	return core.StringNilMapper(secret.Name)
+}
+
+// GetCreatedAt returns the CreatedAt field of the secret, or an empty string if it is not set.
+func (secret Secret) GetCreatedAt() string {
+	return core.StringNilMapper(secret.CreatedAt)
+}
+
+// GetName returns the Name field of the persistent data store, or an empty string if it is not set.
+func (persistentDataStore PersistentDataStore) GetName() string {
+	return core.StringNilMapper(persistentDataStore.Name)
+}
+
+// GetCreatedAt returns the CreatedAt field of the persistent data store, or an empty string if it is not set.
+func (persistentDataStore PersistentDataStore) GetCreatedAt() string {
+	return core.StringNilMapper(persistentDataStore.CreatedAt)
+}
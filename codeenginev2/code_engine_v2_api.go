@@ -0,0 +1,574 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by apigen from code_engine_v2.go. DO NOT EDIT.
+
+package codeenginev2
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// CodeEngineAPI : The operations of all resource groups of the Code Engine API, which are implemented by CodeEngineV2.
+type CodeEngineAPI interface {
+	ProjectsAPI
+	AllowedOutboundDestinationsAPI
+	AppsAPI
+	JobRunsAPI
+	JobsAPI
+	FunctionsAPI
+	BindingsAPI
+	BuildRunsAPI
+	BuildsAPI
+	DomainMappingsAPI
+	ConfigMapsAPI
+	SecretsAPI
+	PersistentDataStoresAPI
+}
+
+// ProjectsAPI : The operations of the Projects resource group.
+type ProjectsAPI interface {
+	// ListProjects : List all projects
+	// List all projects in the current account.
+	ListProjects(listProjectsOptions *ListProjectsOptions) (result *ProjectList, response *core.DetailedResponse, err error)
+
+	// ListProjectsWithContext is an alternate form of the ListProjects method which supports a Context parameter
+	ListProjectsWithContext(ctx context.Context, listProjectsOptions *ListProjectsOptions) (result *ProjectList, response *core.DetailedResponse, err error)
+
+	// CreateProject : Create a project
+	// Create a Code Engine project on IBM Cloud. The project will be created in the region that corresponds to the API
+	// endpoint that is being called.
+	CreateProject(createProjectOptions *CreateProjectOptions) (result *Project, response *core.DetailedResponse, err error)
+
+	// CreateProjectWithContext is an alternate form of the CreateProject method which supports a Context parameter
+	CreateProjectWithContext(ctx context.Context, createProjectOptions *CreateProjectOptions) (result *Project, response *core.DetailedResponse, err error)
+
+	// DeleteProject : Delete a project
+	// Delete a project.
+	DeleteProject(deleteProjectOptions *DeleteProjectOptions) (response *core.DetailedResponse, err error)
+
+	// DeleteProjectWithContext is an alternate form of the DeleteProject method which supports a Context parameter
+	DeleteProjectWithContext(ctx context.Context, deleteProjectOptions *DeleteProjectOptions) (response *core.DetailedResponse, err error)
+
+	// GetProject : Get a project
+	// Display the details of a single project.
+	GetProject(getProjectOptions *GetProjectOptions) (result *Project, response *core.DetailedResponse, err error)
+
+	// GetProjectWithContext is an alternate form of the GetProject method which supports a Context parameter
+	GetProjectWithContext(ctx context.Context, getProjectOptions *GetProjectOptions) (result *Project, response *core.DetailedResponse, err error)
+
+	// GetProjectEgressIps : List egress IP addresses
+	// Lists all egress IP addresses (public and private) that are used by components running in this project. For
+	// information about using egress IP addresses, see [Code Engine public and private IP
+	// addresses](https://cloud.ibm.com/docs/codeengine?topic=codeengine-network-addresses).
+	GetProjectEgressIps(getProjectEgressIpsOptions *GetProjectEgressIpsOptions) (result *ProjectEgressIPAddresses, response *core.DetailedResponse, err error)
+
+	// GetProjectEgressIpsWithContext is an alternate form of the GetProjectEgressIps method which supports a Context parameter
+	GetProjectEgressIpsWithContext(ctx context.Context, getProjectEgressIpsOptions *GetProjectEgressIpsOptions) (result *ProjectEgressIPAddresses, response *core.DetailedResponse, err error)
+
+	// GetProjectStatusDetails : Get the status details for a project
+	// Retrieves status details about the given project.
+	GetProjectStatusDetails(getProjectStatusDetailsOptions *GetProjectStatusDetailsOptions) (result *ProjectStatusDetails, response *core.DetailedResponse, err error)
+
+	// GetProjectStatusDetailsWithContext is an alternate form of the GetProjectStatusDetails method which supports a Context parameter
+	GetProjectStatusDetailsWithContext(ctx context.Context, getProjectStatusDetailsOptions *GetProjectStatusDetailsOptions) (result *ProjectStatusDetails, response *core.DetailedResponse, err error)
+}
+
+// AllowedOutboundDestinationsAPI : The operations of the AllowedOutboundDestinations resource group.
+type AllowedOutboundDestinationsAPI interface {
+	// ListAllowedOutboundDestinations : List allowed outbound destinations
+	// List all allowed outbound destinations in a project.
+	ListAllowedOutboundDestinations(listAllowedOutboundDestinationsOptions *ListAllowedOutboundDestinationsOptions) (result *AllowedOutboundDestinationList, response *core.DetailedResponse, err error)
+
+	// ListAllowedOutboundDestinationsWithContext is an alternate form of the ListAllowedOutboundDestinations method which supports a Context parameter
+	ListAllowedOutboundDestinationsWithContext(ctx context.Context, listAllowedOutboundDestinationsOptions *ListAllowedOutboundDestinationsOptions) (result *AllowedOutboundDestinationList, response *core.DetailedResponse, err error)
+
+	// CreateAllowedOutboundDestination : Create an allowed outbound destination
+	// Create an allowed outbound destination.
+	CreateAllowedOutboundDestination(createAllowedOutboundDestinationOptions *CreateAllowedOutboundDestinationOptions) (result AllowedOutboundDestinationIntf, response *core.DetailedResponse, err error)
+
+	// CreateAllowedOutboundDestinationWithContext is an alternate form of the CreateAllowedOutboundDestination method which supports a Context parameter
+	CreateAllowedOutboundDestinationWithContext(ctx context.Context, createAllowedOutboundDestinationOptions *CreateAllowedOutboundDestinationOptions) (result AllowedOutboundDestinationIntf, response *core.DetailedResponse, err error)
+
+	// DeleteAllowedOutboundDestination : Delete an allowed outbound destination
+	// Delete an allowed outbound destination.
+	DeleteAllowedOutboundDestination(deleteAllowedOutboundDestinationOptions *DeleteAllowedOutboundDestinationOptions) (response *core.DetailedResponse, err error)
+
+	// DeleteAllowedOutboundDestinationWithContext is an alternate form of the DeleteAllowedOutboundDestination method which supports a Context parameter
+	DeleteAllowedOutboundDestinationWithContext(ctx context.Context, deleteAllowedOutboundDestinationOptions *DeleteAllowedOutboundDestinationOptions) (response *core.DetailedResponse, err error)
+
+	// GetAllowedOutboundDestination : Get an allowed outbound destination
+	// Display the details of an allowed outbound destination.
+	GetAllowedOutboundDestination(getAllowedOutboundDestinationOptions *GetAllowedOutboundDestinationOptions) (result AllowedOutboundDestinationIntf, response *core.DetailedResponse, err error)
+
+	// GetAllowedOutboundDestinationWithContext is an alternate form of the GetAllowedOutboundDestination method which supports a Context parameter
+	GetAllowedOutboundDestinationWithContext(ctx context.Context, getAllowedOutboundDestinationOptions *GetAllowedOutboundDestinationOptions) (result AllowedOutboundDestinationIntf, response *core.DetailedResponse, err error)
+
+	// UpdateAllowedOutboundDestination : Update an allowed outbound destination
+	// Update an allowed outbound destination.
+	UpdateAllowedOutboundDestination(updateAllowedOutboundDestinationOptions *UpdateAllowedOutboundDestinationOptions) (result AllowedOutboundDestinationIntf, response *core.DetailedResponse, err error)
+
+	// UpdateAllowedOutboundDestinationWithContext is an alternate form of the UpdateAllowedOutboundDestination method which supports a Context parameter
+	UpdateAllowedOutboundDestinationWithContext(ctx context.Context, updateAllowedOutboundDestinationOptions *UpdateAllowedOutboundDestinationOptions) (result AllowedOutboundDestinationIntf, response *core.DetailedResponse, err error)
+}
+
+// AppsAPI : The operations of the Apps resource group.
+type AppsAPI interface {
+	// ListApps : List applications
+	// List all applications in a project.
+	ListApps(listAppsOptions *ListAppsOptions) (result *AppList, response *core.DetailedResponse, err error)
+
+	// ListAppsWithContext is an alternate form of the ListApps method which supports a Context parameter
+	ListAppsWithContext(ctx context.Context, listAppsOptions *ListAppsOptions) (result *AppList, response *core.DetailedResponse, err error)
+
+	// CreateApp : Create an application
+	// Create an application.
+	CreateApp(createAppOptions *CreateAppOptions) (result *App, response *core.DetailedResponse, err error)
+
+	// CreateAppWithContext is an alternate form of the CreateApp method which supports a Context parameter
+	CreateAppWithContext(ctx context.Context, createAppOptions *CreateAppOptions) (result *App, response *core.DetailedResponse, err error)
+
+	// ListAppInstances : List application instances
+	// List all instances of an application.
+	ListAppInstances(listAppInstancesOptions *ListAppInstancesOptions) (result *AppInstanceList, response *core.DetailedResponse, err error)
+
+	// ListAppInstancesWithContext is an alternate form of the ListAppInstances method which supports a Context parameter
+	ListAppInstancesWithContext(ctx context.Context, listAppInstancesOptions *ListAppInstancesOptions) (result *AppInstanceList, response *core.DetailedResponse, err error)
+
+	// ListAppRevisions : List application revisions
+	// List all application revisions in a particular application.
+	ListAppRevisions(listAppRevisionsOptions *ListAppRevisionsOptions) (result *AppRevisionList, response *core.DetailedResponse, err error)
+
+	// ListAppRevisionsWithContext is an alternate form of the ListAppRevisions method which supports a Context parameter
+	ListAppRevisionsWithContext(ctx context.Context, listAppRevisionsOptions *ListAppRevisionsOptions) (result *AppRevisionList, response *core.DetailedResponse, err error)
+
+	// DeleteAppRevision : Delete an application revision
+	// Delete an application revision.
+	DeleteAppRevision(deleteAppRevisionOptions *DeleteAppRevisionOptions) (response *core.DetailedResponse, err error)
+
+	// DeleteAppRevisionWithContext is an alternate form of the DeleteAppRevision method which supports a Context parameter
+	DeleteAppRevisionWithContext(ctx context.Context, deleteAppRevisionOptions *DeleteAppRevisionOptions) (response *core.DetailedResponse, err error)
+
+	// GetAppRevision : Get an application revision
+	// Display the details of an application revision.
+	GetAppRevision(getAppRevisionOptions *GetAppRevisionOptions) (result *AppRevision, response *core.DetailedResponse, err error)
+
+	// GetAppRevisionWithContext is an alternate form of the GetAppRevision method which supports a Context parameter
+	GetAppRevisionWithContext(ctx context.Context, getAppRevisionOptions *GetAppRevisionOptions) (result *AppRevision, response *core.DetailedResponse, err error)
+
+	// DeleteApp : Delete an application
+	// Delete an application.
+	DeleteApp(deleteAppOptions *DeleteAppOptions) (response *core.DetailedResponse, err error)
+
+	// DeleteAppWithContext is an alternate form of the DeleteApp method which supports a Context parameter
+	DeleteAppWithContext(ctx context.Context, deleteAppOptions *DeleteAppOptions) (response *core.DetailedResponse, err error)
+
+	// GetApp : Get an application
+	// Display the details of an application.
+	GetApp(getAppOptions *GetAppOptions) (result *App, response *core.DetailedResponse, err error)
+
+	// GetAppWithContext is an alternate form of the GetApp method which supports a Context parameter
+	GetAppWithContext(ctx context.Context, getAppOptions *GetAppOptions) (result *App, response *core.DetailedResponse, err error)
+
+	// UpdateApp : Update an application
+	// An application contains one or more revisions. A revision represents an immutable version of the configuration
+	// properties of the application. Each update of an application configuration property creates a new revision of the
+	// application. [Learn more](https://cloud.ibm.com/docs/codeengine?topic=codeengine-update-app).
+	UpdateApp(updateAppOptions *UpdateAppOptions) (result *App, response *core.DetailedResponse, err error)
+
+	// UpdateAppWithContext is an alternate form of the UpdateApp method which supports a Context parameter
+	UpdateAppWithContext(ctx context.Context, updateAppOptions *UpdateAppOptions) (result *App, response *core.DetailedResponse, err error)
+}
+
+// JobRunsAPI : The operations of the JobRuns resource group.
+type JobRunsAPI interface {
+	// ListJobRuns : List job runs
+	// List all job runs in a project.
+	ListJobRuns(listJobRunsOptions *ListJobRunsOptions) (result *JobRunList, response *core.DetailedResponse, err error)
+
+	// ListJobRunsWithContext is an alternate form of the ListJobRuns method which supports a Context parameter
+	ListJobRunsWithContext(ctx context.Context, listJobRunsOptions *ListJobRunsOptions) (result *JobRunList, response *core.DetailedResponse, err error)
+
+	// CreateJobRun : Create a job run
+	// Create an job run.
+	CreateJobRun(createJobRunOptions *CreateJobRunOptions) (result *JobRun, response *core.DetailedResponse, err error)
+
+	// CreateJobRunWithContext is an alternate form of the CreateJobRun method which supports a Context parameter
+	CreateJobRunWithContext(ctx context.Context, createJobRunOptions *CreateJobRunOptions) (result *JobRun, response *core.DetailedResponse, err error)
+
+	// DeleteJobRun : Delete a job run
+	// Delete a job run.
+	DeleteJobRun(deleteJobRunOptions *DeleteJobRunOptions) (response *core.DetailedResponse, err error)
+
+	// DeleteJobRunWithContext is an alternate form of the DeleteJobRun method which supports a Context parameter
+	DeleteJobRunWithContext(ctx context.Context, deleteJobRunOptions *DeleteJobRunOptions) (response *core.DetailedResponse, err error)
+
+	// GetJobRun : Get a job run
+	// Display the details of a job run.
+	GetJobRun(getJobRunOptions *GetJobRunOptions) (result *JobRun, response *core.DetailedResponse, err error)
+
+	// GetJobRunWithContext is an alternate form of the GetJobRun method which supports a Context parameter
+	GetJobRunWithContext(ctx context.Context, getJobRunOptions *GetJobRunOptions) (result *JobRun, response *core.DetailedResponse, err error)
+}
+
+// JobsAPI : The operations of the Jobs resource group.
+type JobsAPI interface {
+	// ListJobs : List jobs
+	// List all jobs in a project.
+	ListJobs(listJobsOptions *ListJobsOptions) (result *JobList, response *core.DetailedResponse, err error)
+
+	// ListJobsWithContext is an alternate form of the ListJobs method which supports a Context parameter
+	ListJobsWithContext(ctx context.Context, listJobsOptions *ListJobsOptions) (result *JobList, response *core.DetailedResponse, err error)
+
+	// CreateJob : Create a job
+	// Create a job.
+	CreateJob(createJobOptions *CreateJobOptions) (result *Job, response *core.DetailedResponse, err error)
+
+	// CreateJobWithContext is an alternate form of the CreateJob method which supports a Context parameter
+	CreateJobWithContext(ctx context.Context, createJobOptions *CreateJobOptions) (result *Job, response *core.DetailedResponse, err error)
+
+	// DeleteJob : Delete a job
+	// Delete a job.
+	DeleteJob(deleteJobOptions *DeleteJobOptions) (response *core.DetailedResponse, err error)
+
+	// DeleteJobWithContext is an alternate form of the DeleteJob method which supports a Context parameter
+	DeleteJobWithContext(ctx context.Context, deleteJobOptions *DeleteJobOptions) (response *core.DetailedResponse, err error)
+
+	// GetJob : Get a job
+	// Display the details of a job.
+	GetJob(getJobOptions *GetJobOptions) (result *Job, response *core.DetailedResponse, err error)
+
+	// GetJobWithContext is an alternate form of the GetJob method which supports a Context parameter
+	GetJobWithContext(ctx context.Context, getJobOptions *GetJobOptions) (result *Job, response *core.DetailedResponse, err error)
+
+	// UpdateJob : Update a job
+	// Update the given job.
+	UpdateJob(updateJobOptions *UpdateJobOptions) (result *Job, response *core.DetailedResponse, err error)
+
+	// UpdateJobWithContext is an alternate form of the UpdateJob method which supports a Context parameter
+	UpdateJobWithContext(ctx context.Context, updateJobOptions *UpdateJobOptions) (result *Job, response *core.DetailedResponse, err error)
+}
+
+// FunctionsAPI : The operations of the Functions resource group.
+type FunctionsAPI interface {
+	// ListFunctionRuntimes : List the function runtimes
+	// List all valid function runtimes.
+	ListFunctionRuntimes(listFunctionRuntimesOptions *ListFunctionRuntimesOptions) (result *FunctionRuntimeList, response *core.DetailedResponse, err error)
+
+	// ListFunctionRuntimesWithContext is an alternate form of the ListFunctionRuntimes method which supports a Context parameter
+	ListFunctionRuntimesWithContext(ctx context.Context, listFunctionRuntimesOptions *ListFunctionRuntimesOptions) (result *FunctionRuntimeList, response *core.DetailedResponse, err error)
+
+	// ListFunctions : List functions
+	// List all functions in a project.
+	ListFunctions(listFunctionsOptions *ListFunctionsOptions) (result *FunctionList, response *core.DetailedResponse, err error)
+
+	// ListFunctionsWithContext is an alternate form of the ListFunctions method which supports a Context parameter
+	ListFunctionsWithContext(ctx context.Context, listFunctionsOptions *ListFunctionsOptions) (result *FunctionList, response *core.DetailedResponse, err error)
+
+	// CreateFunction : Create a function
+	// Create a function.
+	CreateFunction(createFunctionOptions *CreateFunctionOptions) (result *Function, response *core.DetailedResponse, err error)
+
+	// CreateFunctionWithContext is an alternate form of the CreateFunction method which supports a Context parameter
+	CreateFunctionWithContext(ctx context.Context, createFunctionOptions *CreateFunctionOptions) (result *Function, response *core.DetailedResponse, err error)
+
+	// DeleteFunction : Delete a function
+	// Delete a function.
+	DeleteFunction(deleteFunctionOptions *DeleteFunctionOptions) (response *core.DetailedResponse, err error)
+
+	// DeleteFunctionWithContext is an alternate form of the DeleteFunction method which supports a Context parameter
+	DeleteFunctionWithContext(ctx context.Context, deleteFunctionOptions *DeleteFunctionOptions) (response *core.DetailedResponse, err error)
+
+	// GetFunction : Get a function
+	// Display the details of a function.
+	GetFunction(getFunctionOptions *GetFunctionOptions) (result *Function, response *core.DetailedResponse, err error)
+
+	// GetFunctionWithContext is an alternate form of the GetFunction method which supports a Context parameter
+	GetFunctionWithContext(ctx context.Context, getFunctionOptions *GetFunctionOptions) (result *Function, response *core.DetailedResponse, err error)
+
+	// UpdateFunction : Update a function
+	// Update the given function.
+	UpdateFunction(updateFunctionOptions *UpdateFunctionOptions) (result *Function, response *core.DetailedResponse, err error)
+
+	// UpdateFunctionWithContext is an alternate form of the UpdateFunction method which supports a Context parameter
+	UpdateFunctionWithContext(ctx context.Context, updateFunctionOptions *UpdateFunctionOptions) (result *Function, response *core.DetailedResponse, err error)
+}
+
+// BindingsAPI : The operations of the Bindings resource group.
+type BindingsAPI interface {
+	// ListBindings : List bindings
+	// List all bindings in a project.
+	ListBindings(listBindingsOptions *ListBindingsOptions) (result *BindingList, response *core.DetailedResponse, err error)
+
+	// ListBindingsWithContext is an alternate form of the ListBindings method which supports a Context parameter
+	ListBindingsWithContext(ctx context.Context, listBindingsOptions *ListBindingsOptions) (result *BindingList, response *core.DetailedResponse, err error)
+
+	// CreateBinding : Create a binding
+	// Create a binding. Creating a service binding with a Code Engine app will update the app, creating a new revision. For
+	// more information see the [documentation](https://cloud.ibm.com/docs/codeengine?topic=codeengine-service-binding).
+	CreateBinding(createBindingOptions *CreateBindingOptions) (result *Binding, response *core.DetailedResponse, err error)
+
+	// CreateBindingWithContext is an alternate form of the CreateBinding method which supports a Context parameter
+	CreateBindingWithContext(ctx context.Context, createBindingOptions *CreateBindingOptions) (result *Binding, response *core.DetailedResponse, err error)
+
+	// DeleteBinding : Delete a binding
+	// Delete a binding.
+	DeleteBinding(deleteBindingOptions *DeleteBindingOptions) (response *core.DetailedResponse, err error)
+
+	// DeleteBindingWithContext is an alternate form of the DeleteBinding method which supports a Context parameter
+	DeleteBindingWithContext(ctx context.Context, deleteBindingOptions *DeleteBindingOptions) (response *core.DetailedResponse, err error)
+
+	// GetBinding : Get a binding
+	// Display the details of a binding.
+	GetBinding(getBindingOptions *GetBindingOptions) (result *Binding, response *core.DetailedResponse, err error)
+
+	// GetBindingWithContext is an alternate form of the GetBinding method which supports a Context parameter
+	GetBindingWithContext(ctx context.Context, getBindingOptions *GetBindingOptions) (result *Binding, response *core.DetailedResponse, err error)
+}
+
+// BuildRunsAPI : The operations of the BuildRuns resource group.
+type BuildRunsAPI interface {
+	// ListBuildRuns : List build runs
+	// List all build runs in a project.
+	ListBuildRuns(listBuildRunsOptions *ListBuildRunsOptions) (result *BuildRunList, response *core.DetailedResponse, err error)
+
+	// ListBuildRunsWithContext is an alternate form of the ListBuildRuns method which supports a Context parameter
+	ListBuildRunsWithContext(ctx context.Context, listBuildRunsOptions *ListBuildRunsOptions) (result *BuildRunList, response *core.DetailedResponse, err error)
+
+	// CreateBuildRun : Create a build run
+	// Create a build run.
+	CreateBuildRun(createBuildRunOptions *CreateBuildRunOptions) (result *BuildRun, response *core.DetailedResponse, err error)
+
+	// CreateBuildRunWithContext is an alternate form of the CreateBuildRun method which supports a Context parameter
+	CreateBuildRunWithContext(ctx context.Context, createBuildRunOptions *CreateBuildRunOptions) (result *BuildRun, response *core.DetailedResponse, err error)
+
+	// DeleteBuildRun : Delete a build run
+	// Delete a build run.
+	DeleteBuildRun(deleteBuildRunOptions *DeleteBuildRunOptions) (response *core.DetailedResponse, err error)
+
+	// DeleteBuildRunWithContext is an alternate form of the DeleteBuildRun method which supports a Context parameter
+	DeleteBuildRunWithContext(ctx context.Context, deleteBuildRunOptions *DeleteBuildRunOptions) (response *core.DetailedResponse, err error)
+
+	// GetBuildRun : Get a build run
+	// Display the details of a build run.
+	GetBuildRun(getBuildRunOptions *GetBuildRunOptions) (result *BuildRun, response *core.DetailedResponse, err error)
+
+	// GetBuildRunWithContext is an alternate form of the GetBuildRun method which supports a Context parameter
+	GetBuildRunWithContext(ctx context.Context, getBuildRunOptions *GetBuildRunOptions) (result *BuildRun, response *core.DetailedResponse, err error)
+}
+
+// BuildsAPI : The operations of the Builds resource group.
+type BuildsAPI interface {
+	// ListBuilds : List builds
+	// List all builds in a project.
+	ListBuilds(listBuildsOptions *ListBuildsOptions) (result *BuildList, response *core.DetailedResponse, err error)
+
+	// ListBuildsWithContext is an alternate form of the ListBuilds method which supports a Context parameter
+	ListBuildsWithContext(ctx context.Context, listBuildsOptions *ListBuildsOptions) (result *BuildList, response *core.DetailedResponse, err error)
+
+	// CreateBuild : Create a build
+	// Create a build.
+	CreateBuild(createBuildOptions *CreateBuildOptions) (result *Build, response *core.DetailedResponse, err error)
+
+	// CreateBuildWithContext is an alternate form of the CreateBuild method which supports a Context parameter
+	CreateBuildWithContext(ctx context.Context, createBuildOptions *CreateBuildOptions) (result *Build, response *core.DetailedResponse, err error)
+
+	// DeleteBuild : Delete a build
+	// Delete a build.
+	DeleteBuild(deleteBuildOptions *DeleteBuildOptions) (response *core.DetailedResponse, err error)
+
+	// DeleteBuildWithContext is an alternate form of the DeleteBuild method which supports a Context parameter
+	DeleteBuildWithContext(ctx context.Context, deleteBuildOptions *DeleteBuildOptions) (response *core.DetailedResponse, err error)
+
+	// GetBuild : Get a build
+	// Display the details of a build.
+	GetBuild(getBuildOptions *GetBuildOptions) (result *Build, response *core.DetailedResponse, err error)
+
+	// GetBuildWithContext is an alternate form of the GetBuild method which supports a Context parameter
+	GetBuildWithContext(ctx context.Context, getBuildOptions *GetBuildOptions) (result *Build, response *core.DetailedResponse, err error)
+
+	// UpdateBuild : Update a build
+	// Update a build.
+	UpdateBuild(updateBuildOptions *UpdateBuildOptions) (result *Build, response *core.DetailedResponse, err error)
+
+	// UpdateBuildWithContext is an alternate form of the UpdateBuild method which supports a Context parameter
+	UpdateBuildWithContext(ctx context.Context, updateBuildOptions *UpdateBuildOptions) (result *Build, response *core.DetailedResponse, err error)
+}
+
+// DomainMappingsAPI : The operations of the DomainMappings resource group.
+type DomainMappingsAPI interface {
+	// ListDomainMappings : List domain mappings
+	// List all domain mappings in a project.
+	ListDomainMappings(listDomainMappingsOptions *ListDomainMappingsOptions) (result *DomainMappingList, response *core.DetailedResponse, err error)
+
+	// ListDomainMappingsWithContext is an alternate form of the ListDomainMappings method which supports a Context parameter
+	ListDomainMappingsWithContext(ctx context.Context, listDomainMappingsOptions *ListDomainMappingsOptions) (result *DomainMappingList, response *core.DetailedResponse, err error)
+
+	// CreateDomainMapping : Create a domain mapping
+	// Create a domain mapping.
+	CreateDomainMapping(createDomainMappingOptions *CreateDomainMappingOptions) (result *DomainMapping, response *core.DetailedResponse, err error)
+
+	// CreateDomainMappingWithContext is an alternate form of the CreateDomainMapping method which supports a Context parameter
+	CreateDomainMappingWithContext(ctx context.Context, createDomainMappingOptions *CreateDomainMappingOptions) (result *DomainMapping, response *core.DetailedResponse, err error)
+
+	// DeleteDomainMapping : Delete a domain mapping
+	// Delete a domain mapping.
+	DeleteDomainMapping(deleteDomainMappingOptions *DeleteDomainMappingOptions) (response *core.DetailedResponse, err error)
+
+	// DeleteDomainMappingWithContext is an alternate form of the DeleteDomainMapping method which supports a Context parameter
+	DeleteDomainMappingWithContext(ctx context.Context, deleteDomainMappingOptions *DeleteDomainMappingOptions) (response *core.DetailedResponse, err error)
+
+	// GetDomainMapping : Get a domain mapping
+	// Get domain mapping.
+	GetDomainMapping(getDomainMappingOptions *GetDomainMappingOptions) (result *DomainMapping, response *core.DetailedResponse, err error)
+
+	// GetDomainMappingWithContext is an alternate form of the GetDomainMapping method which supports a Context parameter
+	GetDomainMappingWithContext(ctx context.Context, getDomainMappingOptions *GetDomainMappingOptions) (result *DomainMapping, response *core.DetailedResponse, err error)
+
+	// UpdateDomainMapping : Update a domain mapping
+	// Update a domain mapping.
+	UpdateDomainMapping(updateDomainMappingOptions *UpdateDomainMappingOptions) (result *DomainMapping, response *core.DetailedResponse, err error)
+
+	// UpdateDomainMappingWithContext is an alternate form of the UpdateDomainMapping method which supports a Context parameter
+	UpdateDomainMappingWithContext(ctx context.Context, updateDomainMappingOptions *UpdateDomainMappingOptions) (result *DomainMapping, response *core.DetailedResponse, err error)
+}
+
+// ConfigMapsAPI : The operations of the ConfigMaps resource group.
+type ConfigMapsAPI interface {
+	// ListConfigMaps : List config maps
+	// List all config maps in a project.
+	ListConfigMaps(listConfigMapsOptions *ListConfigMapsOptions) (result *ConfigMapList, response *core.DetailedResponse, err error)
+
+	// ListConfigMapsWithContext is an alternate form of the ListConfigMaps method which supports a Context parameter
+	ListConfigMapsWithContext(ctx context.Context, listConfigMapsOptions *ListConfigMapsOptions) (result *ConfigMapList, response *core.DetailedResponse, err error)
+
+	// CreateConfigMap : Create a config map
+	// Create a config map.
+	CreateConfigMap(createConfigMapOptions *CreateConfigMapOptions) (result *ConfigMap, response *core.DetailedResponse, err error)
+
+	// CreateConfigMapWithContext is an alternate form of the CreateConfigMap method which supports a Context parameter
+	CreateConfigMapWithContext(ctx context.Context, createConfigMapOptions *CreateConfigMapOptions) (result *ConfigMap, response *core.DetailedResponse, err error)
+
+	// DeleteConfigMap : Delete a config map
+	// Delete a config map.
+	DeleteConfigMap(deleteConfigMapOptions *DeleteConfigMapOptions) (response *core.DetailedResponse, err error)
+
+	// DeleteConfigMapWithContext is an alternate form of the DeleteConfigMap method which supports a Context parameter
+	DeleteConfigMapWithContext(ctx context.Context, deleteConfigMapOptions *DeleteConfigMapOptions) (response *core.DetailedResponse, err error)
+
+	// GetConfigMap : Get a config map
+	// Display the details of a config map.
+	GetConfigMap(getConfigMapOptions *GetConfigMapOptions) (result *ConfigMap, response *core.DetailedResponse, err error)
+
+	// GetConfigMapWithContext is an alternate form of the GetConfigMap method which supports a Context parameter
+	GetConfigMapWithContext(ctx context.Context, getConfigMapOptions *GetConfigMapOptions) (result *ConfigMap, response *core.DetailedResponse, err error)
+
+	// ReplaceConfigMap : Update a config map
+	// Update a config map.
+	ReplaceConfigMap(replaceConfigMapOptions *ReplaceConfigMapOptions) (result *ConfigMap, response *core.DetailedResponse, err error)
+
+	// ReplaceConfigMapWithContext is an alternate form of the ReplaceConfigMap method which supports a Context parameter
+	ReplaceConfigMapWithContext(ctx context.Context, replaceConfigMapOptions *ReplaceConfigMapOptions) (result *ConfigMap, response *core.DetailedResponse, err error)
+}
+
+// SecretsAPI : The operations of the Secrets resource group.
+type SecretsAPI interface {
+	// ListSecrets : List secrets
+	// List all secrets in a project.
+	ListSecrets(listSecretsOptions *ListSecretsOptions) (result *SecretList, response *core.DetailedResponse, err error)
+
+	// ListSecretsWithContext is an alternate form of the ListSecrets method which supports a Context parameter
+	ListSecretsWithContext(ctx context.Context, listSecretsOptions *ListSecretsOptions) (result *SecretList, response *core.DetailedResponse, err error)
+
+	// CreateSecret : Create a secret
+	// Create a secret.
+	CreateSecret(createSecretOptions *CreateSecretOptions) (result *Secret, response *core.DetailedResponse, err error)
+
+	// CreateSecretWithContext is an alternate form of the CreateSecret method which supports a Context parameter
+	CreateSecretWithContext(ctx context.Context, createSecretOptions *CreateSecretOptions) (result *Secret, response *core.DetailedResponse, err error)
+
+	// DeleteSecret : Delete a secret
+	// Delete a secret.
+	DeleteSecret(deleteSecretOptions *DeleteSecretOptions) (response *core.DetailedResponse, err error)
+
+	// DeleteSecretWithContext is an alternate form of the DeleteSecret method which supports a Context parameter
+	DeleteSecretWithContext(ctx context.Context, deleteSecretOptions *DeleteSecretOptions) (response *core.DetailedResponse, err error)
+
+	// GetSecret : Get a secret
+	// Get a secret.
+	GetSecret(getSecretOptions *GetSecretOptions) (result *Secret, response *core.DetailedResponse, err error)
+
+	// GetSecretWithContext is an alternate form of the GetSecret method which supports a Context parameter
+	GetSecretWithContext(ctx context.Context, getSecretOptions *GetSecretOptions) (result *Secret, response *core.DetailedResponse, err error)
+
+	// ReplaceSecret : Update a secret
+	// Update a secret.
+	ReplaceSecret(replaceSecretOptions *ReplaceSecretOptions) (result *Secret, response *core.DetailedResponse, err error)
+
+	// ReplaceSecretWithContext is an alternate form of the ReplaceSecret method which supports a Context parameter
+	ReplaceSecretWithContext(ctx context.Context, replaceSecretOptions *ReplaceSecretOptions) (result *Secret, response *core.DetailedResponse, err error)
+}
+
+// PersistentDataStoresAPI : The operations of the PersistentDataStores resource group.
+type PersistentDataStoresAPI interface {
+	// ListPersistentDataStores : List persistent data stores
+	// List all persistent data stores in a project.
+	ListPersistentDataStores(listPersistentDataStoresOptions *ListPersistentDataStoresOptions) (result *PersistentDataStoreList, response *core.DetailedResponse, err error)
+
+	// ListPersistentDataStoresWithContext is an alternate form of the ListPersistentDataStores method which supports a Context parameter
+	ListPersistentDataStoresWithContext(ctx context.Context, listPersistentDataStoresOptions *ListPersistentDataStoresOptions) (result *PersistentDataStoreList, response *core.DetailedResponse, err error)
+
+	// CreatePersistentDataStore : Create a persistent data store
+	// Create a persistent data store.
+	CreatePersistentDataStore(createPersistentDataStoreOptions *CreatePersistentDataStoreOptions) (result *PersistentDataStore, response *core.DetailedResponse, err error)
+
+	// CreatePersistentDataStoreWithContext is an alternate form of the CreatePersistentDataStore method which supports a Context parameter
+	CreatePersistentDataStoreWithContext(ctx context.Context, createPersistentDataStoreOptions *CreatePersistentDataStoreOptions) (result *PersistentDataStore, response *core.DetailedResponse, err error)
+
+	// DeletePersistentDataStore : Delete a persistent data store
+	// Delete a persistent data store.
+	DeletePersistentDataStore(deletePersistentDataStoreOptions *DeletePersistentDataStoreOptions) (response *core.DetailedResponse, err error)
+
+	// DeletePersistentDataStoreWithContext is an alternate form of the DeletePersistentDataStore method which supports a Context parameter
+	DeletePersistentDataStoreWithContext(ctx context.Context, deletePersistentDataStoreOptions *DeletePersistentDataStoreOptions) (response *core.DetailedResponse, err error)
+
+	// GetPersistentDataStore : Get a persistent data store
+	// Get a persistent data store.
+	GetPersistentDataStore(getPersistentDataStoreOptions *GetPersistentDataStoreOptions) (result *PersistentDataStore, response *core.DetailedResponse, err error)
+
+	// GetPersistentDataStoreWithContext is an alternate form of the GetPersistentDataStore method which supports a Context parameter
+	GetPersistentDataStoreWithContext(ctx context.Context, getPersistentDataStoreOptions *GetPersistentDataStoreOptions) (result *PersistentDataStore, response *core.DetailedResponse, err error)
+}
+
+// Compile-time assertions that CodeEngineV2 implements the interfaces.
+var (
+	_ CodeEngineAPI                  = (*CodeEngineV2)(nil)
+	_ ProjectsAPI                    = (*CodeEngineV2)(nil)
+	_ AllowedOutboundDestinationsAPI = (*CodeEngineV2)(nil)
+	_ AppsAPI                        = (*CodeEngineV2)(nil)
+	_ JobRunsAPI                     = (*CodeEngineV2)(nil)
+	_ JobsAPI                        = (*CodeEngineV2)(nil)
+	_ FunctionsAPI                   = (*CodeEngineV2)(nil)
+	_ BindingsAPI                    = (*CodeEngineV2)(nil)
+	_ BuildRunsAPI                   = (*CodeEngineV2)(nil)
+	_ BuildsAPI                      = (*CodeEngineV2)(nil)
+	_ DomainMappingsAPI              = (*CodeEngineV2)(nil)
+	_ ConfigMapsAPI                  = (*CodeEngineV2)(nil)
+	_ SecretsAPI                     = (*CodeEngineV2)(nil)
+	_ PersistentDataStoresAPI        = (*CodeEngineV2)(nil)
+)
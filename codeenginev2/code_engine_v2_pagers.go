@@ -0,0 +1,493 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by apigen from code_engine_v2.go. DO NOT EDIT.
+
+package codeenginev2
+
+import (
+	"context"
+	"iter"
+)
+
+// Compile-time assertions that the pagers implement Pager.
+var (
+	_ Pager[Project]                        = (*ProjectsPager)(nil)
+	_ Pager[AllowedOutboundDestinationIntf] = (*AllowedOutboundDestinationsPager)(nil)
+	_ Pager[App]                            = (*AppsPager)(nil)
+	_ Pager[AppInstance]                    = (*AppInstancesPager)(nil)
+	_ Pager[AppRevision]                    = (*AppRevisionsPager)(nil)
+	_ Pager[JobRun]                         = (*JobRunsPager)(nil)
+	_ Pager[Job]                            = (*JobsPager)(nil)
+	_ Pager[Function]                       = (*FunctionsPager)(nil)
+	_ Pager[Binding]                        = (*BindingsPager)(nil)
+	_ Pager[BuildRun]                       = (*BuildRunsPager)(nil)
+	_ Pager[Build]                          = (*BuildsPager)(nil)
+	_ Pager[DomainMapping]                  = (*DomainMappingsPager)(nil)
+	_ Pager[ConfigMap]                      = (*ConfigMapsPager)(nil)
+	_ Pager[Secret]                         = (*SecretsPager)(nil)
+	_ Pager[PersistentDataStore]            = (*PersistentDataStoresPager)(nil)
+)
+
+// All returns an iterator over the items of the remaining pages, which are retrieved one page at a time while the
+// iteration proceeds. An error ends the iteration after it has been yielded.
+func (pager *ProjectsPager) All(ctx context.Context) iter.Seq2[Project, error] {
+	return allItems[Project](ctx, pager)
+}
+
+// Checkpoint returns an opaque checkpoint of the pager, which covers its options, including the page size, and the
+// start token of the next page. NewProjectsPagerFromCheckpoint continues the pagination from
+// the checkpoint. The headers of the options, which may contain credentials, are not part of the checkpoint.
+func (pager *ProjectsPager) Checkpoint() (checkpoint string, err error) {
+	return encodePagerCheckpoint("ProjectsPager", pager.options, pager.pageContext.next, pager.hasNext)
+}
+
+// NewProjectsPagerFromCheckpoint returns a ProjectsPager that continues the pagination of the pager that returned the
+// checkpoint with the next page.
+func (codeEngine *CodeEngineV2) NewProjectsPagerFromCheckpoint(checkpoint string) (pager *ProjectsPager, err error) {
+	var options ListProjectsOptions
+	next, hasNext, err := decodePagerCheckpoint("ProjectsPager", checkpoint, &options)
+	if err != nil {
+		return
+	}
+	pager = &ProjectsPager{
+		hasNext: hasNext,
+		options: &options,
+		client:  codeEngine,
+	}
+	pager.pageContext.next = next
+	return
+}
+
+// All returns an iterator over the items of the remaining pages, which are retrieved one page at a time while the
+// iteration proceeds. An error ends the iteration after it has been yielded.
+func (pager *AllowedOutboundDestinationsPager) All(ctx context.Context) iter.Seq2[AllowedOutboundDestinationIntf, error] {
+	return allItems[AllowedOutboundDestinationIntf](ctx, pager)
+}
+
+// Checkpoint returns an opaque checkpoint of the pager, which covers its options, including the page size, and the
+// start token of the next page. NewAllowedOutboundDestinationsPagerFromCheckpoint continues the pagination from
+// the checkpoint. The headers of the options, which may contain credentials, are not part of the checkpoint.
+func (pager *AllowedOutboundDestinationsPager) Checkpoint() (checkpoint string, err error) {
+	return encodePagerCheckpoint("AllowedOutboundDestinationsPager", pager.options, pager.pageContext.next, pager.hasNext)
+}
+
+// NewAllowedOutboundDestinationsPagerFromCheckpoint returns a AllowedOutboundDestinationsPager that continues the pagination of the pager that returned the
+// checkpoint with the next page.
+func (codeEngine *CodeEngineV2) NewAllowedOutboundDestinationsPagerFromCheckpoint(checkpoint string) (pager *AllowedOutboundDestinationsPager, err error) {
+	var options ListAllowedOutboundDestinationsOptions
+	next, hasNext, err := decodePagerCheckpoint("AllowedOutboundDestinationsPager", checkpoint, &options)
+	if err != nil {
+		return
+	}
+	pager = &AllowedOutboundDestinationsPager{
+		hasNext: hasNext,
+		options: &options,
+		client:  codeEngine,
+	}
+	pager.pageContext.next = next
+	return
+}
+
+// All returns an iterator over the items of the remaining pages, which are retrieved one page at a time while the
+// iteration proceeds. An error ends the iteration after it has been yielded.
+func (pager *AppsPager) All(ctx context.Context) iter.Seq2[App, error] {
+	return allItems[App](ctx, pager)
+}
+
+// Checkpoint returns an opaque checkpoint of the pager, which covers its options, including the page size, and the
+// start token of the next page. NewAppsPagerFromCheckpoint continues the pagination from
+// the checkpoint. The headers of the options, which may contain credentials, are not part of the checkpoint.
+func (pager *AppsPager) Checkpoint() (checkpoint string, err error) {
+	return encodePagerCheckpoint("AppsPager", pager.options, pager.pageContext.next, pager.hasNext)
+}
+
+// NewAppsPagerFromCheckpoint returns a AppsPager that continues the pagination of the pager that returned the
+// checkpoint with the next page.
+func (codeEngine *CodeEngineV2) NewAppsPagerFromCheckpoint(checkpoint string) (pager *AppsPager, err error) {
+	var options ListAppsOptions
+	next, hasNext, err := decodePagerCheckpoint("AppsPager", checkpoint, &options)
+	if err != nil {
+		return
+	}
+	pager = &AppsPager{
+		hasNext: hasNext,
+		options: &options,
+		client:  codeEngine,
+	}
+	pager.pageContext.next = next
+	return
+}
+
+// All returns an iterator over the items of the remaining pages, which are retrieved one page at a time while the
+// iteration proceeds. An error ends the iteration after it has been yielded.
+func (pager *AppInstancesPager) All(ctx context.Context) iter.Seq2[AppInstance, error] {
+	return allItems[AppInstance](ctx, pager)
+}
+
+// Checkpoint returns an opaque checkpoint of the pager, which covers its options, including the page size, and the
+// start token of the next page. NewAppInstancesPagerFromCheckpoint continues the pagination from
+// the checkpoint. The headers of the options, which may contain credentials, are not part of the checkpoint.
+func (pager *AppInstancesPager) Checkpoint() (checkpoint string, err error) {
+	return encodePagerCheckpoint("AppInstancesPager", pager.options, pager.pageContext.next, pager.hasNext)
+}
+
+// NewAppInstancesPagerFromCheckpoint returns a AppInstancesPager that continues the pagination of the pager that returned the
+// checkpoint with the next page.
+func (codeEngine *CodeEngineV2) NewAppInstancesPagerFromCheckpoint(checkpoint string) (pager *AppInstancesPager, err error) {
+	var options ListAppInstancesOptions
+	next, hasNext, err := decodePagerCheckpoint("AppInstancesPager", checkpoint, &options)
+	if err != nil {
+		return
+	}
+	pager = &AppInstancesPager{
+		hasNext: hasNext,
+		options: &options,
+		client:  codeEngine,
+	}
+	pager.pageContext.next = next
+	return
+}
+
+// All returns an iterator over the items of the remaining pages, which are retrieved one page at a time while the
+// iteration proceeds. An error ends the iteration after it has been yielded.
+func (pager *AppRevisionsPager) All(ctx context.Context) iter.Seq2[AppRevision, error] {
+	return allItems[AppRevision](ctx, pager)
+}
+
+// Checkpoint returns an opaque checkpoint of the pager, which covers its options, including the page size, and the
+// start token of the next page. NewAppRevisionsPagerFromCheckpoint continues the pagination from
+// the checkpoint. The headers of the options, which may contain credentials, are not part of the checkpoint.
+func (pager *AppRevisionsPager) Checkpoint() (checkpoint string, err error) {
+	return encodePagerCheckpoint("AppRevisionsPager", pager.options, pager.pageContext.next, pager.hasNext)
+}
+
+// NewAppRevisionsPagerFromCheckpoint returns a AppRevisionsPager that continues the pagination of the pager that returned the
+// checkpoint with the next page.
+func (codeEngine *CodeEngineV2) NewAppRevisionsPagerFromCheckpoint(checkpoint string) (pager *AppRevisionsPager, err error) {
+	var options ListAppRevisionsOptions
+	next, hasNext, err := decodePagerCheckpoint("AppRevisionsPager", checkpoint, &options)
+	if err != nil {
+		return
+	}
+	pager = &AppRevisionsPager{
+		hasNext: hasNext,
+		options: &options,
+		client:  codeEngine,
+	}
+	pager.pageContext.next = next
+	return
+}
+
+// All returns an iterator over the items of the remaining pages, which are retrieved one page at a time while the
+// iteration proceeds. An error ends the iteration after it has been yielded.
+func (pager *JobRunsPager) All(ctx context.Context) iter.Seq2[JobRun, error] {
+	return allItems[JobRun](ctx, pager)
+}
+
+// Checkpoint returns an opaque checkpoint of the pager, which covers its options, including the page size, and the
+// start token of the next page. NewJobRunsPagerFromCheckpoint continues the pagination from
+// the checkpoint. The headers of the options, which may contain credentials, are not part of the checkpoint.
+func (pager *JobRunsPager) Checkpoint() (checkpoint string, err error) {
+	return encodePagerCheckpoint("JobRunsPager", pager.options, pager.pageContext.next, pager.hasNext)
+}
+
+// NewJobRunsPagerFromCheckpoint returns a JobRunsPager that continues the pagination of the pager that returned the
+// checkpoint with the next page.
+func (codeEngine *CodeEngineV2) NewJobRunsPagerFromCheckpoint(checkpoint string) (pager *JobRunsPager, err error) {
+	var options ListJobRunsOptions
+	next, hasNext, err := decodePagerCheckpoint("JobRunsPager", checkpoint, &options)
+	if err != nil {
+		return
+	}
+	pager = &JobRunsPager{
+		hasNext: hasNext,
+		options: &options,
+		client:  codeEngine,
+	}
+	pager.pageContext.next = next
+	return
+}
+
+// All returns an iterator over the items of the remaining pages, which are retrieved one page at a time while the
+// iteration proceeds. An error ends the iteration after it has been yielded.
+func (pager *JobsPager) All(ctx context.Context) iter.Seq2[Job, error] {
+	return allItems[Job](ctx, pager)
+}
+
+// Checkpoint returns an opaque checkpoint of the pager, which covers its options, including the page size, and the
+// start token of the next page. NewJobsPagerFromCheckpoint continues the pagination from
+// the checkpoint. The headers of the options, which may contain credentials, are not part of the checkpoint.
+func (pager *JobsPager) Checkpoint() (checkpoint string, err error) {
+	return encodePagerCheckpoint("JobsPager", pager.options, pager.pageContext.next, pager.hasNext)
+}
+
+// NewJobsPagerFromCheckpoint returns a JobsPager that continues the pagination of the pager that returned the
+// checkpoint with the next page.
+func (codeEngine *CodeEngineV2) NewJobsPagerFromCheckpoint(checkpoint string) (pager *JobsPager, err error) {
+	var options ListJobsOptions
+	next, hasNext, err := decodePagerCheckpoint("JobsPager", checkpoint, &options)
+	if err != nil {
+		return
+	}
+	pager = &JobsPager{
+		hasNext: hasNext,
+		options: &options,
+		client:  codeEngine,
+	}
+	pager.pageContext.next = next
+	return
+}
+
+// All returns an iterator over the items of the remaining pages, which are retrieved one page at a time while the
+// iteration proceeds. An error ends the iteration after it has been yielded.
+func (pager *FunctionsPager) All(ctx context.Context) iter.Seq2[Function, error] {
+	return allItems[Function](ctx, pager)
+}
+
+// Checkpoint returns an opaque checkpoint of the pager, which covers its options, including the page size, and the
+// start token of the next page. NewFunctionsPagerFromCheckpoint continues the pagination from
+// the checkpoint. The headers of the options, which may contain credentials, are not part of the checkpoint.
+func (pager *FunctionsPager) Checkpoint() (checkpoint string, err error) {
+	return encodePagerCheckpoint("FunctionsPager", pager.options, pager.pageContext.next, pager.hasNext)
+}
+
+// NewFunctionsPagerFromCheckpoint returns a FunctionsPager that continues the pagination of the pager that returned the
+// checkpoint with the next page.
+func (codeEngine *CodeEngineV2) NewFunctionsPagerFromCheckpoint(checkpoint string) (pager *FunctionsPager, err error) {
+	var options ListFunctionsOptions
+	next, hasNext, err := decodePagerCheckpoint("FunctionsPager", checkpoint, &options)
+	if err != nil {
+		return
+	}
+	pager = &FunctionsPager{
+		hasNext: hasNext,
+		options: &options,
+		client:  codeEngine,
+	}
+	pager.pageContext.next = next
+	return
+}
+
+// All returns an iterator over the items of the remaining pages, which are retrieved one page at a time while the
+// iteration proceeds. An error ends the iteration after it has been yielded.
+func (pager *BindingsPager) All(ctx context.Context) iter.Seq2[Binding, error] {
+	return allItems[Binding](ctx, pager)
+}
+
+// Checkpoint returns an opaque checkpoint of the pager, which covers its options, including the page size, and the
+// start token of the next page. NewBindingsPagerFromCheckpoint continues the pagination from
+// the checkpoint. The headers of the options, which may contain credentials, are not part of the checkpoint.
+func (pager *BindingsPager) Checkpoint() (checkpoint string, err error) {
+	return encodePagerCheckpoint("BindingsPager", pager.options, pager.pageContext.next, pager.hasNext)
+}
+
+// NewBindingsPagerFromCheckpoint returns a BindingsPager that continues the pagination of the pager that returned the
+// checkpoint with the next page.
+func (codeEngine *CodeEngineV2) NewBindingsPagerFromCheckpoint(checkpoint string) (pager *BindingsPager, err error) {
+	var options ListBindingsOptions
+	next, hasNext, err := decodePagerCheckpoint("BindingsPager", checkpoint, &options)
+	if err != nil {
+		return
+	}
+	pager = &BindingsPager{
+		hasNext: hasNext,
+		options: &options,
+		client:  codeEngine,
+	}
+	pager.pageContext.next = next
+	return
+}
+
+// All returns an iterator over the items of the remaining pages, which are retrieved one page at a time while the
+// iteration proceeds. An error ends the iteration after it has been yielded.
+func (pager *BuildRunsPager) All(ctx context.Context) iter.Seq2[BuildRun, error] {
+	return allItems[BuildRun](ctx, pager)
+}
+
+// Checkpoint returns an opaque checkpoint of the pager, which covers its options, including the page size, and the
+// start token of the next page. NewBuildRunsPagerFromCheckpoint continues the pagination from
+// the checkpoint. The headers of the options, which may contain credentials, are not part of the checkpoint.
+func (pager *BuildRunsPager) Checkpoint() (checkpoint string, err error) {
+	return encodePagerCheckpoint("BuildRunsPager", pager.options, pager.pageContext.next, pager.hasNext)
+}
+
+// NewBuildRunsPagerFromCheckpoint returns a BuildRunsPager that continues the pagination of the pager that returned the
+// checkpoint with the next page.
+func (codeEngine *CodeEngineV2) NewBuildRunsPagerFromCheckpoint(checkpoint string) (pager *BuildRunsPager, err error) {
+	var options ListBuildRunsOptions
+	next, hasNext, err := decodePagerCheckpoint("BuildRunsPager", checkpoint, &options)
+	if err != nil {
+		return
+	}
+	pager = &BuildRunsPager{
+		hasNext: hasNext,
+		options: &options,
+		client:  codeEngine,
+	}
+	pager.pageContext.next = next
+	return
+}
+
+// All returns an iterator over the items of the remaining pages, which are retrieved one page at a time while the
+// iteration proceeds. An error ends the iteration after it has been yielded.
+func (pager *BuildsPager) All(ctx context.Context) iter.Seq2[Build, error] {
+	return allItems[Build](ctx, pager)
+}
+
+// Checkpoint returns an opaque checkpoint of the pager, which covers its options, including the page size, and the
+// start token of the next page. NewBuildsPagerFromCheckpoint continues the pagination from
+// the checkpoint. The headers of the options, which may contain credentials, are not part of the checkpoint.
+func (pager *BuildsPager) Checkpoint() (checkpoint string, err error) {
+	return encodePagerCheckpoint("BuildsPager", pager.options, pager.pageContext.next, pager.hasNext)
+}
+
+// NewBuildsPagerFromCheckpoint returns a BuildsPager that continues the pagination of the pager that returned the
+// checkpoint with the next page.
+func (codeEngine *CodeEngineV2) NewBuildsPagerFromCheckpoint(checkpoint string) (pager *BuildsPager, err error) {
+	var options ListBuildsOptions
+	next, hasNext, err := decodePagerCheckpoint("BuildsPager", checkpoint, &options)
+	if err != nil {
+		return
+	}
+	pager = &BuildsPager{
+		hasNext: hasNext,
+		options: &options,
+		client:  codeEngine,
+	}
+	pager.pageContext.next = next
+	return
+}
+
+// All returns an iterator over the items of the remaining pages, which are retrieved one page at a time while the
+// iteration proceeds. An error ends the iteration after it has been yielded.
+func (pager *DomainMappingsPager) All(ctx context.Context) iter.Seq2[DomainMapping, error] {
+	return allItems[DomainMapping](ctx, pager)
+}
+
+// Checkpoint returns an opaque checkpoint of the pager, which covers its options, including the page size, and the
+// start token of the next page. NewDomainMappingsPagerFromCheckpoint continues the pagination from
+// the checkpoint. The headers of the options, which may contain credentials, are not part of the checkpoint.
+func (pager *DomainMappingsPager) Checkpoint() (checkpoint string, err error) {
+	return encodePagerCheckpoint("DomainMappingsPager", pager.options, pager.pageContext.next, pager.hasNext)
+}
+
+// NewDomainMappingsPagerFromCheckpoint returns a DomainMappingsPager that continues the pagination of the pager that returned the
+// checkpoint with the next page.
+func (codeEngine *CodeEngineV2) NewDomainMappingsPagerFromCheckpoint(checkpoint string) (pager *DomainMappingsPager, err error) {
+	var options ListDomainMappingsOptions
+	next, hasNext, err := decodePagerCheckpoint("DomainMappingsPager", checkpoint, &options)
+	if err != nil {
+		return
+	}
+	pager = &DomainMappingsPager{
+		hasNext: hasNext,
+		options: &options,
+		client:  codeEngine,
+	}
+	pager.pageContext.next = next
+	return
+}
+
+// All returns an iterator over the items of the remaining pages, which are retrieved one page at a time while the
+// iteration proceeds. An error ends the iteration after it has been yielded.
+func (pager *ConfigMapsPager) All(ctx context.Context) iter.Seq2[ConfigMap, error] {
+	return allItems[ConfigMap](ctx, pager)
+}
+
+// Checkpoint returns an opaque checkpoint of the pager, which covers its options, including the page size, and the
+// start token of the next page. NewConfigMapsPagerFromCheckpoint continues the pagination from
+// the checkpoint. The headers of the options, which may contain credentials, are not part of the checkpoint.
+func (pager *ConfigMapsPager) Checkpoint() (checkpoint string, err error) {
+	return encodePagerCheckpoint("ConfigMapsPager", pager.options, pager.pageContext.next, pager.hasNext)
+}
+
+// NewConfigMapsPagerFromCheckpoint returns a ConfigMapsPager that continues the pagination of the pager that returned the
+// checkpoint with the next page.
+func (codeEngine *CodeEngineV2) NewConfigMapsPagerFromCheckpoint(checkpoint string) (pager *ConfigMapsPager, err error) {
+	var options ListConfigMapsOptions
+	next, hasNext, err := decodePagerCheckpoint("ConfigMapsPager", checkpoint, &options)
+	if err != nil {
+		return
+	}
+	pager = &ConfigMapsPager{
+		hasNext: hasNext,
+		options: &options,
+		client:  codeEngine,
+	}
+	pager.pageContext.next = next
+	return
+}
+
+// All returns an iterator over the items of the remaining pages, which are retrieved one page at a time while the
+// iteration proceeds. An error ends the iteration after it has been yielded.
+func (pager *SecretsPager) All(ctx context.Context) iter.Seq2[Secret, error] {
+	return allItems[Secret](ctx, pager)
+}
+
+// Checkpoint returns an opaque checkpoint of the pager, which covers its options, including the page size, and the
+// start token of the next page. NewSecretsPagerFromCheckpoint continues the pagination from
+// the checkpoint. The headers of the options, which may contain credentials, are not part of the checkpoint.
+func (pager *SecretsPager) Checkpoint() (checkpoint string, err error) {
+	return encodePagerCheckpoint("SecretsPager", pager.options, pager.pageContext.next, pager.hasNext)
+}
+
+// NewSecretsPagerFromCheckpoint returns a SecretsPager that continues the pagination of the pager that returned the
+// checkpoint with the next page.
+func (codeEngine *CodeEngineV2) NewSecretsPagerFromCheckpoint(checkpoint string) (pager *SecretsPager, err error) {
+	var options ListSecretsOptions
+	next, hasNext, err := decodePagerCheckpoint("SecretsPager", checkpoint, &options)
+	if err != nil {
+		return
+	}
+	pager = &SecretsPager{
+		hasNext: hasNext,
+		options: &options,
+		client:  codeEngine,
+	}
+	pager.pageContext.next = next
+	return
+}
+
+// All returns an iterator over the items of the remaining pages, which are retrieved one page at a time while the
+// iteration proceeds. An error ends the iteration after it has been yielded.
+func (pager *PersistentDataStoresPager) All(ctx context.Context) iter.Seq2[PersistentDataStore, error] {
+	return allItems[PersistentDataStore](ctx, pager)
+}
+
+// Checkpoint returns an opaque checkpoint of the pager, which covers its options, including the page size, and the
+// start token of the next page. NewPersistentDataStoresPagerFromCheckpoint continues the pagination from
+// the checkpoint. The headers of the options, which may contain credentials, are not part of the checkpoint.
+func (pager *PersistentDataStoresPager) Checkpoint() (checkpoint string, err error) {
+	return encodePagerCheckpoint("PersistentDataStoresPager", pager.options, pager.pageContext.next, pager.hasNext)
+}
+
+// NewPersistentDataStoresPagerFromCheckpoint returns a PersistentDataStoresPager that continues the pagination of the pager that returned the
+// checkpoint with the next page.
+func (codeEngine *CodeEngineV2) NewPersistentDataStoresPagerFromCheckpoint(checkpoint string) (pager *PersistentDataStoresPager, err error) {
+	var options ListPersistentDataStoresOptions
+	next, hasNext, err := decodePagerCheckpoint("PersistentDataStoresPager", checkpoint, &options)
+	if err != nil {
+		return
+	}
+	pager = &PersistentDataStoresPager{
+		hasNext: hasNext,
+		options: &options,
+		client:  codeEngine,
+	}
+	pager.pageContext.next = next
+	return
+}
@@ -0,0 +1,1468 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by apigen from code_engine_v2.go. DO NOT EDIT.
+
+package codeenginev2
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Delete : Delete a project
+// Delete a project.
+//
+// The ID of the options is set to the ID of the project; nil options are treated as empty options.
+func (project *ProjectClient) Delete(ctx context.Context, deleteProjectOptions *DeleteProjectOptions) (response *core.DetailedResponse, err error) {
+	options := DeleteProjectOptions{}
+	if deleteProjectOptions != nil {
+		options = *deleteProjectOptions
+	}
+	options.ID = core.StringPtr(project.projectID)
+	return project.codeEngine.DeleteProjectWithContext(ctx, &options)
+}
+
+// NewDeleteOptions : Instantiate DeleteProjectOptions for the project
+func (project *ProjectClient) NewDeleteOptions() *DeleteProjectOptions {
+	return project.codeEngine.NewDeleteProjectOptions(project.projectID)
+}
+
+// Get : Get a project
+// Display the details of a single project.
+//
+// The ID of the options is set to the ID of the project; nil options are treated as empty options.
+func (project *ProjectClient) Get(ctx context.Context, getProjectOptions *GetProjectOptions) (result *Project, response *core.DetailedResponse, err error) {
+	options := GetProjectOptions{}
+	if getProjectOptions != nil {
+		options = *getProjectOptions
+	}
+	options.ID = core.StringPtr(project.projectID)
+	return project.codeEngine.GetProjectWithContext(ctx, &options)
+}
+
+// NewGetOptions : Instantiate GetProjectOptions for the project
+func (project *ProjectClient) NewGetOptions() *GetProjectOptions {
+	return project.codeEngine.NewGetProjectOptions(project.projectID)
+}
+
+// GetEgressIps : List egress IP addresses
+// Lists all egress IP addresses (public and private) that are used by components running in this project. For
+// information about using egress IP addresses, see [Code Engine public and private IP
+// addresses](https://cloud.ibm.com/docs/codeengine?topic=codeengine-network-addresses).
+//
+// The ProjectID of the options is set to the ID of the project; nil options are treated as empty options.
+func (project *ProjectClient) GetEgressIps(ctx context.Context, getProjectEgressIpsOptions *GetProjectEgressIpsOptions) (result *ProjectEgressIPAddresses, response *core.DetailedResponse, err error) {
+	options := GetProjectEgressIpsOptions{}
+	if getProjectEgressIpsOptions != nil {
+		options = *getProjectEgressIpsOptions
+	}
+	options.ProjectID = core.StringPtr(project.projectID)
+	return project.codeEngine.GetProjectEgressIpsWithContext(ctx, &options)
+}
+
+// NewGetEgressIpsOptions : Instantiate GetProjectEgressIpsOptions for the project
+func (project *ProjectClient) NewGetEgressIpsOptions() *GetProjectEgressIpsOptions {
+	return project.codeEngine.NewGetProjectEgressIpsOptions(project.projectID)
+}
+
+// GetStatusDetails : Get the status details for a project
+// Retrieves status details about the given project.
+//
+// The ProjectID of the options is set to the ID of the project; nil options are treated as empty options.
+func (project *ProjectClient) GetStatusDetails(ctx context.Context, getProjectStatusDetailsOptions *GetProjectStatusDetailsOptions) (result *ProjectStatusDetails, response *core.DetailedResponse, err error) {
+	options := GetProjectStatusDetailsOptions{}
+	if getProjectStatusDetailsOptions != nil {
+		options = *getProjectStatusDetailsOptions
+	}
+	options.ProjectID = core.StringPtr(project.projectID)
+	return project.codeEngine.GetProjectStatusDetailsWithContext(ctx, &options)
+}
+
+// NewGetStatusDetailsOptions : Instantiate GetProjectStatusDetailsOptions for the project
+func (project *ProjectClient) NewGetStatusDetailsOptions() *GetProjectStatusDetailsOptions {
+	return project.codeEngine.NewGetProjectStatusDetailsOptions(project.projectID)
+}
+
+// ProjectAllowedOutboundDestinations : The operations of the AllowedOutboundDestinations resource group in a project.
+type ProjectAllowedOutboundDestinations struct {
+	codeEngine *CodeEngineV2
+	projectID  string
+}
+
+// AllowedOutboundDestinations returns the operations of the AllowedOutboundDestinations resource group in the project.
+func (project *ProjectClient) AllowedOutboundDestinations() *ProjectAllowedOutboundDestinations {
+	return &ProjectAllowedOutboundDestinations{codeEngine: project.codeEngine, projectID: project.projectID}
+}
+
+// List : List allowed outbound destinations
+// List all allowed outbound destinations in a project.
+//
+// The ProjectID of the options is set to the ID of the project; nil options are treated as empty options.
+func (allowedOutboundDestinations *ProjectAllowedOutboundDestinations) List(ctx context.Context, listAllowedOutboundDestinationsOptions *ListAllowedOutboundDestinationsOptions) (result *AllowedOutboundDestinationList, response *core.DetailedResponse, err error) {
+	options := ListAllowedOutboundDestinationsOptions{}
+	if listAllowedOutboundDestinationsOptions != nil {
+		options = *listAllowedOutboundDestinationsOptions
+	}
+	options.ProjectID = core.StringPtr(allowedOutboundDestinations.projectID)
+	return allowedOutboundDestinations.codeEngine.ListAllowedOutboundDestinationsWithContext(ctx, &options)
+}
+
+// NewListOptions : Instantiate ListAllowedOutboundDestinationsOptions for the project
+func (allowedOutboundDestinations *ProjectAllowedOutboundDestinations) NewListOptions() *ListAllowedOutboundDestinationsOptions {
+	return allowedOutboundDestinations.codeEngine.NewListAllowedOutboundDestinationsOptions(allowedOutboundDestinations.projectID)
+}
+
+// Create : Create an allowed outbound destination
+// Create an allowed outbound destination.
+//
+// The ProjectID of the options is set to the ID of the project; nil options are treated as empty options.
+func (allowedOutboundDestinations *ProjectAllowedOutboundDestinations) Create(ctx context.Context, createAllowedOutboundDestinationOptions *CreateAllowedOutboundDestinationOptions) (result AllowedOutboundDestinationIntf, response *core.DetailedResponse, err error) {
+	options := CreateAllowedOutboundDestinationOptions{}
+	if createAllowedOutboundDestinationOptions != nil {
+		options = *createAllowedOutboundDestinationOptions
+	}
+	options.ProjectID = core.StringPtr(allowedOutboundDestinations.projectID)
+	return allowedOutboundDestinations.codeEngine.CreateAllowedOutboundDestinationWithContext(ctx, &options)
+}
+
+// NewCreateOptions : Instantiate CreateAllowedOutboundDestinationOptions for the project
+func (allowedOutboundDestinations *ProjectAllowedOutboundDestinations) NewCreateOptions(allowedOutboundDestination AllowedOutboundDestinationPrototypeIntf) *CreateAllowedOutboundDestinationOptions {
+	return allowedOutboundDestinations.codeEngine.NewCreateAllowedOutboundDestinationOptions(allowedOutboundDestinations.projectID, allowedOutboundDestination)
+}
+
+// Delete : Delete an allowed outbound destination
+// Delete an allowed outbound destination.
+//
+// The ProjectID of the options is set to the ID of the project; nil options are treated as empty options.
+func (allowedOutboundDestinations *ProjectAllowedOutboundDestinations) Delete(ctx context.Context, deleteAllowedOutboundDestinationOptions *DeleteAllowedOutboundDestinationOptions) (response *core.DetailedResponse, err error) {
+	options := DeleteAllowedOutboundDestinationOptions{}
+	if deleteAllowedOutboundDestinationOptions != nil {
+		options = *deleteAllowedOutboundDestinationOptions
+	}
+	options.ProjectID = core.StringPtr(allowedOutboundDestinations.projectID)
+	return allowedOutboundDestinations.codeEngine.DeleteAllowedOutboundDestinationWithContext(ctx, &options)
+}
+
+// NewDeleteOptions : Instantiate DeleteAllowedOutboundDestinationOptions for the project
+func (allowedOutboundDestinations *ProjectAllowedOutboundDestinations) NewDeleteOptions(name string) *DeleteAllowedOutboundDestinationOptions {
+	return allowedOutboundDestinations.codeEngine.NewDeleteAllowedOutboundDestinationOptions(allowedOutboundDestinations.projectID, name)
+}
+
+// Get : Get an allowed outbound destination
+// Display the details of an allowed outbound destination.
+//
+// The ProjectID of the options is set to the ID of the project; nil options are treated as empty options.
+func (allowedOutboundDestinations *ProjectAllowedOutboundDestinations) Get(ctx context.Context, getAllowedOutboundDestinationOptions *GetAllowedOutboundDestinationOptions) (result AllowedOutboundDestinationIntf, response *core.DetailedResponse, err error) {
+	options := GetAllowedOutboundDestinationOptions{}
+	if getAllowedOutboundDestinationOptions != nil {
+		options = *getAllowedOutboundDestinationOptions
+	}
+	options.ProjectID = core.StringPtr(allowedOutboundDestinations.projectID)
+	return allowedOutboundDestinations.codeEngine.GetAllowedOutboundDestinationWithContext(ctx, &options)
+}
+
+// NewGetOptions : Instantiate GetAllowedOutboundDestinationOptions for the project
+func (allowedOutboundDestinations *ProjectAllowedOutboundDestinations) NewGetOptions(name string) *GetAllowedOutboundDestinationOptions {
+	return allowedOutboundDestinations.codeEngine.NewGetAllowedOutboundDestinationOptions(allowedOutboundDestinations.projectID, name)
+}
+
+// Update : Update an allowed outbound destination
+// Update an allowed outbound destination.
+//
+// The ProjectID of the options is set to the ID of the project; nil options are treated as empty options.
+func (allowedOutboundDestinations *ProjectAllowedOutboundDestinations) Update(ctx context.Context, updateAllowedOutboundDestinationOptions *UpdateAllowedOutboundDestinationOptions) (result AllowedOutboundDestinationIntf, response *core.DetailedResponse, err error) {
+	options := UpdateAllowedOutboundDestinationOptions{}
+	if updateAllowedOutboundDestinationOptions != nil {
+		options = *updateAllowedOutboundDestinationOptions
+	}
+	options.ProjectID = core.StringPtr(allowedOutboundDestinations.projectID)
+	return allowedOutboundDestinations.codeEngine.UpdateAllowedOutboundDestinationWithContext(ctx, &options)
+}
+
+// NewUpdateOptions : Instantiate UpdateAllowedOutboundDestinationOptions for the project
+func (allowedOutboundDestinations *ProjectAllowedOutboundDestinations) NewUpdateOptions(name string, ifMatch string, allowedOutboundDestination map[string]interface{}) *UpdateAllowedOutboundDestinationOptions {
+	return allowedOutboundDestinations.codeEngine.NewUpdateAllowedOutboundDestinationOptions(allowedOutboundDestinations.projectID, name, ifMatch, allowedOutboundDestination)
+}
+
+// NewPager returns a new AllowedOutboundDestinationsPager of the project. The ProjectID of the options is set to the ID of the project; nil
+// options are treated as empty options.
+func (allowedOutboundDestinations *ProjectAllowedOutboundDestinations) NewPager(options *ListAllowedOutboundDestinationsOptions) (pager *AllowedOutboundDestinationsPager, err error) {
+	copied := ListAllowedOutboundDestinationsOptions{}
+	if options != nil {
+		copied = *options
+	}
+	copied.ProjectID = core.StringPtr(allowedOutboundDestinations.projectID)
+	return allowedOutboundDestinations.codeEngine.NewAllowedOutboundDestinationsPager(&copied)
+}
+
+// ProjectApps : The operations of the Apps resource group in a project.
+type ProjectApps struct {
+	codeEngine *CodeEngineV2
+	projectID  string
+}
+
+// Apps returns the operations of the Apps resource group in the project.
+func (project *ProjectClient) Apps() *ProjectApps {
+	return &ProjectApps{codeEngine: project.codeEngine, projectID: project.projectID}
+}
+
+// List : List applications
+// List all applications in a project.
+//
+// The ProjectID of the options is set to the ID of the project; nil options are treated as empty options.
+func (apps *ProjectApps) List(ctx context.Context, listAppsOptions *ListAppsOptions) (result *AppList, response *core.DetailedResponse, err error) {
+	options := ListAppsOptions{}
+	if listAppsOptions != nil {
+		options = *listAppsOptions
+	}
+	options.ProjectID = core.StringPtr(apps.projectID)
+	return apps.codeEngine.ListAppsWithContext(ctx, &options)
+}
+
+// NewListOptions : Instantiate ListAppsOptions for the project
+func (apps *ProjectApps) NewListOptions() *ListAppsOptions {
+	return apps.codeEngine.NewListAppsOptions(apps.projectID)
+}
+
+// Create : Create an application
+// Create an application.
+//
+// The ProjectID of the options is set to the ID of the project; nil options are treated as empty options.
+func (apps *ProjectApps) Create(ctx context.Context, createAppOptions *CreateAppOptions) (result *App, response *core.DetailedResponse, err error) {
+	options := CreateAppOptions{}
+	if createAppOptions != nil {
+		options = *createAppOptions
+	}
+	options.ProjectID = core.StringPtr(apps.projectID)
+	return apps.codeEngine.CreateAppWithContext(ctx, &options)
+}
+
+// NewCreateOptions : Instantiate CreateAppOptions for the project
+func (apps *ProjectApps) NewCreateOptions(imageReference string, name string) *CreateAppOptions {
+	return apps.codeEngine.NewCreateAppOptions(apps.projectID, imageReference, name)
+}
+
+// ListInstances : List application instances
+// List all instances of an application.
+//
+// The ProjectID of the options is set to the ID of the project; nil options are treated as empty options.
+func (apps *ProjectApps) ListInstances(ctx context.Context, listAppInstancesOptions *ListAppInstancesOptions) (result *AppInstanceList, response *core.DetailedResponse, err error) {
+	options := ListAppInstancesOptions{}
+	if listAppInstancesOptions != nil {
+		options = *listAppInstancesOptions
+	}
+	options.ProjectID = core.StringPtr(apps.projectID)
+	return apps.codeEngine.ListAppInstancesWithContext(ctx, &options)
+}
+
+// NewListInstancesOptions : Instantiate ListAppInstancesOptions for the project
+func (apps *ProjectApps) NewListInstancesOptions(appName string) *ListAppInstancesOptions {
+	return apps.codeEngine.NewListAppInstancesOptions(apps.projectID, appName)
+}
+
+// ListRevisions : List application revisions
+// List all application revisions in a particular application.
+//
+// The ProjectID of the options is set to the ID of the project; nil options are treated as empty options.
+func (apps *ProjectApps) ListRevisions(ctx context.Context, listAppRevisionsOptions *ListAppRevisionsOptions) (result *AppRevisionList, response *core.DetailedResponse, err error) {
+	options := ListAppRevisionsOptions{}
+	if listAppRevisionsOptions != nil {
+		options = *listAppRevisionsOptions
+	}
+	options.ProjectID = core.StringPtr(apps.projectID)
+	return apps.codeEngine.ListAppRevisionsWithContext(ctx, &options)
+}
+
+// NewListRevisionsOptions : Instantiate ListAppRevisionsOptions for the project
+func (apps *ProjectApps) NewListRevisionsOptions(appName string) *ListAppRevisionsOptions {
+	return apps.codeEngine.NewListAppRevisionsOptions(apps.projectID, appName)
+}
+
+// DeleteRevision : Delete an application revision
+// Delete an application revision.
+//
+// The ProjectID of the options is set to the ID of the project; nil options are treated as empty options.
+func (apps *ProjectApps) DeleteRevision(ctx context.Context, deleteAppRevisionOptions *DeleteAppRevisionOptions) (response *core.DetailedResponse, err error) {
+	options := DeleteAppRevisionOptions{}
+	if deleteAppRevisionOptions != nil {
+		options = *deleteAppRevisionOptions
+	}
+	options.ProjectID = core.StringPtr(apps.projectID)
+	return apps.codeEngine.DeleteAppRevisionWithContext(ctx, &options)
+}
+
+// NewDeleteRevisionOptions : Instantiate DeleteAppRevisionOptions for the project
+func (apps *ProjectApps) NewDeleteRevisionOptions(appName string, name string) *DeleteAppRevisionOptions {
+	return apps.codeEngine.NewDeleteAppRevisionOptions(apps.projectID, appName, name)
+}
+
+// GetRevision : Get an application revision
+// Display the details of an application revision.
+//
+// The ProjectID of the options is set to the ID of the project; nil options are treated as empty options.
+func (apps *ProjectApps) GetRevision(ctx context.Context, getAppRevisionOptions *GetAppRevisionOptions) (result *AppRevision, response *core.DetailedResponse, err error) {
+	options := GetAppRevisionOptions{}
+	if getAppRevisionOptions != nil {
+		options = *getAppRevisionOptions
+	}
+	options.ProjectID = core.StringPtr(apps.projectID)
+	return apps.codeEngine.GetAppRevisionWithContext(ctx, &options)
+}
+
+// NewGetRevisionOptions : Instantiate GetAppRevisionOptions for the project
+func (apps *ProjectApps) NewGetRevisionOptions(appName string, name string) *GetAppRevisionOptions {
+	return apps.codeEngine.NewGetAppRevisionOptions(apps.projectID, appName, name)
+}
+
+// Delete : Delete an application
+// Delete an application.
+//
+// The ProjectID of the options is set to the ID of the project; nil options are treated as empty options.
+func (apps *ProjectApps) Delete(ctx context.Context, deleteAppOptions *DeleteAppOptions) (response *core.DetailedResponse, err error) {
+	options := DeleteAppOptions{}
+	if deleteAppOptions != nil {
+		options = *deleteAppOptions
+	}
+	options.ProjectID = core.StringPtr(apps.projectID)
+	return apps.codeEngine.DeleteAppWithContext(ctx, &options)
+}
+
+// NewDeleteOptions : Instantiate DeleteAppOptions for the project
+func (apps *ProjectApps) NewDeleteOptions(name string) *DeleteAppOptions {
+	return apps.codeEngine.NewDeleteAppOptions(apps.projectID, name)
+}
+
+// Get : Get an application
+// Display the details of an application.
+//
+// The ProjectID of the options is set to the ID of the project; nil options are treated as empty options.
+func (apps *ProjectApps) Get(ctx context.Context, getAppOptions *GetAppOptions) (result *App, response *core.DetailedResponse, err error) {
+	options := GetAppOptions{}
+	if getAppOptions != nil {
+		options = *getAppOptions
+	}
+	options.ProjectID = core.StringPtr(apps.projectID)
+	return apps.codeEngine.GetAppWithContext(ctx, &options)
+}
+
+// NewGetOptions : Instantiate GetAppOptions for the project
+func (apps *ProjectApps) NewGetOptions(name string) *GetAppOptions {
+	return apps.codeEngine.NewGetAppOptions(apps.projectID, name)
+}
+
+// Update : Update an application
+// An application contains one or more revisions. A revision represents an immutable version of the configuration
+// properties of the application. Each update of an application configuration property creates a new revision of the
+// application. [Learn more](https://cloud.ibm.com/docs/codeengine?topic=codeengine-update-app).
+//
+// The ProjectID of the options is set to the ID of the project; nil options are treated as empty options.
+func (apps *ProjectApps) Update(ctx context.Context, updateAppOptions *UpdateAppOptions) (result *App, response *core.DetailedResponse, err error) {
+	options := UpdateAppOptions{}
+	if updateAppOptions != nil {
+		options = *updateAppOptions
+	}
+	options.ProjectID = core.StringPtr(apps.projectID)
+	return apps.codeEngine.UpdateAppWithContext(ctx, &options)
+}
+
+// NewUpdateOptions : Instantiate UpdateAppOptions for the project
+func (apps *ProjectApps) NewUpdateOptions(name string, ifMatch string, app map[string]interface{}) *UpdateAppOptions {
+	return apps.codeEngine.NewUpdateAppOptions(apps.projectID, name, ifMatch, app)
+}
+
+// NewPager returns a new AppsPager of the project. The ProjectID of the options is set to the ID of the project; nil
+// options are treated as empty options.
+func (apps *ProjectApps) NewPager(options *ListAppsOptions) (pager *AppsPager, err error) {
+	copied := ListAppsOptions{}
+	if options != nil {
+		copied = *options
+	}
+	copied.ProjectID = core.StringPtr(apps.projectID)
+	return apps.codeEngine.NewAppsPager(&copied)
+}
+
+// NewInstancesPager returns a new AppInstancesPager of the project. The ProjectID of the options is set to the ID of the project; nil
+// options are treated as empty options.
+func (apps *ProjectApps) NewInstancesPager(options *ListAppInstancesOptions) (pager *AppInstancesPager, err error) {
+	copied := ListAppInstancesOptions{}
+	if options != nil {
+		copied = *options
+	}
+	copied.ProjectID = core.StringPtr(apps.projectID)
+	return apps.codeEngine.NewAppInstancesPager(&copied)
+}
+
+// NewRevisionsPager returns a new AppRevisionsPager of the project. The ProjectID of the options is set to the ID of the project; nil
+// options are treated as empty options.
+func (apps *ProjectApps) NewRevisionsPager(options *ListAppRevisionsOptions) (pager *AppRevisionsPager, err error) {
+	copied := ListAppRevisionsOptions{}
+	if options != nil {
+		copied = *options
+	}
+	copied.ProjectID = core.StringPtr(apps.projectID)
+	return apps.codeEngine.NewAppRevisionsPager(&copied)
+}
+
+// ProjectJobRuns : The operations of the JobRuns resource group in a project.
+type ProjectJobRuns struct {
+	codeEngine *CodeEngineV2
+	projectID  string
+}
+
+// JobRuns returns the operations of the JobRuns resource group in the project.
+func (project *ProjectClient) JobRuns() *ProjectJobRuns {
+	return &ProjectJobRuns{codeEngine: project.codeEngine, projectID: project.projectID}
+}
+
+// List : List job runs
+// List all job runs in a project.
+//
+// The ProjectID of the options is set to the ID of the project; nil options are treated as empty options.
+func (jobRuns *ProjectJobRuns) List(ctx context.Context, listJobRunsOptions *ListJobRunsOptions) (result *JobRunList, response *core.DetailedResponse, err error) {
+	options := ListJobRunsOptions{}
+	if listJobRunsOptions != nil {
+		options = *listJobRunsOptions
+	}
+	options.ProjectID = core.StringPtr(jobRuns.projectID)
+	return jobRuns.codeEngine.ListJobRunsWithContext(ctx, &options)
+}
+
+// NewListOptions : Instantiate ListJobRunsOptions for the project
+func (jobRuns *ProjectJobRuns) NewListOptions() *ListJobRunsOptions {
+	return jobRuns.codeEngine.NewListJobRunsOptions(jobRuns.projectID)
+}
+
+// Create : Create a job run
+// Create an job run.
+//
+// The ProjectID of the options is set to the ID of the project; nil options are treated as empty options.
+func (jobRuns *ProjectJobRuns) Create(ctx context.Context, createJobRunOptions *CreateJobRunOptions) (result *JobRun, response *core.DetailedResponse, err error) {
+	options := CreateJobRunOptions{}
+	if createJobRunOptions != nil {
+		options = *createJobRunOptions
+	}
+	options.ProjectID = core.StringPtr(jobRuns.projectID)
+	return jobRuns.codeEngine.CreateJobRunWithContext(ctx, &options)
+}
+
+// NewCreateOptions : Instantiate CreateJobRunOptions for the project
+func (jobRuns *ProjectJobRuns) NewCreateOptions() *CreateJobRunOptions {
+	return jobRuns.codeEngine.NewCreateJobRunOptions(jobRuns.projectID)
+}
+
+// Delete : Delete a job run
+// Delete a job run.
+//
+// The ProjectID of the options is set to the ID of the project; nil options are treated as empty options.
+func (jobRuns *ProjectJobRuns) Delete(ctx context.Context, deleteJobRunOptions *DeleteJobRunOptions) (response *core.DetailedResponse, err error) {
+	options := DeleteJobRunOptions{}
+	if deleteJobRunOptions != nil {
+		options = *deleteJobRunOptions
+	}
+	options.ProjectID = core.StringPtr(jobRuns.projectID)
+	return jobRuns.codeEngine.DeleteJobRunWithContext(ctx, &options)
+}
+
+// NewDeleteOptions : Instantiate DeleteJobRunOptions for the project
+func (jobRuns *ProjectJobRuns) NewDeleteOptions(name string) *DeleteJobRunOptions {
+	return jobRuns.codeEngine.NewDeleteJobRunOptions(jobRuns.projectID, name)
+}
+
+// Get : Get a job run
+// Display the details of a job run.
+//
+// The ProjectID of the options is set to the ID of the project; nil options are treated as empty options.
+func (jobRuns *ProjectJobRuns) Get(ctx context.Context, getJobRunOptions *GetJobRunOptions) (result *JobRun, response *core.DetailedResponse, err error) {
+	options := GetJobRunOptions{}
+	if getJobRunOptions != nil {
+		options = *getJobRunOptions
+	}
+	options.ProjectID = core.StringPtr(jobRuns.projectID)
+	return jobRuns.codeEngine.GetJobRunWithContext(ctx, &options)
+}
+
+// NewGetOptions : Instantiate GetJobRunOptions for the project
+func (jobRuns *ProjectJobRuns) NewGetOptions(name string) *GetJobRunOptions {
+	return jobRuns.codeEngine.NewGetJobRunOptions(jobRuns.projectID, name)
+}
+
+// NewPager returns a new JobRunsPager of the project. The ProjectID of the options is set to the ID of the project; nil
+// options are treated as empty options.
+func (jobRuns *ProjectJobRuns) NewPager(options *ListJobRunsOptions) (pager *JobRunsPager, err error) {
+	copied := ListJobRunsOptions{}
+	if options != nil {
+		copied = *options
+	}
+	copied.ProjectID = core.StringPtr(jobRuns.projectID)
+	return jobRuns.codeEngine.NewJobRunsPager(&copied)
+}
+
+// ProjectJobs : The operations of the Jobs resource group in a project.
+type ProjectJobs struct {
+	codeEngine *CodeEngineV2
+	projectID  string
+}
+
+// Jobs returns the operations of the Jobs resource group in the project.
+func (project *ProjectClient) Jobs() *ProjectJobs {
+	return &ProjectJobs{codeEngine: project.codeEngine, projectID: project.projectID}
+}
+
+// List : List jobs
+// List all jobs in a project.
+//
+// The ProjectID of the options is set to the ID of the project; nil options are treated as empty options.
+func (jobs *ProjectJobs) List(ctx context.Context, listJobsOptions *ListJobsOptions) (result *JobList, response *core.DetailedResponse, err error) {
+	options := ListJobsOptions{}
+	if listJobsOptions != nil {
+		options = *listJobsOptions
+	}
+	options.ProjectID = core.StringPtr(jobs.projectID)
+	return jobs.codeEngine.ListJobsWithContext(ctx, &options)
+}
+
+// NewListOptions : Instantiate ListJobsOptions for the project
+func (jobs *ProjectJobs) NewListOptions() *ListJobsOptions {
+	return jobs.codeEngine.NewListJobsOptions(jobs.projectID)
+}
+
+// Create : Create a job
+// Create a job.
+//
+// The ProjectID of the options is set to the ID of the project; nil options are treated as empty options.
+func (jobs *ProjectJobs) Create(ctx context.Context, createJobOptions *CreateJobOptions) (result *Job, response *core.DetailedResponse, err error) {
+	options := CreateJobOptions{}
+	if createJobOptions != nil {
+		options = *createJobOptions
+	}
+	options.ProjectID = core.StringPtr(jobs.projectID)
+	return jobs.codeEngine.CreateJobWithContext(ctx, &options)
+}
+
+// NewCreateOptions : Instantiate CreateJobOptions for the project
+func (jobs *ProjectJobs) NewCreateOptions(imageReference string, name string) *CreateJobOptions {
+	return jobs.codeEngine.NewCreateJobOptions(jobs.projectID, imageReference, name)
+}
+
+// Delete : Delete a job
+// Delete a job.
+//
+// The ProjectID of the options is set to the ID of the project; nil options are treated as empty options.
+func (jobs *ProjectJobs) Delete(ctx context.Context, deleteJobOptions *DeleteJobOptions) (response *core.DetailedResponse, err error) {
+	options := DeleteJobOptions{}
+	if deleteJobOptions != nil {
+		options = *deleteJobOptions
+	}
+	options.ProjectID = core.StringPtr(jobs.projectID)
+	return jobs.codeEngine.DeleteJobWithContext(ctx, &options)
+}
+
+// NewDeleteOptions : Instantiate DeleteJobOptions for the project
+func (jobs *ProjectJobs) NewDeleteOptions(name string) *DeleteJobOptions {
+	return jobs.codeEngine.NewDeleteJobOptions(jobs.projectID, name)
+}
+
+// Get : Get a job
+// Display the details of a job.
+//
+// The ProjectID of the options is set to the ID of the project; nil options are treated as empty options.
+func (jobs *ProjectJobs) Get(ctx context.Context, getJobOptions *GetJobOptions) (result *Job, response *core.DetailedResponse, err error) {
+	options := GetJobOptions{}
+	if getJobOptions != nil {
+		options = *getJobOptions
+	}
+	options.ProjectID = core.StringPtr(jobs.projectID)
+	return jobs.codeEngine.GetJobWithContext(ctx, &options)
+}
+
+// NewGetOptions : Instantiate GetJobOptions for the project
+func (jobs *ProjectJobs) NewGetOptions(name string) *GetJobOptions {
+	return jobs.codeEngine.NewGetJobOptions(jobs.projectID, name)
+}
+
+// Update : Update a job
+// Update the given job.
+//
+// The ProjectID of the options is set to the ID of the project; nil options are treated as empty options.
+func (jobs *ProjectJobs) Update(ctx context.Context, updateJobOptions *UpdateJobOptions) (result *Job, response *core.DetailedResponse, err error) {
+	options := UpdateJobOptions{}
+	if updateJobOptions != nil {
+		options = *updateJobOptions
+	}
+	options.ProjectID = core.StringPtr(jobs.projectID)
+	return jobs.codeEngine.UpdateJobWithContext(ctx, &options)
+}
+
+// NewUpdateOptions : Instantiate UpdateJobOptions for the project
+func (jobs *ProjectJobs) NewUpdateOptions(name string, ifMatch string, job map[string]interface{}) *UpdateJobOptions {
+	return jobs.codeEngine.NewUpdateJobOptions(jobs.projectID, name, ifMatch, job)
+}
+
+// NewPager returns a new JobsPager of the project. The ProjectID of the options is set to the ID of the project; nil
+// options are treated as empty options.
+func (jobs *ProjectJobs) NewPager(options *ListJobsOptions) (pager *JobsPager, err error) {
+	copied := ListJobsOptions{}
+	if options != nil {
+		copied = *options
+	}
+	copied.ProjectID = core.StringPtr(jobs.projectID)
+	return jobs.codeEngine.NewJobsPager(&copied)
+}
+
+// ProjectFunctions : The operations of the Functions resource group in a project.
+type ProjectFunctions struct {
+	codeEngine *CodeEngineV2
+	projectID  string
+}
+
+// Functions returns the operations of the Functions resource group in the project.
+func (project *ProjectClient) Functions() *ProjectFunctions {
+	return &ProjectFunctions{codeEngine: project.codeEngine, projectID: project.projectID}
+}
+
+// List : List functions
+// List all functions in a project.
+//
+// The ProjectID of the options is set to the ID of the project; nil options are treated as empty options.
+func (functions *ProjectFunctions) List(ctx context.Context, listFunctionsOptions *ListFunctionsOptions) (result *FunctionList, response *core.DetailedResponse, err error) {
+	options := ListFunctionsOptions{}
+	if listFunctionsOptions != nil {
+		options = *listFunctionsOptions
+	}
+	options.ProjectID = core.StringPtr(functions.projectID)
+	return functions.codeEngine.ListFunctionsWithContext(ctx, &options)
+}
+
+// NewListOptions : Instantiate ListFunctionsOptions for the project
+func (functions *ProjectFunctions) NewListOptions() *ListFunctionsOptions {
+	return functions.codeEngine.NewListFunctionsOptions(functions.projectID)
+}
+
+// Create : Create a function
+// Create a function.
+//
+// The ProjectID of the options is set to the ID of the project; nil options are treated as empty options.
+func (functions *ProjectFunctions) Create(ctx context.Context, createFunctionOptions *CreateFunctionOptions) (result *Function, response *core.DetailedResponse, err error) {
+	options := CreateFunctionOptions{}
+	if createFunctionOptions != nil {
+		options = *createFunctionOptions
+	}
+	options.ProjectID = core.StringPtr(functions.projectID)
+	return functions.codeEngine.CreateFunctionWithContext(ctx, &options)
+}
+
+// NewCreateOptions : Instantiate CreateFunctionOptions for the project
+func (functions *ProjectFunctions) NewCreateOptions(codeReference string, name string, runtime string) *CreateFunctionOptions {
+	return functions.codeEngine.NewCreateFunctionOptions(functions.projectID, codeReference, name, runtime)
+}
+
+// Delete : Delete a function
+// Delete a function.
+//
+// The ProjectID of the options is set to the ID of the project; nil options are treated as empty options.
+func (functions *ProjectFunctions) Delete(ctx context.Context, deleteFunctionOptions *DeleteFunctionOptions) (response *core.DetailedResponse, err error) {
+	options := DeleteFunctionOptions{}
+	if deleteFunctionOptions != nil {
+		options = *deleteFunctionOptions
+	}
+	options.ProjectID = core.StringPtr(functions.projectID)
+	return functions.codeEngine.DeleteFunctionWithContext(ctx, &options)
+}
+
+// NewDeleteOptions : Instantiate DeleteFunctionOptions for the project
+func (functions *ProjectFunctions) NewDeleteOptions(name string) *DeleteFunctionOptions {
+	return functions.codeEngine.NewDeleteFunctionOptions(functions.projectID, name)
+}
+
+// Get : Get a function
+// Display the details of a function.
+//
+// The ProjectID of the options is set to the ID of the project; nil options are treated as empty options.
+func (functions *ProjectFunctions) Get(ctx context.Context, getFunctionOptions *GetFunctionOptions) (result *Function, response *core.DetailedResponse, err error) {
+	options := GetFunctionOptions{}
+	if getFunctionOptions != nil {
+		options = *getFunctionOptions
+	}
+	options.ProjectID = core.StringPtr(functions.projectID)
+	return functions.codeEngine.GetFunctionWithContext(ctx, &options)
+}
+
+// NewGetOptions : Instantiate GetFunctionOptions for the project
+func (functions *ProjectFunctions) NewGetOptions(name string) *GetFunctionOptions {
+	return functions.codeEngine.NewGetFunctionOptions(functions.projectID, name)
+}
+
+// Update : Update a function
+// Update the given function.
+//
+// The ProjectID of the options is set to the ID of the project; nil options are treated as empty options.
+func (functions *ProjectFunctions) Update(ctx context.Context, updateFunctionOptions *UpdateFunctionOptions) (result *Function, response *core.DetailedResponse, err error) {
+	options := UpdateFunctionOptions{}
+	if updateFunctionOptions != nil {
+		options = *updateFunctionOptions
+	}
+	options.ProjectID = core.StringPtr(functions.projectID)
+	return functions.codeEngine.UpdateFunctionWithContext(ctx, &options)
+}
+
+// NewUpdateOptions : Instantiate UpdateFunctionOptions for the project
+func (functions *ProjectFunctions) NewUpdateOptions(name string, ifMatch string, function map[string]interface{}) *UpdateFunctionOptions {
+	return functions.codeEngine.NewUpdateFunctionOptions(functions.projectID, name, ifMatch, function)
+}
+
+// NewPager returns a new FunctionsPager of the project. The ProjectID of the options is set to the ID of the project; nil
+// options are treated as empty options.
+func (functions *ProjectFunctions) NewPager(options *ListFunctionsOptions) (pager *FunctionsPager, err error) {
+	copied := ListFunctionsOptions{}
+	if options != nil {
+		copied = *options
+	}
+	copied.ProjectID = core.StringPtr(functions.projectID)
+	return functions.codeEngine.NewFunctionsPager(&copied)
+}
+
+// ProjectBindings : The operations of the Bindings resource group in a project.
+type ProjectBindings struct {
+	codeEngine *CodeEngineV2
+	projectID  string
+}
+
+// Bindings returns the operations of the Bindings resource group in the project.
+func (project *ProjectClient) Bindings() *ProjectBindings {
+	return &ProjectBindings{codeEngine: project.codeEngine, projectID: project.projectID}
+}
+
+// List : List bindings
+// List all bindings in a project.
+//
+// The ProjectID of the options is set to the ID of the project; nil options are treated as empty options.
+func (bindings *ProjectBindings) List(ctx context.Context, listBindingsOptions *ListBindingsOptions) (result *BindingList, response *core.DetailedResponse, err error) {
+	options := ListBindingsOptions{}
+	if listBindingsOptions != nil {
+		options = *listBindingsOptions
+	}
+	options.ProjectID = core.StringPtr(bindings.projectID)
+	return bindings.codeEngine.ListBindingsWithContext(ctx, &options)
+}
+
+// NewListOptions : Instantiate ListBindingsOptions for the project
+func (bindings *ProjectBindings) NewListOptions() *ListBindingsOptions {
+	return bindings.codeEngine.NewListBindingsOptions(bindings.projectID)
+}
+
+// Create : Create a binding
+// Create a binding. Creating a service binding with a Code Engine app will update the app, creating a new revision. For
+// more information see the [documentation](https://cloud.ibm.com/docs/codeengine?topic=codeengine-service-binding).
+//
+// The ProjectID of the options is set to the ID of the project; nil options are treated as empty options.
+func (bindings *ProjectBindings) Create(ctx context.Context, createBindingOptions *CreateBindingOptions) (result *Binding, response *core.DetailedResponse, err error) {
+	options := CreateBindingOptions{}
+	if createBindingOptions != nil {
+		options = *createBindingOptions
+	}
+	options.ProjectID = core.StringPtr(bindings.projectID)
+	return bindings.codeEngine.CreateBindingWithContext(ctx, &options)
+}
+
+// NewCreateOptions : Instantiate CreateBindingOptions for the project
+func (bindings *ProjectBindings) NewCreateOptions(component *ComponentRef, prefix string, secretName string) *CreateBindingOptions {
+	return bindings.codeEngine.NewCreateBindingOptions(bindings.projectID, component, prefix, secretName)
+}
+
+// Delete : Delete a binding
+// Delete a binding.
+//
+// The ProjectID of the options is set to the ID of the project; nil options are treated as empty options.
+func (bindings *ProjectBindings) Delete(ctx context.Context, deleteBindingOptions *DeleteBindingOptions) (response *core.DetailedResponse, err error) {
+	options := DeleteBindingOptions{}
+	if deleteBindingOptions != nil {
+		options = *deleteBindingOptions
+	}
+	options.ProjectID = core.StringPtr(bindings.projectID)
+	return bindings.codeEngine.DeleteBindingWithContext(ctx, &options)
+}
+
+// NewDeleteOptions : Instantiate DeleteBindingOptions for the project
+func (bindings *ProjectBindings) NewDeleteOptions(id string) *DeleteBindingOptions {
+	return bindings.codeEngine.NewDeleteBindingOptions(bindings.projectID, id)
+}
+
+// Get : Get a binding
+// Display the details of a binding.
+//
+// The ProjectID of the options is set to the ID of the project; nil options are treated as empty options.
+func (bindings *ProjectBindings) Get(ctx context.Context, getBindingOptions *GetBindingOptions) (result *Binding, response *core.DetailedResponse, err error) {
+	options := GetBindingOptions{}
+	if getBindingOptions != nil {
+		options = *getBindingOptions
+	}
+	options.ProjectID = core.StringPtr(bindings.projectID)
+	return bindings.codeEngine.GetBindingWithContext(ctx, &options)
+}
+
+// NewGetOptions : Instantiate GetBindingOptions for the project
+func (bindings *ProjectBindings) NewGetOptions(id string) *GetBindingOptions {
+	return bindings.codeEngine.NewGetBindingOptions(bindings.projectID, id)
+}
+
+// NewPager returns a new BindingsPager of the project. The ProjectID of the options is set to the ID of the project; nil
+// options are treated as empty options.
+func (bindings *ProjectBindings) NewPager(options *ListBindingsOptions) (pager *BindingsPager, err error) {
+	copied := ListBindingsOptions{}
+	if options != nil {
+		copied = *options
+	}
+	copied.ProjectID = core.StringPtr(bindings.projectID)
+	return bindings.codeEngine.NewBindingsPager(&copied)
+}
+
+// ProjectBuildRuns : The operations of the BuildRuns resource group in a project.
+type ProjectBuildRuns struct {
+	codeEngine *CodeEngineV2
+	projectID  string
+}
+
+// BuildRuns returns the operations of the BuildRuns resource group in the project.
+func (project *ProjectClient) BuildRuns() *ProjectBuildRuns {
+	return &ProjectBuildRuns{codeEngine: project.codeEngine, projectID: project.projectID}
+}
+
+// List : List build runs
+// List all build runs in a project.
+//
+// The ProjectID of the options is set to the ID of the project; nil options are treated as empty options.
+func (buildRuns *ProjectBuildRuns) List(ctx context.Context, listBuildRunsOptions *ListBuildRunsOptions) (result *BuildRunList, response *core.DetailedResponse, err error) {
+	options := ListBuildRunsOptions{}
+	if listBuildRunsOptions != nil {
+		options = *listBuildRunsOptions
+	}
+	options.ProjectID = core.StringPtr(buildRuns.projectID)
+	return buildRuns.codeEngine.ListBuildRunsWithContext(ctx, &options)
+}
+
+// NewListOptions : Instantiate ListBuildRunsOptions for the project
+func (buildRuns *ProjectBuildRuns) NewListOptions() *ListBuildRunsOptions {
+	return buildRuns.codeEngine.NewListBuildRunsOptions(buildRuns.projectID)
+}
+
+// Create : Create a build run
+// Create a build run.
+//
+// The ProjectID of the options is set to the ID of the project; nil options are treated as empty options.
+func (buildRuns *ProjectBuildRuns) Create(ctx context.Context, createBuildRunOptions *CreateBuildRunOptions) (result *BuildRun, response *core.DetailedResponse, err error) {
+	options := CreateBuildRunOptions{}
+	if createBuildRunOptions != nil {
+		options = *createBuildRunOptions
+	}
+	options.ProjectID = core.StringPtr(buildRuns.projectID)
+	return buildRuns.codeEngine.CreateBuildRunWithContext(ctx, &options)
+}
+
+// NewCreateOptions : Instantiate CreateBuildRunOptions for the project
+func (buildRuns *ProjectBuildRuns) NewCreateOptions() *CreateBuildRunOptions {
+	return buildRuns.codeEngine.NewCreateBuildRunOptions(buildRuns.projectID)
+}
+
+// Delete : Delete a build run
+// Delete a build run.
+//
+// The ProjectID of the options is set to the ID of the project; nil options are treated as empty options.
+func (buildRuns *ProjectBuildRuns) Delete(ctx context.Context, deleteBuildRunOptions *DeleteBuildRunOptions) (response *core.DetailedResponse, err error) {
+	options := DeleteBuildRunOptions{}
+	if deleteBuildRunOptions != nil {
+		options = *deleteBuildRunOptions
+	}
+	options.ProjectID = core.StringPtr(buildRuns.projectID)
+	return buildRuns.codeEngine.DeleteBuildRunWithContext(ctx, &options)
+}
+
+// NewDeleteOptions : Instantiate DeleteBuildRunOptions for the project
+func (buildRuns *ProjectBuildRuns) NewDeleteOptions(name string) *DeleteBuildRunOptions {
+	return buildRuns.codeEngine.NewDeleteBuildRunOptions(buildRuns.projectID, name)
+}
+
+// Get : Get a build run
+// Display the details of a build run.
+//
+// The ProjectID of the options is set to the ID of the project; nil options are treated as empty options.
+func (buildRuns *ProjectBuildRuns) Get(ctx context.Context, getBuildRunOptions *GetBuildRunOptions) (result *BuildRun, response *core.DetailedResponse, err error) {
+	options := GetBuildRunOptions{}
+	if getBuildRunOptions != nil {
+		options = *getBuildRunOptions
+	}
+	options.ProjectID = core.StringPtr(buildRuns.projectID)
+	return buildRuns.codeEngine.GetBuildRunWithContext(ctx, &options)
+}
+
+// NewGetOptions : Instantiate GetBuildRunOptions for the project
+func (buildRuns *ProjectBuildRuns) NewGetOptions(name string) *GetBuildRunOptions {
+	return buildRuns.codeEngine.NewGetBuildRunOptions(buildRuns.projectID, name)
+}
+
+// NewPager returns a new BuildRunsPager of the project. The ProjectID of the options is set to the ID of the project; nil
+// options are treated as empty options.
+func (buildRuns *ProjectBuildRuns) NewPager(options *ListBuildRunsOptions) (pager *BuildRunsPager, err error) {
+	copied := ListBuildRunsOptions{}
+	if options != nil {
+		copied = *options
+	}
+	copied.ProjectID = core.StringPtr(buildRuns.projectID)
+	return buildRuns.codeEngine.NewBuildRunsPager(&copied)
+}
+
+// ProjectBuilds : The operations of the Builds resource group in a project.
+type ProjectBuilds struct {
+	codeEngine *CodeEngineV2
+	projectID  string
+}
+
+// Builds returns the operations of the Builds resource group in the project.
+func (project *ProjectClient) Builds() *ProjectBuilds {
+	return &ProjectBuilds{codeEngine: project.codeEngine, projectID: project.projectID}
+}
+
+// List : List builds
+// List all builds in a project.
+//
+// The ProjectID of the options is set to the ID of the project; nil options are treated as empty options.
+func (builds *ProjectBuilds) List(ctx context.Context, listBuildsOptions *ListBuildsOptions) (result *BuildList, response *core.DetailedResponse, err error) {
+	options := ListBuildsOptions{}
+	if listBuildsOptions != nil {
+		options = *listBuildsOptions
+	}
+	options.ProjectID = core.StringPtr(builds.projectID)
+	return builds.codeEngine.ListBuildsWithContext(ctx, &options)
+}
+
+// NewListOptions : Instantiate ListBuildsOptions for the project
+func (builds *ProjectBuilds) NewListOptions() *ListBuildsOptions {
+	return builds.codeEngine.NewListBuildsOptions(builds.projectID)
+}
+
+// Create : Create a build
+// Create a build.
+//
+// The ProjectID of the options is set to the ID of the project; nil options are treated as empty options.
+func (builds *ProjectBuilds) Create(ctx context.Context, createBuildOptions *CreateBuildOptions) (result *Build, response *core.DetailedResponse, err error) {
+	options := CreateBuildOptions{}
+	if createBuildOptions != nil {
+		options = *createBuildOptions
+	}
+	options.ProjectID = core.StringPtr(builds.projectID)
+	return builds.codeEngine.CreateBuildWithContext(ctx, &options)
+}
+
+// NewCreateOptions : Instantiate CreateBuildOptions for the project
+func (builds *ProjectBuilds) NewCreateOptions(name string, outputImage string, outputSecret string, strategyType string) *CreateBuildOptions {
+	return builds.codeEngine.NewCreateBuildOptions(builds.projectID, name, outputImage, outputSecret, strategyType)
+}
+
+// Delete : Delete a build
+// Delete a build.
+//
+// The ProjectID of the options is set to the ID of the project; nil options are treated as empty options.
+func (builds *ProjectBuilds) Delete(ctx context.Context, deleteBuildOptions *DeleteBuildOptions) (response *core.DetailedResponse, err error) {
+	options := DeleteBuildOptions{}
+	if deleteBuildOptions != nil {
+		options = *deleteBuildOptions
+	}
+	options.ProjectID = core.StringPtr(builds.projectID)
+	return builds.codeEngine.DeleteBuildWithContext(ctx, &options)
+}
+
+// NewDeleteOptions : Instantiate DeleteBuildOptions for the project
+func (builds *ProjectBuilds) NewDeleteOptions(name string) *DeleteBuildOptions {
+	return builds.codeEngine.NewDeleteBuildOptions(builds.projectID, name)
+}
+
+// Get : Get a build
+// Display the details of a build.
+//
+// The ProjectID of the options is set to the ID of the project; nil options are treated as empty options.
+func (builds *ProjectBuilds) Get(ctx context.Context, getBuildOptions *GetBuildOptions) (result *Build, response *core.DetailedResponse, err error) {
+	options := GetBuildOptions{}
+	if getBuildOptions != nil {
+		options = *getBuildOptions
+	}
+	options.ProjectID = core.StringPtr(builds.projectID)
+	return builds.codeEngine.GetBuildWithContext(ctx, &options)
+}
+
+// NewGetOptions : Instantiate GetBuildOptions for the project
+func (builds *ProjectBuilds) NewGetOptions(name string) *GetBuildOptions {
+	return builds.codeEngine.NewGetBuildOptions(builds.projectID, name)
+}
+
+// Update : Update a build
+// Update a build.
+//
+// The ProjectID of the options is set to the ID of the project; nil options are treated as empty options.
+func (builds *ProjectBuilds) Update(ctx context.Context, updateBuildOptions *UpdateBuildOptions) (result *Build, response *core.DetailedResponse, err error) {
+	options := UpdateBuildOptions{}
+	if updateBuildOptions != nil {
+		options = *updateBuildOptions
+	}
+	options.ProjectID = core.StringPtr(builds.projectID)
+	return builds.codeEngine.UpdateBuildWithContext(ctx, &options)
+}
+
+// NewUpdateOptions : Instantiate UpdateBuildOptions for the project
+func (builds *ProjectBuilds) NewUpdateOptions(name string, ifMatch string, build map[string]interface{}) *UpdateBuildOptions {
+	return builds.codeEngine.NewUpdateBuildOptions(builds.projectID, name, ifMatch, build)
+}
+
+// NewPager returns a new BuildsPager of the project. The ProjectID of the options is set to the ID of the project; nil
+// options are treated as empty options.
+func (builds *ProjectBuilds) NewPager(options *ListBuildsOptions) (pager *BuildsPager, err error) {
+	copied := ListBuildsOptions{}
+	if options != nil {
+		copied = *options
+	}
+	copied.ProjectID = core.StringPtr(builds.projectID)
+	return builds.codeEngine.NewBuildsPager(&copied)
+}
+
+// ProjectDomainMappings : The operations of the DomainMappings resource group in a project.
+type ProjectDomainMappings struct {
+	codeEngine *CodeEngineV2
+	projectID  string
+}
+
+// DomainMappings returns the operations of the DomainMappings resource group in the project.
+func (project *ProjectClient) DomainMappings() *ProjectDomainMappings {
+	return &ProjectDomainMappings{codeEngine: project.codeEngine, projectID: project.projectID}
+}
+
+// List : List domain mappings
+// List all domain mappings in a project.
+//
+// The ProjectID of the options is set to the ID of the project; nil options are treated as empty options.
+func (domainMappings *ProjectDomainMappings) List(ctx context.Context, listDomainMappingsOptions *ListDomainMappingsOptions) (result *DomainMappingList, response *core.DetailedResponse, err error) {
+	options := ListDomainMappingsOptions{}
+	if listDomainMappingsOptions != nil {
+		options = *listDomainMappingsOptions
+	}
+	options.ProjectID = core.StringPtr(domainMappings.projectID)
+	return domainMappings.codeEngine.ListDomainMappingsWithContext(ctx, &options)
+}
+
+// NewListOptions : Instantiate ListDomainMappingsOptions for the project
+func (domainMappings *ProjectDomainMappings) NewListOptions() *ListDomainMappingsOptions {
+	return domainMappings.codeEngine.NewListDomainMappingsOptions(domainMappings.projectID)
+}
+
+// Create : Create a domain mapping
+// Create a domain mapping.
+//
+// The ProjectID of the options is set to the ID of the project; nil options are treated as empty options.
+func (domainMappings *ProjectDomainMappings) Create(ctx context.Context, createDomainMappingOptions *CreateDomainMappingOptions) (result *DomainMapping, response *core.DetailedResponse, err error) {
+	options := CreateDomainMappingOptions{}
+	if createDomainMappingOptions != nil {
+		options = *createDomainMappingOptions
+	}
+	options.ProjectID = core.StringPtr(domainMappings.projectID)
+	return domainMappings.codeEngine.CreateDomainMappingWithContext(ctx, &options)
+}
+
+// NewCreateOptions : Instantiate CreateDomainMappingOptions for the project
+func (domainMappings *ProjectDomainMappings) NewCreateOptions(component *ComponentRef, name string, tlsSecret string) *CreateDomainMappingOptions {
+	return domainMappings.codeEngine.NewCreateDomainMappingOptions(domainMappings.projectID, component, name, tlsSecret)
+}
+
+// Delete : Delete a domain mapping
+// Delete a domain mapping.
+//
+// The ProjectID of the options is set to the ID of the project; nil options are treated as empty options.
+func (domainMappings *ProjectDomainMappings) Delete(ctx context.Context, deleteDomainMappingOptions *DeleteDomainMappingOptions) (response *core.DetailedResponse, err error) {
+	options := DeleteDomainMappingOptions{}
+	if deleteDomainMappingOptions != nil {
+		options = *deleteDomainMappingOptions
+	}
+	options.ProjectID = core.StringPtr(domainMappings.projectID)
+	return domainMappings.codeEngine.DeleteDomainMappingWithContext(ctx, &options)
+}
+
+// NewDeleteOptions : Instantiate DeleteDomainMappingOptions for the project
+func (domainMappings *ProjectDomainMappings) NewDeleteOptions(name string) *DeleteDomainMappingOptions {
+	return domainMappings.codeEngine.NewDeleteDomainMappingOptions(domainMappings.projectID, name)
+}
+
+// Get : Get a domain mapping
+// Get domain mapping.
+//
+// The ProjectID of the options is set to the ID of the project; nil options are treated as empty options.
+func (domainMappings *ProjectDomainMappings) Get(ctx context.Context, getDomainMappingOptions *GetDomainMappingOptions) (result *DomainMapping, response *core.DetailedResponse, err error) {
+	options := GetDomainMappingOptions{}
+	if getDomainMappingOptions != nil {
+		options = *getDomainMappingOptions
+	}
+	options.ProjectID = core.StringPtr(domainMappings.projectID)
+	return domainMappings.codeEngine.GetDomainMappingWithContext(ctx, &options)
+}
+
+// NewGetOptions : Instantiate GetDomainMappingOptions for the project
+func (domainMappings *ProjectDomainMappings) NewGetOptions(name string) *GetDomainMappingOptions {
+	return domainMappings.codeEngine.NewGetDomainMappingOptions(domainMappings.projectID, name)
+}
+
+// Update : Update a domain mapping
+// Update a domain mapping.
+//
+// The ProjectID of the options is set to the ID of the project; nil options are treated as empty options.
+func (domainMappings *ProjectDomainMappings) Update(ctx context.Context, updateDomainMappingOptions *UpdateDomainMappingOptions) (result *DomainMapping, response *core.DetailedResponse, err error) {
+	options := UpdateDomainMappingOptions{}
+	if updateDomainMappingOptions != nil {
+		options = *updateDomainMappingOptions
+	}
+	options.ProjectID = core.StringPtr(domainMappings.projectID)
+	return domainMappings.codeEngine.UpdateDomainMappingWithContext(ctx, &options)
+}
+
+// NewUpdateOptions : Instantiate UpdateDomainMappingOptions for the project
+func (domainMappings *ProjectDomainMappings) NewUpdateOptions(name string, ifMatch string, domainMapping map[string]interface{}) *UpdateDomainMappingOptions {
+	return domainMappings.codeEngine.NewUpdateDomainMappingOptions(domainMappings.projectID, name, ifMatch, domainMapping)
+}
+
+// NewPager returns a new DomainMappingsPager of the project. The ProjectID of the options is set to the ID of the project; nil
+// options are treated as empty options.
+func (domainMappings *ProjectDomainMappings) NewPager(options *ListDomainMappingsOptions) (pager *DomainMappingsPager, err error) {
+	copied := ListDomainMappingsOptions{}
+	if options != nil {
+		copied = *options
+	}
+	copied.ProjectID = core.StringPtr(domainMappings.projectID)
+	return domainMappings.codeEngine.NewDomainMappingsPager(&copied)
+}
+
+// ProjectConfigMaps : The operations of the ConfigMaps resource group in a project.
+type ProjectConfigMaps struct {
+	codeEngine *CodeEngineV2
+	projectID  string
+}
+
+// ConfigMaps returns the operations of the ConfigMaps resource group in the project.
+func (project *ProjectClient) ConfigMaps() *ProjectConfigMaps {
+	return &ProjectConfigMaps{codeEngine: project.codeEngine, projectID: project.projectID}
+}
+
+// List : List config maps
+// List all config maps in a project.
+//
+// The ProjectID of the options is set to the ID of the project; nil options are treated as empty options.
+func (configMaps *ProjectConfigMaps) List(ctx context.Context, listConfigMapsOptions *ListConfigMapsOptions) (result *ConfigMapList, response *core.DetailedResponse, err error) {
+	options := ListConfigMapsOptions{}
+	if listConfigMapsOptions != nil {
+		options = *listConfigMapsOptions
+	}
+	options.ProjectID = core.StringPtr(configMaps.projectID)
+	return configMaps.codeEngine.ListConfigMapsWithContext(ctx, &options)
+}
+
+// NewListOptions : Instantiate ListConfigMapsOptions for the project
+func (configMaps *ProjectConfigMaps) NewListOptions() *ListConfigMapsOptions {
+	return configMaps.codeEngine.NewListConfigMapsOptions(configMaps.projectID)
+}
+
+// Create : Create a config map
+// Create a config map.
+//
+// The ProjectID of the options is set to the ID of the project; nil options are treated as empty options.
+func (configMaps *ProjectConfigMaps) Create(ctx context.Context, createConfigMapOptions *CreateConfigMapOptions) (result *ConfigMap, response *core.DetailedResponse, err error) {
+	options := CreateConfigMapOptions{}
+	if createConfigMapOptions != nil {
+		options = *createConfigMapOptions
+	}
+	options.ProjectID = core.StringPtr(configMaps.projectID)
+	return configMaps.codeEngine.CreateConfigMapWithContext(ctx, &options)
+}
+
+// NewCreateOptions : Instantiate CreateConfigMapOptions for the project
+func (configMaps *ProjectConfigMaps) NewCreateOptions(name string) *CreateConfigMapOptions {
+	return configMaps.codeEngine.NewCreateConfigMapOptions(configMaps.projectID, name)
+}
+
+// Delete : Delete a config map
+// Delete a config map.
+//
+// The ProjectID of the options is set to the ID of the project; nil options are treated as empty options.
+func (configMaps *ProjectConfigMaps) Delete(ctx context.Context, deleteConfigMapOptions *DeleteConfigMapOptions) (response *core.DetailedResponse, err error) {
+	options := DeleteConfigMapOptions{}
+	if deleteConfigMapOptions != nil {
+		options = *deleteConfigMapOptions
+	}
+	options.ProjectID = core.StringPtr(configMaps.projectID)
+	return configMaps.codeEngine.DeleteConfigMapWithContext(ctx, &options)
+}
+
+// NewDeleteOptions : Instantiate DeleteConfigMapOptions for the project
+func (configMaps *ProjectConfigMaps) NewDeleteOptions(name string) *DeleteConfigMapOptions {
+	return configMaps.codeEngine.NewDeleteConfigMapOptions(configMaps.projectID, name)
+}
+
+// Get : Get a config map
+// Display the details of a config map.
+//
+// The ProjectID of the options is set to the ID of the project; nil options are treated as empty options.
+func (configMaps *ProjectConfigMaps) Get(ctx context.Context, getConfigMapOptions *GetConfigMapOptions) (result *ConfigMap, response *core.DetailedResponse, err error) {
+	options := GetConfigMapOptions{}
+	if getConfigMapOptions != nil {
+		options = *getConfigMapOptions
+	}
+	options.ProjectID = core.StringPtr(configMaps.projectID)
+	return configMaps.codeEngine.GetConfigMapWithContext(ctx, &options)
+}
+
+// NewGetOptions : Instantiate GetConfigMapOptions for the project
+func (configMaps *ProjectConfigMaps) NewGetOptions(name string) *GetConfigMapOptions {
+	return configMaps.codeEngine.NewGetConfigMapOptions(configMaps.projectID, name)
+}
+
+// Replace : Update a config map
+// Update a config map.
+//
+// The ProjectID of the options is set to the ID of the project; nil options are treated as empty options.
+func (configMaps *ProjectConfigMaps) Replace(ctx context.Context, replaceConfigMapOptions *ReplaceConfigMapOptions) (result *ConfigMap, response *core.DetailedResponse, err error) {
+	options := ReplaceConfigMapOptions{}
+	if replaceConfigMapOptions != nil {
+		options = *replaceConfigMapOptions
+	}
+	options.ProjectID = core.StringPtr(configMaps.projectID)
+	return configMaps.codeEngine.ReplaceConfigMapWithContext(ctx, &options)
+}
+
+// NewReplaceOptions : Instantiate ReplaceConfigMapOptions for the project
+func (configMaps *ProjectConfigMaps) NewReplaceOptions(name string, ifMatch string) *ReplaceConfigMapOptions {
+	return configMaps.codeEngine.NewReplaceConfigMapOptions(configMaps.projectID, name, ifMatch)
+}
+
+// NewPager returns a new ConfigMapsPager of the project. The ProjectID of the options is set to the ID of the project; nil
+// options are treated as empty options.
+func (configMaps *ProjectConfigMaps) NewPager(options *ListConfigMapsOptions) (pager *ConfigMapsPager, err error) {
+	copied := ListConfigMapsOptions{}
+	if options != nil {
+		copied = *options
+	}
+	copied.ProjectID = core.StringPtr(configMaps.projectID)
+	return configMaps.codeEngine.NewConfigMapsPager(&copied)
+}
+
+// ProjectSecrets : The operations of the Secrets resource group in a project.
+type ProjectSecrets struct {
+	codeEngine *CodeEngineV2
+	projectID  string
+}
+
+// Secrets returns the operations of the Secrets resource group in the project.
+func (project *ProjectClient) Secrets() *ProjectSecrets {
+	return &ProjectSecrets{codeEngine: project.codeEngine, projectID: project.projectID}
+}
+
+// List : List secrets
+// List all secrets in a project.
+//
+// The ProjectID of the options is set to the ID of the project; nil options are treated as empty options.
+func (secrets *ProjectSecrets) List(ctx context.Context, listSecretsOptions *ListSecretsOptions) (result *SecretList, response *core.DetailedResponse, err error) {
+	options := ListSecretsOptions{}
+	if listSecretsOptions != nil {
+		options = *listSecretsOptions
+	}
+	options.ProjectID = core.StringPtr(secrets.projectID)
+	return secrets.codeEngine.ListSecretsWithContext(ctx, &options)
+}
+
+// NewListOptions : Instantiate ListSecretsOptions for the project
+func (secrets *ProjectSecrets) NewListOptions() *ListSecretsOptions {
+	return secrets.codeEngine.NewListSecretsOptions(secrets.projectID)
+}
+
+// Create : Create a secret
+// Create a secret.
+//
+// The ProjectID of the options is set to the ID of the project; nil options are treated as empty options.
+func (secrets *ProjectSecrets) Create(ctx context.Context, createSecretOptions *CreateSecretOptions) (result *Secret, response *core.DetailedResponse, err error) {
+	options := CreateSecretOptions{}
+	if createSecretOptions != nil {
+		options = *createSecretOptions
+	}
+	options.ProjectID = core.StringPtr(secrets.projectID)
+	return secrets.codeEngine.CreateSecretWithContext(ctx, &options)
+}
+
+// NewCreateOptions : Instantiate CreateSecretOptions for the project
+func (secrets *ProjectSecrets) NewCreateOptions(format string, name string) *CreateSecretOptions {
+	return secrets.codeEngine.NewCreateSecretOptions(secrets.projectID, format, name)
+}
+
+// Delete : Delete a secret
+// Delete a secret.
+//
+// The ProjectID of the options is set to the ID of the project; nil options are treated as empty options.
+func (secrets *ProjectSecrets) Delete(ctx context.Context, deleteSecretOptions *DeleteSecretOptions) (response *core.DetailedResponse, err error) {
+	options := DeleteSecretOptions{}
+	if deleteSecretOptions != nil {
+		options = *deleteSecretOptions
+	}
+	options.ProjectID = core.StringPtr(secrets.projectID)
+	return secrets.codeEngine.DeleteSecretWithContext(ctx, &options)
+}
+
+// NewDeleteOptions : Instantiate DeleteSecretOptions for the project
+func (secrets *ProjectSecrets) NewDeleteOptions(name string) *DeleteSecretOptions {
+	return secrets.codeEngine.NewDeleteSecretOptions(secrets.projectID, name)
+}
+
+// Get : Get a secret
+// Get a secret.
+//
+// The ProjectID of the options is set to the ID of the project; nil options are treated as empty options.
+func (secrets *ProjectSecrets) Get(ctx context.Context, getSecretOptions *GetSecretOptions) (result *Secret, response *core.DetailedResponse, err error) {
+	options := GetSecretOptions{}
+	if getSecretOptions != nil {
+		options = *getSecretOptions
+	}
+	options.ProjectID = core.StringPtr(secrets.projectID)
+	return secrets.codeEngine.GetSecretWithContext(ctx, &options)
+}
+
+// NewGetOptions : Instantiate GetSecretOptions for the project
+func (secrets *ProjectSecrets) NewGetOptions(name string) *GetSecretOptions {
+	return secrets.codeEngine.NewGetSecretOptions(secrets.projectID, name)
+}
+
+// Replace : Update a secret
+// Update a secret.
+//
+// The ProjectID of the options is set to the ID of the project; nil options are treated as empty options.
+func (secrets *ProjectSecrets) Replace(ctx context.Context, replaceSecretOptions *ReplaceSecretOptions) (result *Secret, response *core.DetailedResponse, err error) {
+	options := ReplaceSecretOptions{}
+	if replaceSecretOptions != nil {
+		options = *replaceSecretOptions
+	}
+	options.ProjectID = core.StringPtr(secrets.projectID)
+	return secrets.codeEngine.ReplaceSecretWithContext(ctx, &options)
+}
+
+// NewReplaceOptions : Instantiate ReplaceSecretOptions for the project
+func (secrets *ProjectSecrets) NewReplaceOptions(name string, ifMatch string, format string) *ReplaceSecretOptions {
+	return secrets.codeEngine.NewReplaceSecretOptions(secrets.projectID, name, ifMatch, format)
+}
+
+// NewPager returns a new SecretsPager of the project. The ProjectID of the options is set to the ID of the project; nil
+// options are treated as empty options.
+func (secrets *ProjectSecrets) NewPager(options *ListSecretsOptions) (pager *SecretsPager, err error) {
+	copied := ListSecretsOptions{}
+	if options != nil {
+		copied = *options
+	}
+	copied.ProjectID = core.StringPtr(secrets.projectID)
+	return secrets.codeEngine.NewSecretsPager(&copied)
+}
+
+// ProjectPersistentDataStores : The operations of the PersistentDataStores resource group in a project.
+type ProjectPersistentDataStores struct {
+	codeEngine *CodeEngineV2
+	projectID  string
+}
+
+// PersistentDataStores returns the operations of the PersistentDataStores resource group in the project.
+func (project *ProjectClient) PersistentDataStores() *ProjectPersistentDataStores {
+	return &ProjectPersistentDataStores{codeEngine: project.codeEngine, projectID: project.projectID}
+}
+
+// List : List persistent data stores
+// List all persistent data stores in a project.
+//
+// The ProjectID of the options is set to the ID of the project; nil options are treated as empty options.
+func (persistentDataStores *ProjectPersistentDataStores) List(ctx context.Context, listPersistentDataStoresOptions *ListPersistentDataStoresOptions) (result *PersistentDataStoreList, response *core.DetailedResponse, err error) {
+	options := ListPersistentDataStoresOptions{}
+	if listPersistentDataStoresOptions != nil {
+		options = *listPersistentDataStoresOptions
+	}
+	options.ProjectID = core.StringPtr(persistentDataStores.projectID)
+	return persistentDataStores.codeEngine.ListPersistentDataStoresWithContext(ctx, &options)
+}
+
+// NewListOptions : Instantiate ListPersistentDataStoresOptions for the project
+func (persistentDataStores *ProjectPersistentDataStores) NewListOptions() *ListPersistentDataStoresOptions {
+	return persistentDataStores.codeEngine.NewListPersistentDataStoresOptions(persistentDataStores.projectID)
+}
+
+// Create : Create a persistent data store
+// Create a persistent data store.
+//
+// The ProjectID of the options is set to the ID of the project; nil options are treated as empty options.
+func (persistentDataStores *ProjectPersistentDataStores) Create(ctx context.Context, createPersistentDataStoreOptions *CreatePersistentDataStoreOptions) (result *PersistentDataStore, response *core.DetailedResponse, err error) {
+	options := CreatePersistentDataStoreOptions{}
+	if createPersistentDataStoreOptions != nil {
+		options = *createPersistentDataStoreOptions
+	}
+	options.ProjectID = core.StringPtr(persistentDataStores.projectID)
+	return persistentDataStores.codeEngine.CreatePersistentDataStoreWithContext(ctx, &options)
+}
+
+// NewCreateOptions : Instantiate CreatePersistentDataStoreOptions for the project
+func (persistentDataStores *ProjectPersistentDataStores) NewCreateOptions(name string, storageType string) *CreatePersistentDataStoreOptions {
+	return persistentDataStores.codeEngine.NewCreatePersistentDataStoreOptions(persistentDataStores.projectID, name, storageType)
+}
+
+// Delete : Delete a persistent data store
+// Delete a persistent data store.
+//
+// The ProjectID of the options is set to the ID of the project; nil options are treated as empty options.
+func (persistentDataStores *ProjectPersistentDataStores) Delete(ctx context.Context, deletePersistentDataStoreOptions *DeletePersistentDataStoreOptions) (response *core.DetailedResponse, err error) {
+	options := DeletePersistentDataStoreOptions{}
+	if deletePersistentDataStoreOptions != nil {
+		options = *deletePersistentDataStoreOptions
+	}
+	options.ProjectID = core.StringPtr(persistentDataStores.projectID)
+	return persistentDataStores.codeEngine.DeletePersistentDataStoreWithContext(ctx, &options)
+}
+
+// NewDeleteOptions : Instantiate DeletePersistentDataStoreOptions for the project
+func (persistentDataStores *ProjectPersistentDataStores) NewDeleteOptions(name string) *DeletePersistentDataStoreOptions {
+	return persistentDataStores.codeEngine.NewDeletePersistentDataStoreOptions(persistentDataStores.projectID, name)
+}
+
+// Get : Get a persistent data store
+// Get a persistent data store.
+//
+// The ProjectID of the options is set to the ID of the project; nil options are treated as empty options.
+func (persistentDataStores *ProjectPersistentDataStores) Get(ctx context.Context, getPersistentDataStoreOptions *GetPersistentDataStoreOptions) (result *PersistentDataStore, response *core.DetailedResponse, err error) {
+	options := GetPersistentDataStoreOptions{}
+	if getPersistentDataStoreOptions != nil {
+		options = *getPersistentDataStoreOptions
+	}
+	options.ProjectID = core.StringPtr(persistentDataStores.projectID)
+	return persistentDataStores.codeEngine.GetPersistentDataStoreWithContext(ctx, &options)
+}
+
+// NewGetOptions : Instantiate GetPersistentDataStoreOptions for the project
+func (persistentDataStores *ProjectPersistentDataStores) NewGetOptions(name string) *GetPersistentDataStoreOptions {
+	return persistentDataStores.codeEngine.NewGetPersistentDataStoreOptions(persistentDataStores.projectID, name)
+}
+
+// NewPager returns a new PersistentDataStoresPager of the project. The ProjectID of the options is set to the ID of the project; nil
+// options are treated as empty options.
+func (persistentDataStores *ProjectPersistentDataStores) NewPager(options *ListPersistentDataStoresOptions) (pager *PersistentDataStoresPager, err error) {
+	copied := ListPersistentDataStoresOptions{}
+	if options != nil {
+		copied = *options
+	}
+	copied.ProjectID = core.StringPtr(persistentDataStores.projectID)
+	return persistentDataStores.codeEngine.NewPersistentDataStoresPager(&copied)
+}
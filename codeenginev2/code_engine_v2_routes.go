@@ -0,0 +1,91 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by apigen from code_engine_v2.go. DO NOT EDIT.
+
+package codeenginev2
+
+// operationRoutes are the routes of the operations by their operation ID, which locate the project and resource in
+// the path of a request.
+var operationRoutes = map[string]operationRoute{
+	"ListProjects":                     {"/projects", true},
+	"CreateProject":                    {"/projects", false},
+	"DeleteProject":                    {"/projects/{id}", false},
+	"GetProject":                       {"/projects/{id}", false},
+	"ListAllowedOutboundDestinations":  {"/projects/{project_id}/allowed_outbound_destinations", true},
+	"CreateAllowedOutboundDestination": {"/projects/{project_id}/allowed_outbound_destinations", false},
+	"DeleteAllowedOutboundDestination": {"/projects/{project_id}/allowed_outbound_destinations/{name}", false},
+	"GetAllowedOutboundDestination":    {"/projects/{project_id}/allowed_outbound_destinations/{name}", false},
+	"UpdateAllowedOutboundDestination": {"/projects/{project_id}/allowed_outbound_destinations/{name}", false},
+	"GetProjectEgressIps":              {"/projects/{project_id}/egress_ips", false},
+	"GetProjectStatusDetails":          {"/projects/{project_id}/status_details", false},
+	"ListApps":                         {"/projects/{project_id}/apps", true},
+	"CreateApp":                        {"/projects/{project_id}/apps", false},
+	"ListAppInstances":                 {"/projects/{project_id}/apps/{app_name}/instances", true},
+	"ListAppRevisions":                 {"/projects/{project_id}/apps/{app_name}/revisions", true},
+	"DeleteAppRevision":                {"/projects/{project_id}/apps/{app_name}/revisions/{name}", false},
+	"GetAppRevision":                   {"/projects/{project_id}/apps/{app_name}/revisions/{name}", false},
+	"DeleteApp":                        {"/projects/{project_id}/apps/{name}", false},
+	"GetApp":                           {"/projects/{project_id}/apps/{name}", false},
+	"UpdateApp":                        {"/projects/{project_id}/apps/{name}", false},
+	"ListJobRuns":                      {"/projects/{project_id}/job_runs", true},
+	"CreateJobRun":                     {"/projects/{project_id}/job_runs", false},
+	"DeleteJobRun":                     {"/projects/{project_id}/job_runs/{name}", false},
+	"GetJobRun":                        {"/projects/{project_id}/job_runs/{name}", false},
+	"ListJobs":                         {"/projects/{project_id}/jobs", true},
+	"CreateJob":                        {"/projects/{project_id}/jobs", false},
+	"DeleteJob":                        {"/projects/{project_id}/jobs/{name}", false},
+	"GetJob":                           {"/projects/{project_id}/jobs/{name}", false},
+	"UpdateJob":                        {"/projects/{project_id}/jobs/{name}", false},
+	"ListFunctionRuntimes":             {"/function_runtimes", false},
+	"ListFunctions":                    {"/projects/{project_id}/functions", true},
+	"CreateFunction":                   {"/projects/{project_id}/functions", false},
+	"DeleteFunction":                   {"/projects/{project_id}/functions/{name}", false},
+	"GetFunction":                      {"/projects/{project_id}/functions/{name}", false},
+	"UpdateFunction":                   {"/projects/{project_id}/functions/{name}", false},
+	"ListBindings":                     {"/projects/{project_id}/bindings", true},
+	"CreateBinding":                    {"/projects/{project_id}/bindings", false},
+	"DeleteBinding":                    {"/projects/{project_id}/bindings/{id}", false},
+	"GetBinding":                       {"/projects/{project_id}/bindings/{id}", false},
+	"ListBuildRuns":                    {"/projects/{project_id}/build_runs", true},
+	"CreateBuildRun":                   {"/projects/{project_id}/build_runs", false},
+	"DeleteBuildRun":                   {"/projects/{project_id}/build_runs/{name}", false},
+	"GetBuildRun":                      {"/projects/{project_id}/build_runs/{name}", false},
+	"ListBuilds":                       {"/projects/{project_id}/builds", true},
+	"CreateBuild":                      {"/projects/{project_id}/builds", false},
+	"DeleteBuild":                      {"/projects/{project_id}/builds/{name}", false},
+	"GetBuild":                         {"/projects/{project_id}/builds/{name}", false},
+	"UpdateBuild":                      {"/projects/{project_id}/builds/{name}", false},
+	"ListDomainMappings":               {"/projects/{project_id}/domain_mappings", true},
+	"CreateDomainMapping":              {"/projects/{project_id}/domain_mappings", false},
+	"DeleteDomainMapping":              {"/projects/{project_id}/domain_mappings/{name}", false},
+	"GetDomainMapping":                 {"/projects/{project_id}/domain_mappings/{name}", false},
+	"UpdateDomainMapping":              {"/projects/{project_id}/domain_mappings/{name}", false},
+	"ListConfigMaps":                   {"/projects/{project_id}/config_maps", true},
+	"CreateConfigMap":                  {"/projects/{project_id}/config_maps", false},
+	"DeleteConfigMap":                  {"/projects/{project_id}/config_maps/{name}", false},
+	"GetConfigMap":                     {"/projects/{project_id}/config_maps/{name}", false},
+	"ReplaceConfigMap":                 {"/projects/{project_id}/config_maps/{name}", false},
+	"ListSecrets":                      {"/projects/{project_id}/secrets", true},
+	"CreateSecret":                     {"/projects/{project_id}/secrets", false},
+	"DeleteSecret":                     {"/projects/{project_id}/secrets/{name}", false},
+	"GetSecret":                        {"/projects/{project_id}/secrets/{name}", false},
+	"ReplaceSecret":                    {"/projects/{project_id}/secrets/{name}", false},
+	"ListPersistentDataStores":         {"/projects/{project_id}/persistent_data_stores", true},
+	"CreatePersistentDataStore":        {"/projects/{project_id}/persistent_data_stores", false},
+	"DeletePersistentDataStore":        {"/projects/{project_id}/persistent_data_stores/{name}", false},
+	"GetPersistentDataStore":           {"/projects/{project_id}/persistent_data_stores/{name}", false},
+}
@@ -160,6 +160,9 @@ var _ = Describe(`CodeEngineV2`, func() {
 			Expect(url).To(BeEmpty())
 			Expect(err).ToNot(BeNil())
 			fmt.Fprintf(GinkgoWriter, "Expected error: %s\n", err.Error())
+			url, err = codeenginev2.GetServiceURLForRegion("eu-de")
+			Expect(url).To(Equal("https://api.eu-de.codeengine.cloud.ibm.com/v2"))
+			Expect(err).To(BeNil())
 		})
 	})
 	Describe(`ListProjects(listProjectsOptions *ListProjectsOptions) - Operation response error`, func() {
@@ -0,0 +1,1259 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by apigen from code_engine_v2.go. DO NOT EDIT.
+
+package codeenginev2mock
+
+import (
+	"context"
+
+	"github.com/IBM/code-engine-go-sdk/codeenginev2"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Mock : A mock of the CodeEngineAPI that records its calls and returns the results of its programmable functions.
+//
+// The result of an operation, e.g. GetApp, is programmed by setting the corresponding function, e.g. GetAppFunc. Both
+// variants of an operation, e.g. GetApp and GetAppWithContext, call the same function and are recorded under the name
+// of the operation. Operations whose function is not set return an ErrNotProgrammed error.
+type Mock struct {
+	recorder
+
+	// ListProjectsFunc is called by ListProjects and ListProjectsWithContext.
+	ListProjectsFunc func(ctx context.Context, listProjectsOptions *codeenginev2.ListProjectsOptions) (*codeenginev2.ProjectList, *core.DetailedResponse, error)
+
+	// CreateProjectFunc is called by CreateProject and CreateProjectWithContext.
+	CreateProjectFunc func(ctx context.Context, createProjectOptions *codeenginev2.CreateProjectOptions) (*codeenginev2.Project, *core.DetailedResponse, error)
+
+	// DeleteProjectFunc is called by DeleteProject and DeleteProjectWithContext.
+	DeleteProjectFunc func(ctx context.Context, deleteProjectOptions *codeenginev2.DeleteProjectOptions) (*core.DetailedResponse, error)
+
+	// GetProjectFunc is called by GetProject and GetProjectWithContext.
+	GetProjectFunc func(ctx context.Context, getProjectOptions *codeenginev2.GetProjectOptions) (*codeenginev2.Project, *core.DetailedResponse, error)
+
+	// GetProjectEgressIpsFunc is called by GetProjectEgressIps and GetProjectEgressIpsWithContext.
+	GetProjectEgressIpsFunc func(ctx context.Context, getProjectEgressIpsOptions *codeenginev2.GetProjectEgressIpsOptions) (*codeenginev2.ProjectEgressIPAddresses, *core.DetailedResponse, error)
+
+	// GetProjectStatusDetailsFunc is called by GetProjectStatusDetails and GetProjectStatusDetailsWithContext.
+	GetProjectStatusDetailsFunc func(ctx context.Context, getProjectStatusDetailsOptions *codeenginev2.GetProjectStatusDetailsOptions) (*codeenginev2.ProjectStatusDetails, *core.DetailedResponse, error)
+
+	// ListAllowedOutboundDestinationsFunc is called by ListAllowedOutboundDestinations and ListAllowedOutboundDestinationsWithContext.
+	ListAllowedOutboundDestinationsFunc func(ctx context.Context, listAllowedOutboundDestinationsOptions *codeenginev2.ListAllowedOutboundDestinationsOptions) (*codeenginev2.AllowedOutboundDestinationList, *core.DetailedResponse, error)
+
+	// CreateAllowedOutboundDestinationFunc is called by CreateAllowedOutboundDestination and CreateAllowedOutboundDestinationWithContext.
+	CreateAllowedOutboundDestinationFunc func(ctx context.Context, createAllowedOutboundDestinationOptions *codeenginev2.CreateAllowedOutboundDestinationOptions) (codeenginev2.AllowedOutboundDestinationIntf, *core.DetailedResponse, error)
+
+	// DeleteAllowedOutboundDestinationFunc is called by DeleteAllowedOutboundDestination and DeleteAllowedOutboundDestinationWithContext.
+	DeleteAllowedOutboundDestinationFunc func(ctx context.Context, deleteAllowedOutboundDestinationOptions *codeenginev2.DeleteAllowedOutboundDestinationOptions) (*core.DetailedResponse, error)
+
+	// GetAllowedOutboundDestinationFunc is called by GetAllowedOutboundDestination and GetAllowedOutboundDestinationWithContext.
+	GetAllowedOutboundDestinationFunc func(ctx context.Context, getAllowedOutboundDestinationOptions *codeenginev2.GetAllowedOutboundDestinationOptions) (codeenginev2.AllowedOutboundDestinationIntf, *core.DetailedResponse, error)
+
+	// UpdateAllowedOutboundDestinationFunc is called by UpdateAllowedOutboundDestination and UpdateAllowedOutboundDestinationWithContext.
+	UpdateAllowedOutboundDestinationFunc func(ctx context.Context, updateAllowedOutboundDestinationOptions *codeenginev2.UpdateAllowedOutboundDestinationOptions) (codeenginev2.AllowedOutboundDestinationIntf, *core.DetailedResponse, error)
+
+	// ListAppsFunc is called by ListApps and ListAppsWithContext.
+	ListAppsFunc func(ctx context.Context, listAppsOptions *codeenginev2.ListAppsOptions) (*codeenginev2.AppList, *core.DetailedResponse, error)
+
+	// CreateAppFunc is called by CreateApp and CreateAppWithContext.
+	CreateAppFunc func(ctx context.Context, createAppOptions *codeenginev2.CreateAppOptions) (*codeenginev2.App, *core.DetailedResponse, error)
+
+	// ListAppInstancesFunc is called by ListAppInstances and ListAppInstancesWithContext.
+	ListAppInstancesFunc func(ctx context.Context, listAppInstancesOptions *codeenginev2.ListAppInstancesOptions) (*codeenginev2.AppInstanceList, *core.DetailedResponse, error)
+
+	// ListAppRevisionsFunc is called by ListAppRevisions and ListAppRevisionsWithContext.
+	ListAppRevisionsFunc func(ctx context.Context, listAppRevisionsOptions *codeenginev2.ListAppRevisionsOptions) (*codeenginev2.AppRevisionList, *core.DetailedResponse, error)
+
+	// DeleteAppRevisionFunc is called by DeleteAppRevision and DeleteAppRevisionWithContext.
+	DeleteAppRevisionFunc func(ctx context.Context, deleteAppRevisionOptions *codeenginev2.DeleteAppRevisionOptions) (*core.DetailedResponse, error)
+
+	// GetAppRevisionFunc is called by GetAppRevision and GetAppRevisionWithContext.
+	GetAppRevisionFunc func(ctx context.Context, getAppRevisionOptions *codeenginev2.GetAppRevisionOptions) (*codeenginev2.AppRevision, *core.DetailedResponse, error)
+
+	// DeleteAppFunc is called by DeleteApp and DeleteAppWithContext.
+	DeleteAppFunc func(ctx context.Context, deleteAppOptions *codeenginev2.DeleteAppOptions) (*core.DetailedResponse, error)
+
+	// GetAppFunc is called by GetApp and GetAppWithContext.
+	GetAppFunc func(ctx context.Context, getAppOptions *codeenginev2.GetAppOptions) (*codeenginev2.App, *core.DetailedResponse, error)
+
+	// UpdateAppFunc is called by UpdateApp and UpdateAppWithContext.
+	UpdateAppFunc func(ctx context.Context, updateAppOptions *codeenginev2.UpdateAppOptions) (*codeenginev2.App, *core.DetailedResponse, error)
+
+	// ListJobRunsFunc is called by ListJobRuns and ListJobRunsWithContext.
+	ListJobRunsFunc func(ctx context.Context, listJobRunsOptions *codeenginev2.ListJobRunsOptions) (*codeenginev2.JobRunList, *core.DetailedResponse, error)
+
+	// CreateJobRunFunc is called by CreateJobRun and CreateJobRunWithContext.
+	CreateJobRunFunc func(ctx context.Context, createJobRunOptions *codeenginev2.CreateJobRunOptions) (*codeenginev2.JobRun, *core.DetailedResponse, error)
+
+	// DeleteJobRunFunc is called by DeleteJobRun and DeleteJobRunWithContext.
+	DeleteJobRunFunc func(ctx context.Context, deleteJobRunOptions *codeenginev2.DeleteJobRunOptions) (*core.DetailedResponse, error)
+
+	// GetJobRunFunc is called by GetJobRun and GetJobRunWithContext.
+	GetJobRunFunc func(ctx context.Context, getJobRunOptions *codeenginev2.GetJobRunOptions) (*codeenginev2.JobRun, *core.DetailedResponse, error)
+
+	// ListJobsFunc is called by ListJobs and ListJobsWithContext.
+	ListJobsFunc func(ctx context.Context, listJobsOptions *codeenginev2.ListJobsOptions) (*codeenginev2.JobList, *core.DetailedResponse, error)
+
+	// CreateJobFunc is called by CreateJob and CreateJobWithContext.
+	CreateJobFunc func(ctx context.Context, createJobOptions *codeenginev2.CreateJobOptions) (*codeenginev2.Job, *core.DetailedResponse, error)
+
+	// DeleteJobFunc is called by DeleteJob and DeleteJobWithContext.
+	DeleteJobFunc func(ctx context.Context, deleteJobOptions *codeenginev2.DeleteJobOptions) (*core.DetailedResponse, error)
+
+	// GetJobFunc is called by GetJob and GetJobWithContext.
+	GetJobFunc func(ctx context.Context, getJobOptions *codeenginev2.GetJobOptions) (*codeenginev2.Job, *core.DetailedResponse, error)
+
+	// UpdateJobFunc is called by UpdateJob and UpdateJobWithContext.
+	UpdateJobFunc func(ctx context.Context, updateJobOptions *codeenginev2.UpdateJobOptions) (*codeenginev2.Job, *core.DetailedResponse, error)
+
+	// ListFunctionRuntimesFunc is called by ListFunctionRuntimes and ListFunctionRuntimesWithContext.
+	ListFunctionRuntimesFunc func(ctx context.Context, listFunctionRuntimesOptions *codeenginev2.ListFunctionRuntimesOptions) (*codeenginev2.FunctionRuntimeList, *core.DetailedResponse, error)
+
+	// ListFunctionsFunc is called by ListFunctions and ListFunctionsWithContext.
+	ListFunctionsFunc func(ctx context.Context, listFunctionsOptions *codeenginev2.ListFunctionsOptions) (*codeenginev2.FunctionList, *core.DetailedResponse, error)
+
+	// CreateFunctionFunc is called by CreateFunction and CreateFunctionWithContext.
+	CreateFunctionFunc func(ctx context.Context, createFunctionOptions *codeenginev2.CreateFunctionOptions) (*codeenginev2.Function, *core.DetailedResponse, error)
+
+	// DeleteFunctionFunc is called by DeleteFunction and DeleteFunctionWithContext.
+	DeleteFunctionFunc func(ctx context.Context, deleteFunctionOptions *codeenginev2.DeleteFunctionOptions) (*core.DetailedResponse, error)
+
+	// GetFunctionFunc is called by GetFunction and GetFunctionWithContext.
+	GetFunctionFunc func(ctx context.Context, getFunctionOptions *codeenginev2.GetFunctionOptions) (*codeenginev2.Function, *core.DetailedResponse, error)
+
+	// UpdateFunctionFunc is called by UpdateFunction and UpdateFunctionWithContext.
+	UpdateFunctionFunc func(ctx context.Context, updateFunctionOptions *codeenginev2.UpdateFunctionOptions) (*codeenginev2.Function, *core.DetailedResponse, error)
+
+	// ListBindingsFunc is called by ListBindings and ListBindingsWithContext.
+	ListBindingsFunc func(ctx context.Context, listBindingsOptions *codeenginev2.ListBindingsOptions) (*codeenginev2.BindingList, *core.DetailedResponse, error)
+
+	// CreateBindingFunc is called by CreateBinding and CreateBindingWithContext.
+	CreateBindingFunc func(ctx context.Context, createBindingOptions *codeenginev2.CreateBindingOptions) (*codeenginev2.Binding, *core.DetailedResponse, error)
+
+	// DeleteBindingFunc is called by DeleteBinding and DeleteBindingWithContext.
+	DeleteBindingFunc func(ctx context.Context, deleteBindingOptions *codeenginev2.DeleteBindingOptions) (*core.DetailedResponse, error)
+
+	// GetBindingFunc is called by GetBinding and GetBindingWithContext.
+	GetBindingFunc func(ctx context.Context, getBindingOptions *codeenginev2.GetBindingOptions) (*codeenginev2.Binding, *core.DetailedResponse, error)
+
+	// ListBuildRunsFunc is called by ListBuildRuns and ListBuildRunsWithContext.
+	ListBuildRunsFunc func(ctx context.Context, listBuildRunsOptions *codeenginev2.ListBuildRunsOptions) (*codeenginev2.BuildRunList, *core.DetailedResponse, error)
+
+	// CreateBuildRunFunc is called by CreateBuildRun and CreateBuildRunWithContext.
+	CreateBuildRunFunc func(ctx context.Context, createBuildRunOptions *codeenginev2.CreateBuildRunOptions) (*codeenginev2.BuildRun, *core.DetailedResponse, error)
+
+	// DeleteBuildRunFunc is called by DeleteBuildRun and DeleteBuildRunWithContext.
+	DeleteBuildRunFunc func(ctx context.Context, deleteBuildRunOptions *codeenginev2.DeleteBuildRunOptions) (*core.DetailedResponse, error)
+
+	// GetBuildRunFunc is called by GetBuildRun and GetBuildRunWithContext.
+	GetBuildRunFunc func(ctx context.Context, getBuildRunOptions *codeenginev2.GetBuildRunOptions) (*codeenginev2.BuildRun, *core.DetailedResponse, error)
+
+	// ListBuildsFunc is called by ListBuilds and ListBuildsWithContext.
+	ListBuildsFunc func(ctx context.Context, listBuildsOptions *codeenginev2.ListBuildsOptions) (*codeenginev2.BuildList, *core.DetailedResponse, error)
+
+	// CreateBuildFunc is called by CreateBuild and CreateBuildWithContext.
+	CreateBuildFunc func(ctx context.Context, createBuildOptions *codeenginev2.CreateBuildOptions) (*codeenginev2.Build, *core.DetailedResponse, error)
+
+	// DeleteBuildFunc is called by DeleteBuild and DeleteBuildWithContext.
+	DeleteBuildFunc func(ctx context.Context, deleteBuildOptions *codeenginev2.DeleteBuildOptions) (*core.DetailedResponse, error)
+
+	// GetBuildFunc is called by GetBuild and GetBuildWithContext.
+	GetBuildFunc func(ctx context.Context, getBuildOptions *codeenginev2.GetBuildOptions) (*codeenginev2.Build, *core.DetailedResponse, error)
+
+	// UpdateBuildFunc is called by UpdateBuild and UpdateBuildWithContext.
+	UpdateBuildFunc func(ctx context.Context, updateBuildOptions *codeenginev2.UpdateBuildOptions) (*codeenginev2.Build, *core.DetailedResponse, error)
+
+	// ListDomainMappingsFunc is called by ListDomainMappings and ListDomainMappingsWithContext.
+	ListDomainMappingsFunc func(ctx context.Context, listDomainMappingsOptions *codeenginev2.ListDomainMappingsOptions) (*codeenginev2.DomainMappingList, *core.DetailedResponse, error)
+
+	// CreateDomainMappingFunc is called by CreateDomainMapping and CreateDomainMappingWithContext.
+	CreateDomainMappingFunc func(ctx context.Context, createDomainMappingOptions *codeenginev2.CreateDomainMappingOptions) (*codeenginev2.DomainMapping, *core.DetailedResponse, error)
+
+	// DeleteDomainMappingFunc is called by DeleteDomainMapping and DeleteDomainMappingWithContext.
+	DeleteDomainMappingFunc func(ctx context.Context, deleteDomainMappingOptions *codeenginev2.DeleteDomainMappingOptions) (*core.DetailedResponse, error)
+
+	// GetDomainMappingFunc is called by GetDomainMapping and GetDomainMappingWithContext.
+	GetDomainMappingFunc func(ctx context.Context, getDomainMappingOptions *codeenginev2.GetDomainMappingOptions) (*codeenginev2.DomainMapping, *core.DetailedResponse, error)
+
+	// UpdateDomainMappingFunc is called by UpdateDomainMapping and UpdateDomainMappingWithContext.
+	UpdateDomainMappingFunc func(ctx context.Context, updateDomainMappingOptions *codeenginev2.UpdateDomainMappingOptions) (*codeenginev2.DomainMapping, *core.DetailedResponse, error)
+
+	// ListConfigMapsFunc is called by ListConfigMaps and ListConfigMapsWithContext.
+	ListConfigMapsFunc func(ctx context.Context, listConfigMapsOptions *codeenginev2.ListConfigMapsOptions) (*codeenginev2.ConfigMapList, *core.DetailedResponse, error)
+
+	// CreateConfigMapFunc is called by CreateConfigMap and CreateConfigMapWithContext.
+	CreateConfigMapFunc func(ctx context.Context, createConfigMapOptions *codeenginev2.CreateConfigMapOptions) (*codeenginev2.ConfigMap, *core.DetailedResponse, error)
+
+	// DeleteConfigMapFunc is called by DeleteConfigMap and DeleteConfigMapWithContext.
+	DeleteConfigMapFunc func(ctx context.Context, deleteConfigMapOptions *codeenginev2.DeleteConfigMapOptions) (*core.DetailedResponse, error)
+
+	// GetConfigMapFunc is called by GetConfigMap and GetConfigMapWithContext.
+	GetConfigMapFunc func(ctx context.Context, getConfigMapOptions *codeenginev2.GetConfigMapOptions) (*codeenginev2.ConfigMap, *core.DetailedResponse, error)
+
+	// ReplaceConfigMapFunc is called by ReplaceConfigMap and ReplaceConfigMapWithContext.
+	ReplaceConfigMapFunc func(ctx context.Context, replaceConfigMapOptions *codeenginev2.ReplaceConfigMapOptions) (*codeenginev2.ConfigMap, *core.DetailedResponse, error)
+
+	// ListSecretsFunc is called by ListSecrets and ListSecretsWithContext.
+	ListSecretsFunc func(ctx context.Context, listSecretsOptions *codeenginev2.ListSecretsOptions) (*codeenginev2.SecretList, *core.DetailedResponse, error)
+
+	// CreateSecretFunc is called by CreateSecret and CreateSecretWithContext.
+	CreateSecretFunc func(ctx context.Context, createSecretOptions *codeenginev2.CreateSecretOptions) (*codeenginev2.Secret, *core.DetailedResponse, error)
+
+	// DeleteSecretFunc is called by DeleteSecret and DeleteSecretWithContext.
+	DeleteSecretFunc func(ctx context.Context, deleteSecretOptions *codeenginev2.DeleteSecretOptions) (*core.DetailedResponse, error)
+
+	// GetSecretFunc is called by GetSecret and GetSecretWithContext.
+	GetSecretFunc func(ctx context.Context, getSecretOptions *codeenginev2.GetSecretOptions) (*codeenginev2.Secret, *core.DetailedResponse, error)
+
+	// ReplaceSecretFunc is called by ReplaceSecret and ReplaceSecretWithContext.
+	ReplaceSecretFunc func(ctx context.Context, replaceSecretOptions *codeenginev2.ReplaceSecretOptions) (*codeenginev2.Secret, *core.DetailedResponse, error)
+
+	// ListPersistentDataStoresFunc is called by ListPersistentDataStores and ListPersistentDataStoresWithContext.
+	ListPersistentDataStoresFunc func(ctx context.Context, listPersistentDataStoresOptions *codeenginev2.ListPersistentDataStoresOptions) (*codeenginev2.PersistentDataStoreList, *core.DetailedResponse, error)
+
+	// CreatePersistentDataStoreFunc is called by CreatePersistentDataStore and CreatePersistentDataStoreWithContext.
+	CreatePersistentDataStoreFunc func(ctx context.Context, createPersistentDataStoreOptions *codeenginev2.CreatePersistentDataStoreOptions) (*codeenginev2.PersistentDataStore, *core.DetailedResponse, error)
+
+	// DeletePersistentDataStoreFunc is called by DeletePersistentDataStore and DeletePersistentDataStoreWithContext.
+	DeletePersistentDataStoreFunc func(ctx context.Context, deletePersistentDataStoreOptions *codeenginev2.DeletePersistentDataStoreOptions) (*core.DetailedResponse, error)
+
+	// GetPersistentDataStoreFunc is called by GetPersistentDataStore and GetPersistentDataStoreWithContext.
+	GetPersistentDataStoreFunc func(ctx context.Context, getPersistentDataStoreOptions *codeenginev2.GetPersistentDataStoreOptions) (*codeenginev2.PersistentDataStore, *core.DetailedResponse, error)
+}
+
+// Compile-time assertions that Mock implements the interfaces.
+var (
+	_ codeenginev2.CodeEngineAPI                  = (*Mock)(nil)
+	_ codeenginev2.ProjectsAPI                    = (*Mock)(nil)
+	_ codeenginev2.AllowedOutboundDestinationsAPI = (*Mock)(nil)
+	_ codeenginev2.AppsAPI                        = (*Mock)(nil)
+	_ codeenginev2.JobRunsAPI                     = (*Mock)(nil)
+	_ codeenginev2.JobsAPI                        = (*Mock)(nil)
+	_ codeenginev2.FunctionsAPI                   = (*Mock)(nil)
+	_ codeenginev2.BindingsAPI                    = (*Mock)(nil)
+	_ codeenginev2.BuildRunsAPI                   = (*Mock)(nil)
+	_ codeenginev2.BuildsAPI                      = (*Mock)(nil)
+	_ codeenginev2.DomainMappingsAPI              = (*Mock)(nil)
+	_ codeenginev2.ConfigMapsAPI                  = (*Mock)(nil)
+	_ codeenginev2.SecretsAPI                     = (*Mock)(nil)
+	_ codeenginev2.PersistentDataStoresAPI        = (*Mock)(nil)
+)
+
+// ListProjects records the call and calls ListProjectsFunc.
+func (mock *Mock) ListProjects(listProjectsOptions *codeenginev2.ListProjectsOptions) (result *codeenginev2.ProjectList, response *core.DetailedResponse, err error) {
+	return mock.ListProjectsWithContext(context.Background(), listProjectsOptions)
+}
+
+// ListProjectsWithContext records the call and calls ListProjectsFunc.
+func (mock *Mock) ListProjectsWithContext(ctx context.Context, listProjectsOptions *codeenginev2.ListProjectsOptions) (result *codeenginev2.ProjectList, response *core.DetailedResponse, err error) {
+	mock.record("ListProjects", ctx, listProjectsOptions)
+	if mock.ListProjectsFunc == nil {
+		err = notProgrammed("ListProjects")
+		return
+	}
+	return mock.ListProjectsFunc(ctx, listProjectsOptions)
+}
+
+// CreateProject records the call and calls CreateProjectFunc.
+func (mock *Mock) CreateProject(createProjectOptions *codeenginev2.CreateProjectOptions) (result *codeenginev2.Project, response *core.DetailedResponse, err error) {
+	return mock.CreateProjectWithContext(context.Background(), createProjectOptions)
+}
+
+// CreateProjectWithContext records the call and calls CreateProjectFunc.
+func (mock *Mock) CreateProjectWithContext(ctx context.Context, createProjectOptions *codeenginev2.CreateProjectOptions) (result *codeenginev2.Project, response *core.DetailedResponse, err error) {
+	mock.record("CreateProject", ctx, createProjectOptions)
+	if mock.CreateProjectFunc == nil {
+		err = notProgrammed("CreateProject")
+		return
+	}
+	return mock.CreateProjectFunc(ctx, createProjectOptions)
+}
+
+// DeleteProject records the call and calls DeleteProjectFunc.
+func (mock *Mock) DeleteProject(deleteProjectOptions *codeenginev2.DeleteProjectOptions) (response *core.DetailedResponse, err error) {
+	return mock.DeleteProjectWithContext(context.Background(), deleteProjectOptions)
+}
+
+// DeleteProjectWithContext records the call and calls DeleteProjectFunc.
+func (mock *Mock) DeleteProjectWithContext(ctx context.Context, deleteProjectOptions *codeenginev2.DeleteProjectOptions) (response *core.DetailedResponse, err error) {
+	mock.record("DeleteProject", ctx, deleteProjectOptions)
+	if mock.DeleteProjectFunc == nil {
+		err = notProgrammed("DeleteProject")
+		return
+	}
+	return mock.DeleteProjectFunc(ctx, deleteProjectOptions)
+}
+
+// GetProject records the call and calls GetProjectFunc.
+func (mock *Mock) GetProject(getProjectOptions *codeenginev2.GetProjectOptions) (result *codeenginev2.Project, response *core.DetailedResponse, err error) {
+	return mock.GetProjectWithContext(context.Background(), getProjectOptions)
+}
+
+// GetProjectWithContext records the call and calls GetProjectFunc.
+func (mock *Mock) GetProjectWithContext(ctx context.Context, getProjectOptions *codeenginev2.GetProjectOptions) (result *codeenginev2.Project, response *core.DetailedResponse, err error) {
+	mock.record("GetProject", ctx, getProjectOptions)
+	if mock.GetProjectFunc == nil {
+		err = notProgrammed("GetProject")
+		return
+	}
+	return mock.GetProjectFunc(ctx, getProjectOptions)
+}
+
+// GetProjectEgressIps records the call and calls GetProjectEgressIpsFunc.
+func (mock *Mock) GetProjectEgressIps(getProjectEgressIpsOptions *codeenginev2.GetProjectEgressIpsOptions) (result *codeenginev2.ProjectEgressIPAddresses, response *core.DetailedResponse, err error) {
+	return mock.GetProjectEgressIpsWithContext(context.Background(), getProjectEgressIpsOptions)
+}
+
+// GetProjectEgressIpsWithContext records the call and calls GetProjectEgressIpsFunc.
+func (mock *Mock) GetProjectEgressIpsWithContext(ctx context.Context, getProjectEgressIpsOptions *codeenginev2.GetProjectEgressIpsOptions) (result *codeenginev2.ProjectEgressIPAddresses, response *core.DetailedResponse, err error) {
+	mock.record("GetProjectEgressIps", ctx, getProjectEgressIpsOptions)
+	if mock.GetProjectEgressIpsFunc == nil {
+		err = notProgrammed("GetProjectEgressIps")
+		return
+	}
+	return mock.GetProjectEgressIpsFunc(ctx, getProjectEgressIpsOptions)
+}
+
+// GetProjectStatusDetails records the call and calls GetProjectStatusDetailsFunc.
+func (mock *Mock) GetProjectStatusDetails(getProjectStatusDetailsOptions *codeenginev2.GetProjectStatusDetailsOptions) (result *codeenginev2.ProjectStatusDetails, response *core.DetailedResponse, err error) {
+	return mock.GetProjectStatusDetailsWithContext(context.Background(), getProjectStatusDetailsOptions)
+}
+
+// GetProjectStatusDetailsWithContext records the call and calls GetProjectStatusDetailsFunc.
+func (mock *Mock) GetProjectStatusDetailsWithContext(ctx context.Context, getProjectStatusDetailsOptions *codeenginev2.GetProjectStatusDetailsOptions) (result *codeenginev2.ProjectStatusDetails, response *core.DetailedResponse, err error) {
+	mock.record("GetProjectStatusDetails", ctx, getProjectStatusDetailsOptions)
+	if mock.GetProjectStatusDetailsFunc == nil {
+		err = notProgrammed("GetProjectStatusDetails")
+		return
+	}
+	return mock.GetProjectStatusDetailsFunc(ctx, getProjectStatusDetailsOptions)
+}
+
+// ListAllowedOutboundDestinations records the call and calls ListAllowedOutboundDestinationsFunc.
+func (mock *Mock) ListAllowedOutboundDestinations(listAllowedOutboundDestinationsOptions *codeenginev2.ListAllowedOutboundDestinationsOptions) (result *codeenginev2.AllowedOutboundDestinationList, response *core.DetailedResponse, err error) {
+	return mock.ListAllowedOutboundDestinationsWithContext(context.Background(), listAllowedOutboundDestinationsOptions)
+}
+
+// ListAllowedOutboundDestinationsWithContext records the call and calls ListAllowedOutboundDestinationsFunc.
+func (mock *Mock) ListAllowedOutboundDestinationsWithContext(ctx context.Context, listAllowedOutboundDestinationsOptions *codeenginev2.ListAllowedOutboundDestinationsOptions) (result *codeenginev2.AllowedOutboundDestinationList, response *core.DetailedResponse, err error) {
+	mock.record("ListAllowedOutboundDestinations", ctx, listAllowedOutboundDestinationsOptions)
+	if mock.ListAllowedOutboundDestinationsFunc == nil {
+		err = notProgrammed("ListAllowedOutboundDestinations")
+		return
+	}
+	return mock.ListAllowedOutboundDestinationsFunc(ctx, listAllowedOutboundDestinationsOptions)
+}
+
+// CreateAllowedOutboundDestination records the call and calls CreateAllowedOutboundDestinationFunc.
+func (mock *Mock) CreateAllowedOutboundDestination(createAllowedOutboundDestinationOptions *codeenginev2.CreateAllowedOutboundDestinationOptions) (result codeenginev2.AllowedOutboundDestinationIntf, response *core.DetailedResponse, err error) {
+	return mock.CreateAllowedOutboundDestinationWithContext(context.Background(), createAllowedOutboundDestinationOptions)
+}
+
+// CreateAllowedOutboundDestinationWithContext records the call and calls CreateAllowedOutboundDestinationFunc.
+func (mock *Mock) CreateAllowedOutboundDestinationWithContext(ctx context.Context, createAllowedOutboundDestinationOptions *codeenginev2.CreateAllowedOutboundDestinationOptions) (result codeenginev2.AllowedOutboundDestinationIntf, response *core.DetailedResponse, err error) {
+	mock.record("CreateAllowedOutboundDestination", ctx, createAllowedOutboundDestinationOptions)
+	if mock.CreateAllowedOutboundDestinationFunc == nil {
+		err = notProgrammed("CreateAllowedOutboundDestination")
+		return
+	}
+	return mock.CreateAllowedOutboundDestinationFunc(ctx, createAllowedOutboundDestinationOptions)
+}
+
+// DeleteAllowedOutboundDestination records the call and calls DeleteAllowedOutboundDestinationFunc.
+func (mock *Mock) DeleteAllowedOutboundDestination(deleteAllowedOutboundDestinationOptions *codeenginev2.DeleteAllowedOutboundDestinationOptions) (response *core.DetailedResponse, err error) {
+	return mock.DeleteAllowedOutboundDestinationWithContext(context.Background(), deleteAllowedOutboundDestinationOptions)
+}
+
+// DeleteAllowedOutboundDestinationWithContext records the call and calls DeleteAllowedOutboundDestinationFunc.
+func (mock *Mock) DeleteAllowedOutboundDestinationWithContext(ctx context.Context, deleteAllowedOutboundDestinationOptions *codeenginev2.DeleteAllowedOutboundDestinationOptions) (response *core.DetailedResponse, err error) {
+	mock.record("DeleteAllowedOutboundDestination", ctx, deleteAllowedOutboundDestinationOptions)
+	if mock.DeleteAllowedOutboundDestinationFunc == nil {
+		err = notProgrammed("DeleteAllowedOutboundDestination")
+		return
+	}
+	return mock.DeleteAllowedOutboundDestinationFunc(ctx, deleteAllowedOutboundDestinationOptions)
+}
+
+// GetAllowedOutboundDestination records the call and calls GetAllowedOutboundDestinationFunc.
+func (mock *Mock) GetAllowedOutboundDestination(getAllowedOutboundDestinationOptions *codeenginev2.GetAllowedOutboundDestinationOptions) (result codeenginev2.AllowedOutboundDestinationIntf, response *core.DetailedResponse, err error) {
+	return mock.GetAllowedOutboundDestinationWithContext(context.Background(), getAllowedOutboundDestinationOptions)
+}
+
+// GetAllowedOutboundDestinationWithContext records the call and calls GetAllowedOutboundDestinationFunc.
+func (mock *Mock) GetAllowedOutboundDestinationWithContext(ctx context.Context, getAllowedOutboundDestinationOptions *codeenginev2.GetAllowedOutboundDestinationOptions) (result codeenginev2.AllowedOutboundDestinationIntf, response *core.DetailedResponse, err error) {
+	mock.record("GetAllowedOutboundDestination", ctx, getAllowedOutboundDestinationOptions)
+	if mock.GetAllowedOutboundDestinationFunc == nil {
+		err = notProgrammed("GetAllowedOutboundDestination")
+		return
+	}
+	return mock.GetAllowedOutboundDestinationFunc(ctx, getAllowedOutboundDestinationOptions)
+}
+
+// UpdateAllowedOutboundDestination records the call and calls UpdateAllowedOutboundDestinationFunc.
+func (mock *Mock) UpdateAllowedOutboundDestination(updateAllowedOutboundDestinationOptions *codeenginev2.UpdateAllowedOutboundDestinationOptions) (result codeenginev2.AllowedOutboundDestinationIntf, response *core.DetailedResponse, err error) {
+	return mock.UpdateAllowedOutboundDestinationWithContext(context.Background(), updateAllowedOutboundDestinationOptions)
+}
+
+// UpdateAllowedOutboundDestinationWithContext records the call and calls UpdateAllowedOutboundDestinationFunc.
+func (mock *Mock) UpdateAllowedOutboundDestinationWithContext(ctx context.Context, updateAllowedOutboundDestinationOptions *codeenginev2.UpdateAllowedOutboundDestinationOptions) (result codeenginev2.AllowedOutboundDestinationIntf, response *core.DetailedResponse, err error) {
+	mock.record("UpdateAllowedOutboundDestination", ctx, updateAllowedOutboundDestinationOptions)
+	if mock.UpdateAllowedOutboundDestinationFunc == nil {
+		err = notProgrammed("UpdateAllowedOutboundDestination")
+		return
+	}
+	return mock.UpdateAllowedOutboundDestinationFunc(ctx, updateAllowedOutboundDestinationOptions)
+}
+
+// ListApps records the call and calls ListAppsFunc.
+func (mock *Mock) ListApps(listAppsOptions *codeenginev2.ListAppsOptions) (result *codeenginev2.AppList, response *core.DetailedResponse, err error) {
+	return mock.ListAppsWithContext(context.Background(), listAppsOptions)
+}
+
+// ListAppsWithContext records the call and calls ListAppsFunc.
+func (mock *Mock) ListAppsWithContext(ctx context.Context, listAppsOptions *codeenginev2.ListAppsOptions) (result *codeenginev2.AppList, response *core.DetailedResponse, err error) {
+	mock.record("ListApps", ctx, listAppsOptions)
+	if mock.ListAppsFunc == nil {
+		err = notProgrammed("ListApps")
+		return
+	}
+	return mock.ListAppsFunc(ctx, listAppsOptions)
+}
+
+// CreateApp records the call and calls CreateAppFunc.
+func (mock *Mock) CreateApp(createAppOptions *codeenginev2.CreateAppOptions) (result *codeenginev2.App, response *core.DetailedResponse, err error) {
+	return mock.CreateAppWithContext(context.Background(), createAppOptions)
+}
+
+// CreateAppWithContext records the call and calls CreateAppFunc.
+func (mock *Mock) CreateAppWithContext(ctx context.Context, createAppOptions *codeenginev2.CreateAppOptions) (result *codeenginev2.App, response *core.DetailedResponse, err error) {
+	mock.record("CreateApp", ctx, createAppOptions)
+	if mock.CreateAppFunc == nil {
+		err = notProgrammed("CreateApp")
+		return
+	}
+	return mock.CreateAppFunc(ctx, createAppOptions)
+}
+
+// ListAppInstances records the call and calls ListAppInstancesFunc.
+func (mock *Mock) ListAppInstances(listAppInstancesOptions *codeenginev2.ListAppInstancesOptions) (result *codeenginev2.AppInstanceList, response *core.DetailedResponse, err error) {
+	return mock.ListAppInstancesWithContext(context.Background(), listAppInstancesOptions)
+}
+
+// ListAppInstancesWithContext records the call and calls ListAppInstancesFunc.
+func (mock *Mock) ListAppInstancesWithContext(ctx context.Context, listAppInstancesOptions *codeenginev2.ListAppInstancesOptions) (result *codeenginev2.AppInstanceList, response *core.DetailedResponse, err error) {
+	mock.record("ListAppInstances", ctx, listAppInstancesOptions)
+	if mock.ListAppInstancesFunc == nil {
+		err = notProgrammed("ListAppInstances")
+		return
+	}
+	return mock.ListAppInstancesFunc(ctx, listAppInstancesOptions)
+}
+
+// ListAppRevisions records the call and calls ListAppRevisionsFunc.
+func (mock *Mock) ListAppRevisions(listAppRevisionsOptions *codeenginev2.ListAppRevisionsOptions) (result *codeenginev2.AppRevisionList, response *core.DetailedResponse, err error) {
+	return mock.ListAppRevisionsWithContext(context.Background(), listAppRevisionsOptions)
+}
+
+// ListAppRevisionsWithContext records the call and calls ListAppRevisionsFunc.
+func (mock *Mock) ListAppRevisionsWithContext(ctx context.Context, listAppRevisionsOptions *codeenginev2.ListAppRevisionsOptions) (result *codeenginev2.AppRevisionList, response *core.DetailedResponse, err error) {
+	mock.record("ListAppRevisions", ctx, listAppRevisionsOptions)
+	if mock.ListAppRevisionsFunc == nil {
+		err = notProgrammed("ListAppRevisions")
+		return
+	}
+	return mock.ListAppRevisionsFunc(ctx, listAppRevisionsOptions)
+}
+
+// DeleteAppRevision records the call and calls DeleteAppRevisionFunc.
+func (mock *Mock) DeleteAppRevision(deleteAppRevisionOptions *codeenginev2.DeleteAppRevisionOptions) (response *core.DetailedResponse, err error) {
+	return mock.DeleteAppRevisionWithContext(context.Background(), deleteAppRevisionOptions)
+}
+
+// DeleteAppRevisionWithContext records the call and calls DeleteAppRevisionFunc.
+func (mock *Mock) DeleteAppRevisionWithContext(ctx context.Context, deleteAppRevisionOptions *codeenginev2.DeleteAppRevisionOptions) (response *core.DetailedResponse, err error) {
+	mock.record("DeleteAppRevision", ctx, deleteAppRevisionOptions)
+	if mock.DeleteAppRevisionFunc == nil {
+		err = notProgrammed("DeleteAppRevision")
+		return
+	}
+	return mock.DeleteAppRevisionFunc(ctx, deleteAppRevisionOptions)
+}
+
+// GetAppRevision records the call and calls GetAppRevisionFunc.
+func (mock *Mock) GetAppRevision(getAppRevisionOptions *codeenginev2.GetAppRevisionOptions) (result *codeenginev2.AppRevision, response *core.DetailedResponse, err error) {
+	return mock.GetAppRevisionWithContext(context.Background(), getAppRevisionOptions)
+}
+
+// GetAppRevisionWithContext records the call and calls GetAppRevisionFunc.
+func (mock *Mock) GetAppRevisionWithContext(ctx context.Context, getAppRevisionOptions *codeenginev2.GetAppRevisionOptions) (result *codeenginev2.AppRevision, response *core.DetailedResponse, err error) {
+	mock.record("GetAppRevision", ctx, getAppRevisionOptions)
+	if mock.GetAppRevisionFunc == nil {
+		err = notProgrammed("GetAppRevision")
+		return
+	}
+	return mock.GetAppRevisionFunc(ctx, getAppRevisionOptions)
+}
+
+// DeleteApp records the call and calls DeleteAppFunc.
+func (mock *Mock) DeleteApp(deleteAppOptions *codeenginev2.DeleteAppOptions) (response *core.DetailedResponse, err error) {
+	return mock.DeleteAppWithContext(context.Background(), deleteAppOptions)
+}
+
+// DeleteAppWithContext records the call and calls DeleteAppFunc.
+func (mock *Mock) DeleteAppWithContext(ctx context.Context, deleteAppOptions *codeenginev2.DeleteAppOptions) (response *core.DetailedResponse, err error) {
+	mock.record("DeleteApp", ctx, deleteAppOptions)
+	if mock.DeleteAppFunc == nil {
+		err = notProgrammed("DeleteApp")
+		return
+	}
+	return mock.DeleteAppFunc(ctx, deleteAppOptions)
+}
+
+// GetApp records the call and calls GetAppFunc.
+func (mock *Mock) GetApp(getAppOptions *codeenginev2.GetAppOptions) (result *codeenginev2.App, response *core.DetailedResponse, err error) {
+	return mock.GetAppWithContext(context.Background(), getAppOptions)
+}
+
+// GetAppWithContext records the call and calls GetAppFunc.
+func (mock *Mock) GetAppWithContext(ctx context.Context, getAppOptions *codeenginev2.GetAppOptions) (result *codeenginev2.App, response *core.DetailedResponse, err error) {
+	mock.record("GetApp", ctx, getAppOptions)
+	if mock.GetAppFunc == nil {
+		err = notProgrammed("GetApp")
+		return
+	}
+	return mock.GetAppFunc(ctx, getAppOptions)
+}
+
+// UpdateApp records the call and calls UpdateAppFunc.
+func (mock *Mock) UpdateApp(updateAppOptions *codeenginev2.UpdateAppOptions) (result *codeenginev2.App, response *core.DetailedResponse, err error) {
+	return mock.UpdateAppWithContext(context.Background(), updateAppOptions)
+}
+
+// UpdateAppWithContext records the call and calls UpdateAppFunc.
+func (mock *Mock) UpdateAppWithContext(ctx context.Context, updateAppOptions *codeenginev2.UpdateAppOptions) (result *codeenginev2.App, response *core.DetailedResponse, err error) {
+	mock.record("UpdateApp", ctx, updateAppOptions)
+	if mock.UpdateAppFunc == nil {
+		err = notProgrammed("UpdateApp")
+		return
+	}
+	return mock.UpdateAppFunc(ctx, updateAppOptions)
+}
+
+// ListJobRuns records the call and calls ListJobRunsFunc.
+func (mock *Mock) ListJobRuns(listJobRunsOptions *codeenginev2.ListJobRunsOptions) (result *codeenginev2.JobRunList, response *core.DetailedResponse, err error) {
+	return mock.ListJobRunsWithContext(context.Background(), listJobRunsOptions)
+}
+
+// ListJobRunsWithContext records the call and calls ListJobRunsFunc.
+func (mock *Mock) ListJobRunsWithContext(ctx context.Context, listJobRunsOptions *codeenginev2.ListJobRunsOptions) (result *codeenginev2.JobRunList, response *core.DetailedResponse, err error) {
+	mock.record("ListJobRuns", ctx, listJobRunsOptions)
+	if mock.ListJobRunsFunc == nil {
+		err = notProgrammed("ListJobRuns")
+		return
+	}
+	return mock.ListJobRunsFunc(ctx, listJobRunsOptions)
+}
+
+// CreateJobRun records the call and calls CreateJobRunFunc.
+func (mock *Mock) CreateJobRun(createJobRunOptions *codeenginev2.CreateJobRunOptions) (result *codeenginev2.JobRun, response *core.DetailedResponse, err error) {
+	return mock.CreateJobRunWithContext(context.Background(), createJobRunOptions)
+}
+
+// CreateJobRunWithContext records the call and calls CreateJobRunFunc.
+func (mock *Mock) CreateJobRunWithContext(ctx context.Context, createJobRunOptions *codeenginev2.CreateJobRunOptions) (result *codeenginev2.JobRun, response *core.DetailedResponse, err error) {
+	mock.record("CreateJobRun", ctx, createJobRunOptions)
+	if mock.CreateJobRunFunc == nil {
+		err = notProgrammed("CreateJobRun")
+		return
+	}
+	return mock.CreateJobRunFunc(ctx, createJobRunOptions)
+}
+
+// DeleteJobRun records the call and calls DeleteJobRunFunc.
+func (mock *Mock) DeleteJobRun(deleteJobRunOptions *codeenginev2.DeleteJobRunOptions) (response *core.DetailedResponse, err error) {
+	return mock.DeleteJobRunWithContext(context.Background(), deleteJobRunOptions)
+}
+
+// DeleteJobRunWithContext records the call and calls DeleteJobRunFunc.
+func (mock *Mock) DeleteJobRunWithContext(ctx context.Context, deleteJobRunOptions *codeenginev2.DeleteJobRunOptions) (response *core.DetailedResponse, err error) {
+	mock.record("DeleteJobRun", ctx, deleteJobRunOptions)
+	if mock.DeleteJobRunFunc == nil {
+		err = notProgrammed("DeleteJobRun")
+		return
+	}
+	return mock.DeleteJobRunFunc(ctx, deleteJobRunOptions)
+}
+
+// GetJobRun records the call and calls GetJobRunFunc.
+func (mock *Mock) GetJobRun(getJobRunOptions *codeenginev2.GetJobRunOptions) (result *codeenginev2.JobRun, response *core.DetailedResponse, err error) {
+	return mock.GetJobRunWithContext(context.Background(), getJobRunOptions)
+}
+
+// GetJobRunWithContext records the call and calls GetJobRunFunc.
+func (mock *Mock) GetJobRunWithContext(ctx context.Context, getJobRunOptions *codeenginev2.GetJobRunOptions) (result *codeenginev2.JobRun, response *core.DetailedResponse, err error) {
+	mock.record("GetJobRun", ctx, getJobRunOptions)
+	if mock.GetJobRunFunc == nil {
+		err = notProgrammed("GetJobRun")
+		return
+	}
+	return mock.GetJobRunFunc(ctx, getJobRunOptions)
+}
+
+// ListJobs records the call and calls ListJobsFunc.
+func (mock *Mock) ListJobs(listJobsOptions *codeenginev2.ListJobsOptions) (result *codeenginev2.JobList, response *core.DetailedResponse, err error) {
+	return mock.ListJobsWithContext(context.Background(), listJobsOptions)
+}
+
+// ListJobsWithContext records the call and calls ListJobsFunc.
+func (mock *Mock) ListJobsWithContext(ctx context.Context, listJobsOptions *codeenginev2.ListJobsOptions) (result *codeenginev2.JobList, response *core.DetailedResponse, err error) {
+	mock.record("ListJobs", ctx, listJobsOptions)
+	if mock.ListJobsFunc == nil {
+		err = notProgrammed("ListJobs")
+		return
+	}
+	return mock.ListJobsFunc(ctx, listJobsOptions)
+}
+
+// CreateJob records the call and calls CreateJobFunc.
+func (mock *Mock) CreateJob(createJobOptions *codeenginev2.CreateJobOptions) (result *codeenginev2.Job, response *core.DetailedResponse, err error) {
+	return mock.CreateJobWithContext(context.Background(), createJobOptions)
+}
+
+// CreateJobWithContext records the call and calls CreateJobFunc.
+func (mock *Mock) CreateJobWithContext(ctx context.Context, createJobOptions *codeenginev2.CreateJobOptions) (result *codeenginev2.Job, response *core.DetailedResponse, err error) {
+	mock.record("CreateJob", ctx, createJobOptions)
+	if mock.CreateJobFunc == nil {
+		err = notProgrammed("CreateJob")
+		return
+	}
+	return mock.CreateJobFunc(ctx, createJobOptions)
+}
+
+// DeleteJob records the call and calls DeleteJobFunc.
+func (mock *Mock) DeleteJob(deleteJobOptions *codeenginev2.DeleteJobOptions) (response *core.DetailedResponse, err error) {
+	return mock.DeleteJobWithContext(context.Background(), deleteJobOptions)
+}
+
+// DeleteJobWithContext records the call and calls DeleteJobFunc.
+func (mock *Mock) DeleteJobWithContext(ctx context.Context, deleteJobOptions *codeenginev2.DeleteJobOptions) (response *core.DetailedResponse, err error) {
+	mock.record("DeleteJob", ctx, deleteJobOptions)
+	if mock.DeleteJobFunc == nil {
+		err = notProgrammed("DeleteJob")
+		return
+	}
+	return mock.DeleteJobFunc(ctx, deleteJobOptions)
+}
+
+// GetJob records the call and calls GetJobFunc.
+func (mock *Mock) GetJob(getJobOptions *codeenginev2.GetJobOptions) (result *codeenginev2.Job, response *core.DetailedResponse, err error) {
+	return mock.GetJobWithContext(context.Background(), getJobOptions)
+}
+
+// GetJobWithContext records the call and calls GetJobFunc.
+func (mock *Mock) GetJobWithContext(ctx context.Context, getJobOptions *codeenginev2.GetJobOptions) (result *codeenginev2.Job, response *core.DetailedResponse, err error) {
+	mock.record("GetJob", ctx, getJobOptions)
+	if mock.GetJobFunc == nil {
+		err = notProgrammed("GetJob")
+		return
+	}
+	return mock.GetJobFunc(ctx, getJobOptions)
+}
+
+// UpdateJob records the call and calls UpdateJobFunc.
+func (mock *Mock) UpdateJob(updateJobOptions *codeenginev2.UpdateJobOptions) (result *codeenginev2.Job, response *core.DetailedResponse, err error) {
+	return mock.UpdateJobWithContext(context.Background(), updateJobOptions)
+}
+
+// UpdateJobWithContext records the call and calls UpdateJobFunc.
+func (mock *Mock) UpdateJobWithContext(ctx context.Context, updateJobOptions *codeenginev2.UpdateJobOptions) (result *codeenginev2.Job, response *core.DetailedResponse, err error) {
+	mock.record("UpdateJob", ctx, updateJobOptions)
+	if mock.UpdateJobFunc == nil {
+		err = notProgrammed("UpdateJob")
+		return
+	}
+	return mock.UpdateJobFunc(ctx, updateJobOptions)
+}
+
+// ListFunctionRuntimes records the call and calls ListFunctionRuntimesFunc.
+func (mock *Mock) ListFunctionRuntimes(listFunctionRuntimesOptions *codeenginev2.ListFunctionRuntimesOptions) (result *codeenginev2.FunctionRuntimeList, response *core.DetailedResponse, err error) {
+	return mock.ListFunctionRuntimesWithContext(context.Background(), listFunctionRuntimesOptions)
+}
+
+// ListFunctionRuntimesWithContext records the call and calls ListFunctionRuntimesFunc.
+func (mock *Mock) ListFunctionRuntimesWithContext(ctx context.Context, listFunctionRuntimesOptions *codeenginev2.ListFunctionRuntimesOptions) (result *codeenginev2.FunctionRuntimeList, response *core.DetailedResponse, err error) {
+	mock.record("ListFunctionRuntimes", ctx, listFunctionRuntimesOptions)
+	if mock.ListFunctionRuntimesFunc == nil {
+		err = notProgrammed("ListFunctionRuntimes")
+		return
+	}
+	return mock.ListFunctionRuntimesFunc(ctx, listFunctionRuntimesOptions)
+}
+
+// ListFunctions records the call and calls ListFunctionsFunc.
+func (mock *Mock) ListFunctions(listFunctionsOptions *codeenginev2.ListFunctionsOptions) (result *codeenginev2.FunctionList, response *core.DetailedResponse, err error) {
+	return mock.ListFunctionsWithContext(context.Background(), listFunctionsOptions)
+}
+
+// ListFunctionsWithContext records the call and calls ListFunctionsFunc.
+func (mock *Mock) ListFunctionsWithContext(ctx context.Context, listFunctionsOptions *codeenginev2.ListFunctionsOptions) (result *codeenginev2.FunctionList, response *core.DetailedResponse, err error) {
+	mock.record("ListFunctions", ctx, listFunctionsOptions)
+	if mock.ListFunctionsFunc == nil {
+		err = notProgrammed("ListFunctions")
+		return
+	}
+	return mock.ListFunctionsFunc(ctx, listFunctionsOptions)
+}
+
+// CreateFunction records the call and calls CreateFunctionFunc.
+func (mock *Mock) CreateFunction(createFunctionOptions *codeenginev2.CreateFunctionOptions) (result *codeenginev2.Function, response *core.DetailedResponse, err error) {
+	return mock.CreateFunctionWithContext(context.Background(), createFunctionOptions)
+}
+
+// CreateFunctionWithContext records the call and calls CreateFunctionFunc.
+func (mock *Mock) CreateFunctionWithContext(ctx context.Context, createFunctionOptions *codeenginev2.CreateFunctionOptions) (result *codeenginev2.Function, response *core.DetailedResponse, err error) {
+	mock.record("CreateFunction", ctx, createFunctionOptions)
+	if mock.CreateFunctionFunc == nil {
+		err = notProgrammed("CreateFunction")
+		return
+	}
+	return mock.CreateFunctionFunc(ctx, createFunctionOptions)
+}
+
+// DeleteFunction records the call and calls DeleteFunctionFunc.
+func (mock *Mock) DeleteFunction(deleteFunctionOptions *codeenginev2.DeleteFunctionOptions) (response *core.DetailedResponse, err error) {
+	return mock.DeleteFunctionWithContext(context.Background(), deleteFunctionOptions)
+}
+
+// DeleteFunctionWithContext records the call and calls DeleteFunctionFunc.
+func (mock *Mock) DeleteFunctionWithContext(ctx context.Context, deleteFunctionOptions *codeenginev2.DeleteFunctionOptions) (response *core.DetailedResponse, err error) {
+	mock.record("DeleteFunction", ctx, deleteFunctionOptions)
+	if mock.DeleteFunctionFunc == nil {
+		err = notProgrammed("DeleteFunction")
+		return
+	}
+	return mock.DeleteFunctionFunc(ctx, deleteFunctionOptions)
+}
+
+// GetFunction records the call and calls GetFunctionFunc.
+func (mock *Mock) GetFunction(getFunctionOptions *codeenginev2.GetFunctionOptions) (result *codeenginev2.Function, response *core.DetailedResponse, err error) {
+	return mock.GetFunctionWithContext(context.Background(), getFunctionOptions)
+}
+
+// GetFunctionWithContext records the call and calls GetFunctionFunc.
+func (mock *Mock) GetFunctionWithContext(ctx context.Context, getFunctionOptions *codeenginev2.GetFunctionOptions) (result *codeenginev2.Function, response *core.DetailedResponse, err error) {
+	mock.record("GetFunction", ctx, getFunctionOptions)
+	if mock.GetFunctionFunc == nil {
+		err = notProgrammed("GetFunction")
+		return
+	}
+	return mock.GetFunctionFunc(ctx, getFunctionOptions)
+}
+
+// UpdateFunction records the call and calls UpdateFunctionFunc.
+func (mock *Mock) UpdateFunction(updateFunctionOptions *codeenginev2.UpdateFunctionOptions) (result *codeenginev2.Function, response *core.DetailedResponse, err error) {
+	return mock.UpdateFunctionWithContext(context.Background(), updateFunctionOptions)
+}
+
+// UpdateFunctionWithContext records the call and calls UpdateFunctionFunc.
+func (mock *Mock) UpdateFunctionWithContext(ctx context.Context, updateFunctionOptions *codeenginev2.UpdateFunctionOptions) (result *codeenginev2.Function, response *core.DetailedResponse, err error) {
+	mock.record("UpdateFunction", ctx, updateFunctionOptions)
+	if mock.UpdateFunctionFunc == nil {
+		err = notProgrammed("UpdateFunction")
+		return
+	}
+	return mock.UpdateFunctionFunc(ctx, updateFunctionOptions)
+}
+
+// ListBindings records the call and calls ListBindingsFunc.
+func (mock *Mock) ListBindings(listBindingsOptions *codeenginev2.ListBindingsOptions) (result *codeenginev2.BindingList, response *core.DetailedResponse, err error) {
+	return mock.ListBindingsWithContext(context.Background(), listBindingsOptions)
+}
+
+// ListBindingsWithContext records the call and calls ListBindingsFunc.
+func (mock *Mock) ListBindingsWithContext(ctx context.Context, listBindingsOptions *codeenginev2.ListBindingsOptions) (result *codeenginev2.BindingList, response *core.DetailedResponse, err error) {
+	mock.record("ListBindings", ctx, listBindingsOptions)
+	if mock.ListBindingsFunc == nil {
+		err = notProgrammed("ListBindings")
+		return
+	}
+	return mock.ListBindingsFunc(ctx, listBindingsOptions)
+}
+
+// CreateBinding records the call and calls CreateBindingFunc.
+func (mock *Mock) CreateBinding(createBindingOptions *codeenginev2.CreateBindingOptions) (result *codeenginev2.Binding, response *core.DetailedResponse, err error) {
+	return mock.CreateBindingWithContext(context.Background(), createBindingOptions)
+}
+
+// CreateBindingWithContext records the call and calls CreateBindingFunc.
+func (mock *Mock) CreateBindingWithContext(ctx context.Context, createBindingOptions *codeenginev2.CreateBindingOptions) (result *codeenginev2.Binding, response *core.DetailedResponse, err error) {
+	mock.record("CreateBinding", ctx, createBindingOptions)
+	if mock.CreateBindingFunc == nil {
+		err = notProgrammed("CreateBinding")
+		return
+	}
+	return mock.CreateBindingFunc(ctx, createBindingOptions)
+}
+
+// DeleteBinding records the call and calls DeleteBindingFunc.
+func (mock *Mock) DeleteBinding(deleteBindingOptions *codeenginev2.DeleteBindingOptions) (response *core.DetailedResponse, err error) {
+	return mock.DeleteBindingWithContext(context.Background(), deleteBindingOptions)
+}
+
+// DeleteBindingWithContext records the call and calls DeleteBindingFunc.
+func (mock *Mock) DeleteBindingWithContext(ctx context.Context, deleteBindingOptions *codeenginev2.DeleteBindingOptions) (response *core.DetailedResponse, err error) {
+	mock.record("DeleteBinding", ctx, deleteBindingOptions)
+	if mock.DeleteBindingFunc == nil {
+		err = notProgrammed("DeleteBinding")
+		return
+	}
+	return mock.DeleteBindingFunc(ctx, deleteBindingOptions)
+}
+
+// GetBinding records the call and calls GetBindingFunc.
+func (mock *Mock) GetBinding(getBindingOptions *codeenginev2.GetBindingOptions) (result *codeenginev2.Binding, response *core.DetailedResponse, err error) {
+	return mock.GetBindingWithContext(context.Background(), getBindingOptions)
+}
+
+// GetBindingWithContext records the call and calls GetBindingFunc.
+func (mock *Mock) GetBindingWithContext(ctx context.Context, getBindingOptions *codeenginev2.GetBindingOptions) (result *codeenginev2.Binding, response *core.DetailedResponse, err error) {
+	mock.record("GetBinding", ctx, getBindingOptions)
+	if mock.GetBindingFunc == nil {
+		err = notProgrammed("GetBinding")
+		return
+	}
+	return mock.GetBindingFunc(ctx, getBindingOptions)
+}
+
+// ListBuildRuns records the call and calls ListBuildRunsFunc.
+func (mock *Mock) ListBuildRuns(listBuildRunsOptions *codeenginev2.ListBuildRunsOptions) (result *codeenginev2.BuildRunList, response *core.DetailedResponse, err error) {
+	return mock.ListBuildRunsWithContext(context.Background(), listBuildRunsOptions)
+}
+
+// ListBuildRunsWithContext records the call and calls ListBuildRunsFunc.
+func (mock *Mock) ListBuildRunsWithContext(ctx context.Context, listBuildRunsOptions *codeenginev2.ListBuildRunsOptions) (result *codeenginev2.BuildRunList, response *core.DetailedResponse, err error) {
+	mock.record("ListBuildRuns", ctx, listBuildRunsOptions)
+	if mock.ListBuildRunsFunc == nil {
+		err = notProgrammed("ListBuildRuns")
+		return
+	}
+	return mock.ListBuildRunsFunc(ctx, listBuildRunsOptions)
+}
+
+// CreateBuildRun records the call and calls CreateBuildRunFunc.
+func (mock *Mock) CreateBuildRun(createBuildRunOptions *codeenginev2.CreateBuildRunOptions) (result *codeenginev2.BuildRun, response *core.DetailedResponse, err error) {
+	return mock.CreateBuildRunWithContext(context.Background(), createBuildRunOptions)
+}
+
+// CreateBuildRunWithContext records the call and calls CreateBuildRunFunc.
+func (mock *Mock) CreateBuildRunWithContext(ctx context.Context, createBuildRunOptions *codeenginev2.CreateBuildRunOptions) (result *codeenginev2.BuildRun, response *core.DetailedResponse, err error) {
+	mock.record("CreateBuildRun", ctx, createBuildRunOptions)
+	if mock.CreateBuildRunFunc == nil {
+		err = notProgrammed("CreateBuildRun")
+		return
+	}
+	return mock.CreateBuildRunFunc(ctx, createBuildRunOptions)
+}
+
+// DeleteBuildRun records the call and calls DeleteBuildRunFunc.
+func (mock *Mock) DeleteBuildRun(deleteBuildRunOptions *codeenginev2.DeleteBuildRunOptions) (response *core.DetailedResponse, err error) {
+	return mock.DeleteBuildRunWithContext(context.Background(), deleteBuildRunOptions)
+}
+
+// DeleteBuildRunWithContext records the call and calls DeleteBuildRunFunc.
+func (mock *Mock) DeleteBuildRunWithContext(ctx context.Context, deleteBuildRunOptions *codeenginev2.DeleteBuildRunOptions) (response *core.DetailedResponse, err error) {
+	mock.record("DeleteBuildRun", ctx, deleteBuildRunOptions)
+	if mock.DeleteBuildRunFunc == nil {
+		err = notProgrammed("DeleteBuildRun")
+		return
+	}
+	return mock.DeleteBuildRunFunc(ctx, deleteBuildRunOptions)
+}
+
+// GetBuildRun records the call and calls GetBuildRunFunc.
+func (mock *Mock) GetBuildRun(getBuildRunOptions *codeenginev2.GetBuildRunOptions) (result *codeenginev2.BuildRun, response *core.DetailedResponse, err error) {
+	return mock.GetBuildRunWithContext(context.Background(), getBuildRunOptions)
+}
+
+// GetBuildRunWithContext records the call and calls GetBuildRunFunc.
+func (mock *Mock) GetBuildRunWithContext(ctx context.Context, getBuildRunOptions *codeenginev2.GetBuildRunOptions) (result *codeenginev2.BuildRun, response *core.DetailedResponse, err error) {
+	mock.record("GetBuildRun", ctx, getBuildRunOptions)
+	if mock.GetBuildRunFunc == nil {
+		err = notProgrammed("GetBuildRun")
+		return
+	}
+	return mock.GetBuildRunFunc(ctx, getBuildRunOptions)
+}
+
+// ListBuilds records the call and calls ListBuildsFunc.
+func (mock *Mock) ListBuilds(listBuildsOptions *codeenginev2.ListBuildsOptions) (result *codeenginev2.BuildList, response *core.DetailedResponse, err error) {
+	return mock.ListBuildsWithContext(context.Background(), listBuildsOptions)
+}
+
+// ListBuildsWithContext records the call and calls ListBuildsFunc.
+func (mock *Mock) ListBuildsWithContext(ctx context.Context, listBuildsOptions *codeenginev2.ListBuildsOptions) (result *codeenginev2.BuildList, response *core.DetailedResponse, err error) {
+	mock.record("ListBuilds", ctx, listBuildsOptions)
+	if mock.ListBuildsFunc == nil {
+		err = notProgrammed("ListBuilds")
+		return
+	}
+	return mock.ListBuildsFunc(ctx, listBuildsOptions)
+}
+
+// CreateBuild records the call and calls CreateBuildFunc.
+func (mock *Mock) CreateBuild(createBuildOptions *codeenginev2.CreateBuildOptions) (result *codeenginev2.Build, response *core.DetailedResponse, err error) {
+	return mock.CreateBuildWithContext(context.Background(), createBuildOptions)
+}
+
+// CreateBuildWithContext records the call and calls CreateBuildFunc.
+func (mock *Mock) CreateBuildWithContext(ctx context.Context, createBuildOptions *codeenginev2.CreateBuildOptions) (result *codeenginev2.Build, response *core.DetailedResponse, err error) {
+	mock.record("CreateBuild", ctx, createBuildOptions)
+	if mock.CreateBuildFunc == nil {
+		err = notProgrammed("CreateBuild")
+		return
+	}
+	return mock.CreateBuildFunc(ctx, createBuildOptions)
+}
+
+// DeleteBuild records the call and calls DeleteBuildFunc.
+func (mock *Mock) DeleteBuild(deleteBuildOptions *codeenginev2.DeleteBuildOptions) (response *core.DetailedResponse, err error) {
+	return mock.DeleteBuildWithContext(context.Background(), deleteBuildOptions)
+}
+
+// DeleteBuildWithContext records the call and calls DeleteBuildFunc.
+func (mock *Mock) DeleteBuildWithContext(ctx context.Context, deleteBuildOptions *codeenginev2.DeleteBuildOptions) (response *core.DetailedResponse, err error) {
+	mock.record("DeleteBuild", ctx, deleteBuildOptions)
+	if mock.DeleteBuildFunc == nil {
+		err = notProgrammed("DeleteBuild")
+		return
+	}
+	return mock.DeleteBuildFunc(ctx, deleteBuildOptions)
+}
+
+// GetBuild records the call and calls GetBuildFunc.
+func (mock *Mock) GetBuild(getBuildOptions *codeenginev2.GetBuildOptions) (result *codeenginev2.Build, response *core.DetailedResponse, err error) {
+	return mock.GetBuildWithContext(context.Background(), getBuildOptions)
+}
+
+// GetBuildWithContext records the call and calls GetBuildFunc.
+func (mock *Mock) GetBuildWithContext(ctx context.Context, getBuildOptions *codeenginev2.GetBuildOptions) (result *codeenginev2.Build, response *core.DetailedResponse, err error) {
+	mock.record("GetBuild", ctx, getBuildOptions)
+	if mock.GetBuildFunc == nil {
+		err = notProgrammed("GetBuild")
+		return
+	}
+	return mock.GetBuildFunc(ctx, getBuildOptions)
+}
+
+// UpdateBuild records the call and calls UpdateBuildFunc.
+func (mock *Mock) UpdateBuild(updateBuildOptions *codeenginev2.UpdateBuildOptions) (result *codeenginev2.Build, response *core.DetailedResponse, err error) {
+	return mock.UpdateBuildWithContext(context.Background(), updateBuildOptions)
+}
+
+// UpdateBuildWithContext records the call and calls UpdateBuildFunc.
+func (mock *Mock) UpdateBuildWithContext(ctx context.Context, updateBuildOptions *codeenginev2.UpdateBuildOptions) (result *codeenginev2.Build, response *core.DetailedResponse, err error) {
+	mock.record("UpdateBuild", ctx, updateBuildOptions)
+	if mock.UpdateBuildFunc == nil {
+		err = notProgrammed("UpdateBuild")
+		return
+	}
+	return mock.UpdateBuildFunc(ctx, updateBuildOptions)
+}
+
+// ListDomainMappings records the call and calls ListDomainMappingsFunc.
+func (mock *Mock) ListDomainMappings(listDomainMappingsOptions *codeenginev2.ListDomainMappingsOptions) (result *codeenginev2.DomainMappingList, response *core.DetailedResponse, err error) {
+	return mock.ListDomainMappingsWithContext(context.Background(), listDomainMappingsOptions)
+}
+
+// ListDomainMappingsWithContext records the call and calls ListDomainMappingsFunc.
+func (mock *Mock) ListDomainMappingsWithContext(ctx context.Context, listDomainMappingsOptions *codeenginev2.ListDomainMappingsOptions) (result *codeenginev2.DomainMappingList, response *core.DetailedResponse, err error) {
+	mock.record("ListDomainMappings", ctx, listDomainMappingsOptions)
+	if mock.ListDomainMappingsFunc == nil {
+		err = notProgrammed("ListDomainMappings")
+		return
+	}
+	return mock.ListDomainMappingsFunc(ctx, listDomainMappingsOptions)
+}
+
+// CreateDomainMapping records the call and calls CreateDomainMappingFunc.
+func (mock *Mock) CreateDomainMapping(createDomainMappingOptions *codeenginev2.CreateDomainMappingOptions) (result *codeenginev2.DomainMapping, response *core.DetailedResponse, err error) {
+	return mock.CreateDomainMappingWithContext(context.Background(), createDomainMappingOptions)
+}
+
+// CreateDomainMappingWithContext records the call and calls CreateDomainMappingFunc.
+func (mock *Mock) CreateDomainMappingWithContext(ctx context.Context, createDomainMappingOptions *codeenginev2.CreateDomainMappingOptions) (result *codeenginev2.DomainMapping, response *core.DetailedResponse, err error) {
+	mock.record("CreateDomainMapping", ctx, createDomainMappingOptions)
+	if mock.CreateDomainMappingFunc == nil {
+		err = notProgrammed("CreateDomainMapping")
+		return
+	}
+	return mock.CreateDomainMappingFunc(ctx, createDomainMappingOptions)
+}
+
+// DeleteDomainMapping records the call and calls DeleteDomainMappingFunc.
+func (mock *Mock) DeleteDomainMapping(deleteDomainMappingOptions *codeenginev2.DeleteDomainMappingOptions) (response *core.DetailedResponse, err error) {
+	return mock.DeleteDomainMappingWithContext(context.Background(), deleteDomainMappingOptions)
+}
+
+// DeleteDomainMappingWithContext records the call and calls DeleteDomainMappingFunc.
+func (mock *Mock) DeleteDomainMappingWithContext(ctx context.Context, deleteDomainMappingOptions *codeenginev2.DeleteDomainMappingOptions) (response *core.DetailedResponse, err error) {
+	mock.record("DeleteDomainMapping", ctx, deleteDomainMappingOptions)
+	if mock.DeleteDomainMappingFunc == nil {
+		err = notProgrammed("DeleteDomainMapping")
+		return
+	}
+	return mock.DeleteDomainMappingFunc(ctx, deleteDomainMappingOptions)
+}
+
+// GetDomainMapping records the call and calls GetDomainMappingFunc.
+func (mock *Mock) GetDomainMapping(getDomainMappingOptions *codeenginev2.GetDomainMappingOptions) (result *codeenginev2.DomainMapping, response *core.DetailedResponse, err error) {
+	return mock.GetDomainMappingWithContext(context.Background(), getDomainMappingOptions)
+}
+
+// GetDomainMappingWithContext records the call and calls GetDomainMappingFunc.
+func (mock *Mock) GetDomainMappingWithContext(ctx context.Context, getDomainMappingOptions *codeenginev2.GetDomainMappingOptions) (result *codeenginev2.DomainMapping, response *core.DetailedResponse, err error) {
+	mock.record("GetDomainMapping", ctx, getDomainMappingOptions)
+	if mock.GetDomainMappingFunc == nil {
+		err = notProgrammed("GetDomainMapping")
+		return
+	}
+	return mock.GetDomainMappingFunc(ctx, getDomainMappingOptions)
+}
+
+// UpdateDomainMapping records the call and calls UpdateDomainMappingFunc.
+func (mock *Mock) UpdateDomainMapping(updateDomainMappingOptions *codeenginev2.UpdateDomainMappingOptions) (result *codeenginev2.DomainMapping, response *core.DetailedResponse, err error) {
+	return mock.UpdateDomainMappingWithContext(context.Background(), updateDomainMappingOptions)
+}
+
+// UpdateDomainMappingWithContext records the call and calls UpdateDomainMappingFunc.
+func (mock *Mock) UpdateDomainMappingWithContext(ctx context.Context, updateDomainMappingOptions *codeenginev2.UpdateDomainMappingOptions) (result *codeenginev2.DomainMapping, response *core.DetailedResponse, err error) {
+	mock.record("UpdateDomainMapping", ctx, updateDomainMappingOptions)
+	if mock.UpdateDomainMappingFunc == nil {
+		err = notProgrammed("UpdateDomainMapping")
+		return
+	}
+	return mock.UpdateDomainMappingFunc(ctx, updateDomainMappingOptions)
+}
+
+// ListConfigMaps records the call and calls ListConfigMapsFunc.
+func (mock *Mock) ListConfigMaps(listConfigMapsOptions *codeenginev2.ListConfigMapsOptions) (result *codeenginev2.ConfigMapList, response *core.DetailedResponse, err error) {
+	return mock.ListConfigMapsWithContext(context.Background(), listConfigMapsOptions)
+}
+
+// ListConfigMapsWithContext records the call and calls ListConfigMapsFunc.
+func (mock *Mock) ListConfigMapsWithContext(ctx context.Context, listConfigMapsOptions *codeenginev2.ListConfigMapsOptions) (result *codeenginev2.ConfigMapList, response *core.DetailedResponse, err error) {
+	mock.record("ListConfigMaps", ctx, listConfigMapsOptions)
+	if mock.ListConfigMapsFunc == nil {
+		err = notProgrammed("ListConfigMaps")
+		return
+	}
+	return mock.ListConfigMapsFunc(ctx, listConfigMapsOptions)
+}
+
+// CreateConfigMap records the call and calls CreateConfigMapFunc.
+func (mock *Mock) CreateConfigMap(createConfigMapOptions *codeenginev2.CreateConfigMapOptions) (result *codeenginev2.ConfigMap, response *core.DetailedResponse, err error) {
+	return mock.CreateConfigMapWithContext(context.Background(), createConfigMapOptions)
+}
+
+// CreateConfigMapWithContext records the call and calls CreateConfigMapFunc.
+func (mock *Mock) CreateConfigMapWithContext(ctx context.Context, createConfigMapOptions *codeenginev2.CreateConfigMapOptions) (result *codeenginev2.ConfigMap, response *core.DetailedResponse, err error) {
+	mock.record("CreateConfigMap", ctx, createConfigMapOptions)
+	if mock.CreateConfigMapFunc == nil {
+		err = notProgrammed("CreateConfigMap")
+		return
+	}
+	return mock.CreateConfigMapFunc(ctx, createConfigMapOptions)
+}
+
+// DeleteConfigMap records the call and calls DeleteConfigMapFunc.
+func (mock *Mock) DeleteConfigMap(deleteConfigMapOptions *codeenginev2.DeleteConfigMapOptions) (response *core.DetailedResponse, err error) {
+	return mock.DeleteConfigMapWithContext(context.Background(), deleteConfigMapOptions)
+}
+
+// DeleteConfigMapWithContext records the call and calls DeleteConfigMapFunc.
+func (mock *Mock) DeleteConfigMapWithContext(ctx context.Context, deleteConfigMapOptions *codeenginev2.DeleteConfigMapOptions) (response *core.DetailedResponse, err error) {
+	mock.record("DeleteConfigMap", ctx, deleteConfigMapOptions)
+	if mock.DeleteConfigMapFunc == nil {
+		err = notProgrammed("DeleteConfigMap")
+		return
+	}
+	return mock.DeleteConfigMapFunc(ctx, deleteConfigMapOptions)
+}
+
+// GetConfigMap records the call and calls GetConfigMapFunc.
+func (mock *Mock) GetConfigMap(getConfigMapOptions *codeenginev2.GetConfigMapOptions) (result *codeenginev2.ConfigMap, response *core.DetailedResponse, err error) {
+	return mock.GetConfigMapWithContext(context.Background(), getConfigMapOptions)
+}
+
+// GetConfigMapWithContext records the call and calls GetConfigMapFunc.
+func (mock *Mock) GetConfigMapWithContext(ctx context.Context, getConfigMapOptions *codeenginev2.GetConfigMapOptions) (result *codeenginev2.ConfigMap, response *core.DetailedResponse, err error) {
+	mock.record("GetConfigMap", ctx, getConfigMapOptions)
+	if mock.GetConfigMapFunc == nil {
+		err = notProgrammed("GetConfigMap")
+		return
+	}
+	return mock.GetConfigMapFunc(ctx, getConfigMapOptions)
+}
+
+// ReplaceConfigMap records the call and calls ReplaceConfigMapFunc.
+func (mock *Mock) ReplaceConfigMap(replaceConfigMapOptions *codeenginev2.ReplaceConfigMapOptions) (result *codeenginev2.ConfigMap, response *core.DetailedResponse, err error) {
+	return mock.ReplaceConfigMapWithContext(context.Background(), replaceConfigMapOptions)
+}
+
+// ReplaceConfigMapWithContext records the call and calls ReplaceConfigMapFunc.
+func (mock *Mock) ReplaceConfigMapWithContext(ctx context.Context, replaceConfigMapOptions *codeenginev2.ReplaceConfigMapOptions) (result *codeenginev2.ConfigMap, response *core.DetailedResponse, err error) {
+	mock.record("ReplaceConfigMap", ctx, replaceConfigMapOptions)
+	if mock.ReplaceConfigMapFunc == nil {
+		err = notProgrammed("ReplaceConfigMap")
+		return
+	}
+	return mock.ReplaceConfigMapFunc(ctx, replaceConfigMapOptions)
+}
+
+// ListSecrets records the call and calls ListSecretsFunc.
+func (mock *Mock) ListSecrets(listSecretsOptions *codeenginev2.ListSecretsOptions) (result *codeenginev2.SecretList, response *core.DetailedResponse, err error) {
+	return mock.ListSecretsWithContext(context.Background(), listSecretsOptions)
+}
+
+// ListSecretsWithContext records the call and calls ListSecretsFunc.
+func (mock *Mock) ListSecretsWithContext(ctx context.Context, listSecretsOptions *codeenginev2.ListSecretsOptions) (result *codeenginev2.SecretList, response *core.DetailedResponse, err error) {
+	mock.record("ListSecrets", ctx, listSecretsOptions)
+	if mock.ListSecretsFunc == nil {
+		err = notProgrammed("ListSecrets")
+		return
+	}
+	return mock.ListSecretsFunc(ctx, listSecretsOptions)
+}
+
+// CreateSecret records the call and calls CreateSecretFunc.
+func (mock *Mock) CreateSecret(createSecretOptions *codeenginev2.CreateSecretOptions) (result *codeenginev2.Secret, response *core.DetailedResponse, err error) {
+	return mock.CreateSecretWithContext(context.Background(), createSecretOptions)
+}
+
+// CreateSecretWithContext records the call and calls CreateSecretFunc.
+func (mock *Mock) CreateSecretWithContext(ctx context.Context, createSecretOptions *codeenginev2.CreateSecretOptions) (result *codeenginev2.Secret, response *core.DetailedResponse, err error) {
+	mock.record("CreateSecret", ctx, createSecretOptions)
+	if mock.CreateSecretFunc == nil {
+		err = notProgrammed("CreateSecret")
+		return
+	}
+	return mock.CreateSecretFunc(ctx, createSecretOptions)
+}
+
+// DeleteSecret records the call and calls DeleteSecretFunc.
+func (mock *Mock) DeleteSecret(deleteSecretOptions *codeenginev2.DeleteSecretOptions) (response *core.DetailedResponse, err error) {
+	return mock.DeleteSecretWithContext(context.Background(), deleteSecretOptions)
+}
+
+// DeleteSecretWithContext records the call and calls DeleteSecretFunc.
+func (mock *Mock) DeleteSecretWithContext(ctx context.Context, deleteSecretOptions *codeenginev2.DeleteSecretOptions) (response *core.DetailedResponse, err error) {
+	mock.record("DeleteSecret", ctx, deleteSecretOptions)
+	if mock.DeleteSecretFunc == nil {
+		err = notProgrammed("DeleteSecret")
+		return
+	}
+	return mock.DeleteSecretFunc(ctx, deleteSecretOptions)
+}
+
+// GetSecret records the call and calls GetSecretFunc.
+func (mock *Mock) GetSecret(getSecretOptions *codeenginev2.GetSecretOptions) (result *codeenginev2.Secret, response *core.DetailedResponse, err error) {
+	return mock.GetSecretWithContext(context.Background(), getSecretOptions)
+}
+
+// GetSecretWithContext records the call and calls GetSecretFunc.
+func (mock *Mock) GetSecretWithContext(ctx context.Context, getSecretOptions *codeenginev2.GetSecretOptions) (result *codeenginev2.Secret, response *core.DetailedResponse, err error) {
+	mock.record("GetSecret", ctx, getSecretOptions)
+	if mock.GetSecretFunc == nil {
+		err = notProgrammed("GetSecret")
+		return
+	}
+	return mock.GetSecretFunc(ctx, getSecretOptions)
+}
+
+// ReplaceSecret records the call and calls ReplaceSecretFunc.
+func (mock *Mock) ReplaceSecret(replaceSecretOptions *codeenginev2.ReplaceSecretOptions) (result *codeenginev2.Secret, response *core.DetailedResponse, err error) {
+	return mock.ReplaceSecretWithContext(context.Background(), replaceSecretOptions)
+}
+
+// ReplaceSecretWithContext records the call and calls ReplaceSecretFunc.
+func (mock *Mock) ReplaceSecretWithContext(ctx context.Context, replaceSecretOptions *codeenginev2.ReplaceSecretOptions) (result *codeenginev2.Secret, response *core.DetailedResponse, err error) {
+	mock.record("ReplaceSecret", ctx, replaceSecretOptions)
+	if mock.ReplaceSecretFunc == nil {
+		err = notProgrammed("ReplaceSecret")
+		return
+	}
+	return mock.ReplaceSecretFunc(ctx, replaceSecretOptions)
+}
+
+// ListPersistentDataStores records the call and calls ListPersistentDataStoresFunc.
+func (mock *Mock) ListPersistentDataStores(listPersistentDataStoresOptions *codeenginev2.ListPersistentDataStoresOptions) (result *codeenginev2.PersistentDataStoreList, response *core.DetailedResponse, err error) {
+	return mock.ListPersistentDataStoresWithContext(context.Background(), listPersistentDataStoresOptions)
+}
+
+// ListPersistentDataStoresWithContext records the call and calls ListPersistentDataStoresFunc.
+func (mock *Mock) ListPersistentDataStoresWithContext(ctx context.Context, listPersistentDataStoresOptions *codeenginev2.ListPersistentDataStoresOptions) (result *codeenginev2.PersistentDataStoreList, response *core.DetailedResponse, err error) {
+	mock.record("ListPersistentDataStores", ctx, listPersistentDataStoresOptions)
+	if mock.ListPersistentDataStoresFunc == nil {
+		err = notProgrammed("ListPersistentDataStores")
+		return
+	}
+	return mock.ListPersistentDataStoresFunc(ctx, listPersistentDataStoresOptions)
+}
+
+// CreatePersistentDataStore records the call and calls CreatePersistentDataStoreFunc.
+func (mock *Mock) CreatePersistentDataStore(createPersistentDataStoreOptions *codeenginev2.CreatePersistentDataStoreOptions) (result *codeenginev2.PersistentDataStore, response *core.DetailedResponse, err error) {
+	return mock.CreatePersistentDataStoreWithContext(context.Background(), createPersistentDataStoreOptions)
+}
+
+// CreatePersistentDataStoreWithContext records the call and calls CreatePersistentDataStoreFunc.
+func (mock *Mock) CreatePersistentDataStoreWithContext(ctx context.Context, createPersistentDataStoreOptions *codeenginev2.CreatePersistentDataStoreOptions) (result *codeenginev2.PersistentDataStore, response *core.DetailedResponse, err error) {
+	mock.record("CreatePersistentDataStore", ctx, createPersistentDataStoreOptions)
+	if mock.CreatePersistentDataStoreFunc == nil {
+		err = notProgrammed("CreatePersistentDataStore")
+		return
+	}
+	return mock.CreatePersistentDataStoreFunc(ctx, createPersistentDataStoreOptions)
+}
+
+// DeletePersistentDataStore records the call and calls DeletePersistentDataStoreFunc.
+func (mock *Mock) DeletePersistentDataStore(deletePersistentDataStoreOptions *codeenginev2.DeletePersistentDataStoreOptions) (response *core.DetailedResponse, err error) {
+	return mock.DeletePersistentDataStoreWithContext(context.Background(), deletePersistentDataStoreOptions)
+}
+
+// DeletePersistentDataStoreWithContext records the call and calls DeletePersistentDataStoreFunc.
+func (mock *Mock) DeletePersistentDataStoreWithContext(ctx context.Context, deletePersistentDataStoreOptions *codeenginev2.DeletePersistentDataStoreOptions) (response *core.DetailedResponse, err error) {
+	mock.record("DeletePersistentDataStore", ctx, deletePersistentDataStoreOptions)
+	if mock.DeletePersistentDataStoreFunc == nil {
+		err = notProgrammed("DeletePersistentDataStore")
+		return
+	}
+	return mock.DeletePersistentDataStoreFunc(ctx, deletePersistentDataStoreOptions)
+}
+
+// GetPersistentDataStore records the call and calls GetPersistentDataStoreFunc.
+func (mock *Mock) GetPersistentDataStore(getPersistentDataStoreOptions *codeenginev2.GetPersistentDataStoreOptions) (result *codeenginev2.PersistentDataStore, response *core.DetailedResponse, err error) {
+	return mock.GetPersistentDataStoreWithContext(context.Background(), getPersistentDataStoreOptions)
+}
+
+// GetPersistentDataStoreWithContext records the call and calls GetPersistentDataStoreFunc.
+func (mock *Mock) GetPersistentDataStoreWithContext(ctx context.Context, getPersistentDataStoreOptions *codeenginev2.GetPersistentDataStoreOptions) (result *codeenginev2.PersistentDataStore, response *core.DetailedResponse, err error) {
+	mock.record("GetPersistentDataStore", ctx, getPersistentDataStoreOptions)
+	if mock.GetPersistentDataStoreFunc == nil {
+		err = notProgrammed("GetPersistentDataStore")
+		return
+	}
+	return mock.GetPersistentDataStoreFunc(ctx, getPersistentDataStoreOptions)
+}
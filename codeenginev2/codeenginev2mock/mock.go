@@ -0,0 +1,102 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package codeenginev2mock : A recording mock of the Code Engine v2 API
+//
+// Code that depends on the codeenginev2.CodeEngineAPI interface, or one of its resource group interfaces like
+// codeenginev2.AppsAPI, can be unit-tested with a Mock instead of a server:
+//
+//	mock := codeenginev2mock.NewMock()
+//	mock.GetAppFunc = func(ctx context.Context, options *codeenginev2.GetAppOptions) (*codeenginev2.App, *core.DetailedResponse, error) {
+//		return &codeenginev2.App{Name: options.AppName, Status: core.StringPtr(codeenginev2.App_Status_Ready)}, &core.DetailedResponse{StatusCode: 200}, nil
+//	}
+//	runController(mock)
+//	Expect(mock.CallsTo("GetApp")).To(HaveLen(1))
+package codeenginev2mock
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// ErrNotProgrammed is returned by the operations of a Mock whose function is not set.
+var ErrNotProgrammed = errors.New("operation is not programmed")
+
+// Call : A recorded call of an operation of a Mock.
+type Call struct {
+	// The name of the operation, e.g. `GetApp`.
+	Operation string
+
+	// The context of the call, which is context.Background() for the variants without context.
+	Context context.Context
+
+	// The options of the call, e.g. a *codeenginev2.GetAppOptions.
+	Options interface{}
+}
+
+// NewMock returns a Mock without programmed operations.
+func NewMock() *Mock {
+	return &Mock{}
+}
+
+// recorder records the calls of a Mock.
+type recorder struct {
+	mutex sync.Mutex
+	calls []Call
+}
+
+// Calls returns the calls of all operations in the order in which they were made.
+func (recorder *recorder) Calls() []Call {
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+
+	return append([]Call(nil), recorder.calls...)
+}
+
+// CallsTo returns the calls of the operation in the order in which they were made.
+func (recorder *recorder) CallsTo(operation string) []Call {
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+
+	var result []Call
+	for _, call := range recorder.calls {
+		if call.Operation == operation {
+			result = append(result, call)
+		}
+	}
+	return result
+}
+
+// ResetCalls forgets the recorded calls. The programmed functions are kept.
+func (recorder *recorder) ResetCalls() {
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+
+	recorder.calls = nil
+}
+
+func (recorder *recorder) record(operation string, ctx context.Context, options interface{}) {
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+
+	recorder.calls = append(recorder.calls, Call{Operation: operation, Context: ctx, Options: options})
+}
+
+func notProgrammed(operation string) error {
+	return fmt.Errorf("%s: %w", operation, ErrNotProgrammed)
+}
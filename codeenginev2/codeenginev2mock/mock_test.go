@@ -0,0 +1,81 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codeenginev2mock_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/IBM/code-engine-go-sdk/codeenginev2"
+	"github.com/IBM/code-engine-go-sdk/codeenginev2/codeenginev2mock"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+type contextKey struct{}
+
+// scaleUp is an example of code that depends on a resource group interface.
+func scaleUp(ctx context.Context, apps codeenginev2.AppsAPI, projectID string, name string) error {
+	app, _, err := apps.GetAppWithContext(ctx, &codeenginev2.GetAppOptions{ProjectID: &projectID, Name: &name})
+	if err != nil {
+		return err
+	}
+	patch, err := (&codeenginev2.AppPatch{ScaleMaxInstances: core.Int64Ptr(*app.ScaleMaxInstances * 2)}).AsPatch()
+	if err != nil {
+		return err
+	}
+	_, _, err = apps.UpdateApp(&codeenginev2.UpdateAppOptions{ProjectID: &projectID, Name: &name, IfMatch: app.EntityTag, App: patch})
+	return err
+}
+
+var _ = Describe(`Mock`, func() {
+	It(`Records calls and returns programmed results`, func() {
+		mock := codeenginev2mock.NewMock()
+		mock.GetAppFunc = func(ctx context.Context, options *codeenginev2.GetAppOptions) (*codeenginev2.App, *core.DetailedResponse, error) {
+			return &codeenginev2.App{Name: options.Name, EntityTag: core.StringPtr("7"), ScaleMaxInstances: core.Int64Ptr(5)}, &core.DetailedResponse{StatusCode: 200}, nil
+		}
+		mock.UpdateAppFunc = func(ctx context.Context, options *codeenginev2.UpdateAppOptions) (*codeenginev2.App, *core.DetailedResponse, error) {
+			return &codeenginev2.App{Name: options.Name}, &core.DetailedResponse{StatusCode: 200}, nil
+		}
+
+		ctx := context.WithValue(context.Background(), contextKey{}, "value")
+		Expect(scaleUp(ctx, mock, "project", "my-app")).To(Succeed())
+
+		calls := mock.Calls()
+		Expect(calls).To(HaveLen(2))
+		Expect(calls[0].Operation).To(Equal("GetApp"))
+		Expect(calls[0].Context).To(Equal(ctx))
+		Expect(calls[1].Operation).To(Equal("UpdateApp"))
+		Expect(calls[1].Context).To(Equal(context.Background()))
+
+		updates := mock.CallsTo("UpdateApp")
+		Expect(updates).To(HaveLen(1))
+		options := updates[0].Options.(*codeenginev2.UpdateAppOptions)
+		Expect(*options.IfMatch).To(Equal("7"))
+		Expect(options.App).To(Equal(map[string]interface{}{"scale_max_instances": core.Int64Ptr(10)}))
+
+		mock.ResetCalls()
+		Expect(mock.Calls()).To(BeEmpty())
+	})
+	It(`Returns ErrNotProgrammed for operations without function`, func() {
+		var codeEngine codeenginev2.CodeEngineAPI = codeenginev2mock.NewMock()
+		_, err := codeEngine.DeleteSecret(&codeenginev2.DeleteSecretOptions{})
+		Expect(errors.Is(err, codeenginev2mock.ErrNotProgrammed)).To(BeTrue())
+		Expect(err.Error()).To(Equal("DeleteSecret: operation is not programmed"))
+	})
+})
@@ -0,0 +1,147 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package codeenginev2prometheus : A Prometheus adapter for the metrics of the Code Engine v2 client
+//
+// The Prometheus client library is only linked into programs that import the package. A Recorder is set as the metrics recorder of a service and registered as a Prometheus collector:
+//
+//	recorder := codeenginev2prometheus.NewRecorder(nil)
+//	prometheus.MustRegister(recorder)
+//	codeEngineService.SetMetricsRecorder(recorder)
+//
+// It exports the following metrics, labelled with the operation ID, e.g. `ListApps`:
+//
+//	codeengine_requests_total{operation, method, status_class}
+//	codeengine_request_duration_seconds{operation, status_class}
+//	codeengine_request_retries_total{operation}
+//	codeengine_pager_pages_total{operation}
+package codeenginev2prometheus
+
+import (
+	"github.com/IBM/code-engine-go-sdk/codeenginev2"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// DefaultNamespace is the default namespace of the metrics.
+const DefaultNamespace = "codeengine"
+
+// RecorderOptions : The options of a Recorder.
+type RecorderOptions struct {
+	// The namespace of the metrics. Defaults to DefaultNamespace.
+	Namespace string
+
+	// The buckets of the request duration histogram, in seconds. Defaults to prometheus.DefBuckets.
+	Buckets []float64
+
+	// Labels that are added to all metrics, e.g. the region of the service.
+	ConstLabels prometheus.Labels
+}
+
+// Recorder : A codeenginev2.MetricsRecorder that exports the metrics as a Prometheus collector.
+type Recorder struct {
+	requests *prometheus.CounterVec
+	duration *prometheus.HistogramVec
+	retries  *prometheus.CounterVec
+	pages    *prometheus.CounterVec
+}
+
+// Compile-time assertions that Recorder implements the interfaces.
+var (
+	_ codeenginev2.MetricsRecorder = (*Recorder)(nil)
+	_ prometheus.Collector         = (*Recorder)(nil)
+)
+
+// NewRecorder : Instantiate Recorder
+func NewRecorder(options *RecorderOptions) *Recorder {
+	if options == nil {
+		options = &RecorderOptions{}
+	}
+	namespace := options.Namespace
+	if namespace == "" {
+		namespace = DefaultNamespace
+	}
+	buckets := options.Buckets
+	if buckets == nil {
+		buckets = prometheus.DefBuckets
+	}
+
+	return &Recorder{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace:   namespace,
+			Name:        "requests_total",
+			Help:        "The number of request attempts by operation, HTTP method and status code class.",
+			ConstLabels: options.ConstLabels,
+		}, []string{"operation", "method", "status_class"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace:   namespace,
+			Name:        "request_duration_seconds",
+			Help:        "The duration of the request attempts by operation and status code class.",
+			Buckets:     buckets,
+			ConstLabels: options.ConstLabels,
+		}, []string{"operation", "status_class"}),
+		retries: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace:   namespace,
+			Name:        "request_retries_total",
+			Help:        "The number of retried request attempts by operation.",
+			ConstLabels: options.ConstLabels,
+		}, []string{"operation"}),
+		pages: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace:   namespace,
+			Name:        "pager_pages_total",
+			Help:        "The number of pages returned by the List operations.",
+			ConstLabels: options.ConstLabels,
+		}, []string{"operation"}),
+	}
+}
+
+// ObserveRequest records the attempt of a request.
+func (recorder *Recorder) ObserveRequest(observation codeenginev2.RequestObservation) {
+	operation := operationLabel(observation.Operation)
+	recorder.requests.WithLabelValues(operation, observation.Method, observation.StatusClass).Inc()
+	recorder.duration.WithLabelValues(operation, observation.StatusClass).Observe(observation.Duration.Seconds())
+	if observation.ResendCount > 0 {
+		recorder.retries.WithLabelValues(operation).Inc()
+	}
+}
+
+// ObservePage records a page of a List operation.
+func (recorder *Recorder) ObservePage(operation string) {
+	recorder.pages.WithLabelValues(operationLabel(operation)).Inc()
+}
+
+// Describe sends the descriptors of the metrics to the channel.
+func (recorder *Recorder) Describe(ch chan<- *prometheus.Desc) {
+	recorder.requests.Describe(ch)
+	recorder.duration.Describe(ch)
+	recorder.retries.Describe(ch)
+	recorder.pages.Describe(ch)
+}
+
+// Collect sends the metrics to the channel.
+func (recorder *Recorder) Collect(ch chan<- prometheus.Metric) {
+	recorder.requests.Collect(ch)
+	recorder.duration.Collect(ch)
+	recorder.retries.Collect(ch)
+	recorder.pages.Collect(ch)
+}
+
+// operationLabel returns the label value of an operation, which is `unknown` for requests without operation.
+func operationLabel(operation string) string {
+	if operation == "" {
+		return "unknown"
+	}
+	return operation
+}
@@ -0,0 +1,80 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codeenginev2prometheus_test
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/IBM/code-engine-go-sdk/codeenginev2"
+	"github.com/IBM/code-engine-go-sdk/codeenginev2/codeenginev2prometheus"
+	"github.com/IBM/code-engine-go-sdk/codeenginev2/codeenginev2test"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+var _ = Describe(`Recorder`, func() {
+	It(`Exports the observations as Prometheus metrics`, func() {
+		recorder := codeenginev2prometheus.NewRecorder(&codeenginev2prometheus.RecorderOptions{
+			ConstLabels: prometheus.Labels{"region": "eu-de"},
+		})
+		registry := prometheus.NewPedanticRegistry()
+		Expect(registry.Register(recorder)).To(Succeed())
+
+		recorder.ObserveRequest(codeenginev2.RequestObservation{Operation: "ListApps", Method: http.MethodGet, StatusCode: 200, StatusClass: codeenginev2.StatusClass2xx, Duration: 20 * time.Millisecond})
+		recorder.ObserveRequest(codeenginev2.RequestObservation{Operation: "ListApps", Method: http.MethodGet, StatusCode: 429, StatusClass: codeenginev2.StatusClass4xx, Duration: time.Millisecond})
+		recorder.ObserveRequest(codeenginev2.RequestObservation{Operation: "ListApps", Method: http.MethodGet, StatusCode: 200, StatusClass: codeenginev2.StatusClass2xx, Duration: 30 * time.Millisecond, ResendCount: 1})
+		recorder.ObservePage("ListApps")
+		recorder.ObservePage("ListApps")
+
+		expected := `
+# HELP codeengine_requests_total The number of request attempts by operation, HTTP method and status code class.
+# TYPE codeengine_requests_total counter
+codeengine_requests_total{method="GET",operation="ListApps",region="eu-de",status_class="2xx"} 2
+codeengine_requests_total{method="GET",operation="ListApps",region="eu-de",status_class="4xx"} 1
+# HELP codeengine_request_retries_total The number of retried request attempts by operation.
+# TYPE codeengine_request_retries_total counter
+codeengine_request_retries_total{operation="ListApps",region="eu-de"} 1
+# HELP codeengine_pager_pages_total The number of pages returned by the List operations.
+# TYPE codeengine_pager_pages_total counter
+codeengine_pager_pages_total{operation="ListApps",region="eu-de"} 2
+`
+		Expect(testutil.GatherAndCompare(registry, strings.NewReader(expected),
+			"codeengine_requests_total", "codeengine_request_retries_total", "codeengine_pager_pages_total")).To(Succeed())
+		Expect(testutil.CollectAndCount(recorder, "codeengine_request_duration_seconds")).To(Equal(2))
+	})
+	It(`Records the requests of a service`, func() {
+		server := codeenginev2test.NewServer()
+		defer server.Close()
+		codeEngineService, err := server.NewCodeEngineV2()
+		Expect(err).To(BeNil())
+		projectID := server.AddProject("my-project")
+
+		recorder := codeenginev2prometheus.NewRecorder(&codeenginev2prometheus.RecorderOptions{Namespace: "test"})
+		codeEngineService.SetMetricsRecorder(recorder)
+		_, _, err = codeEngineService.ListApps(codeEngineService.NewListAppsOptions(projectID))
+		Expect(err).To(BeNil())
+		_, _, err = codeEngineService.GetApp(codeEngineService.NewGetAppOptions(projectID, "missing"))
+		Expect(err).ToNot(BeNil())
+
+		Expect(testutil.CollectAndCount(recorder, "test_requests_total")).To(Equal(2))
+		Expect(testutil.CollectAndCount(recorder, "test_pager_pages_total")).To(Equal(1))
+	})
+})
@@ -0,0 +1,432 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codeenginev2test
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/IBM/code-engine-go-sdk/codeenginev2"
+)
+
+// collection describes a collection of project resources, e.g. `apps`.
+type collection struct {
+	// The name of the collection in paths and list responses.
+	name string
+
+	// The name of a resource in error messages.
+	singular string
+
+	resourceType string
+
+	// The field that identifies resources in paths: `name` or `id`.
+	key string
+
+	// The fields that must be set on create.
+	required []string
+
+	// The status of new resources, unless their status is scripted.
+	status string
+
+	// Whether resources have an entity tag, a region, and support PATCH or PUT.
+	entityTag   bool
+	region      bool
+	updatable   bool
+	replaceable bool
+
+	// Hooks that validate and complete new resources, or react on modifications.
+	prepare func(server *Server, projectID string, resource map[string]interface{}) *apiError
+	created func(server *Server, projectID string, resource map[string]interface{})
+	updated func(server *Server, projectID string, resource map[string]interface{})
+	deleted func(server *Server, projectID string, resource map[string]interface{})
+}
+
+// apiError is an error response of a hook.
+type apiError struct {
+	statusCode int
+	code       string
+	message    string
+}
+
+// secretResourceTypes maps the formats of secrets to their resource types.
+var secretResourceTypes = map[string]string{
+	codeenginev2.Secret_Format_BasicAuth:       codeenginev2.Secret_ResourceType_SecretBasicAuthV2,
+	codeenginev2.Secret_Format_Generic:         codeenginev2.Secret_ResourceType_SecretGenericV2,
+	codeenginev2.Secret_Format_HmacAuth:        codeenginev2.Secret_ResourceType_SecretHmacAuthV2,
+	codeenginev2.Secret_Format_Other:           codeenginev2.Secret_ResourceType_SecretOtherV2,
+	codeenginev2.Secret_Format_Registry:        codeenginev2.Secret_ResourceType_SecretRegistryV2,
+	codeenginev2.Secret_Format_ServiceAccess:   codeenginev2.Secret_ResourceType_SecretServiceAccessV2,
+	codeenginev2.Secret_Format_ServiceOperator: codeenginev2.Secret_ResourceType_SecretOperatorV2,
+	codeenginev2.Secret_Format_SshAuth:         codeenginev2.Secret_ResourceType_SecretAuthSshV2,
+	codeenginev2.Secret_Format_Tls:             codeenginev2.Secret_ResourceType_SecretTlsV2,
+}
+
+// revisionFields are the fields of an app that are copied into its revisions.
+var revisionFields = []string{
+	"image_port", "image_reference", "image_secret", "probe_liveness", "probe_readiness", "run_arguments", "run_as_user",
+	"run_commands", "run_compute_resource_token_enabled", "run_env_variables", "run_service_account",
+	"run_volume_mounts", "scale_concurrency", "scale_concurrency_target", "scale_cpu_limit", "scale_down_delay",
+	"scale_ephemeral_storage_limit", "scale_initial_instances", "scale_max_instances", "scale_memory_limit",
+	"scale_min_instances", "scale_request_timeout",
+}
+
+// revisions is the collection of app revisions, which is nested below the apps.
+var revisions = &collection{
+	name:         "revisions",
+	singular:     "revision",
+	resourceType: codeenginev2.AppRevision_ResourceType_AppRevisionV2,
+	key:          "name",
+	status:       codeenginev2.AppRevision_Status_Ready,
+	region:       true,
+}
+
+// collections are the collections of project resources by name.
+var collections = map[string]*collection{
+	"apps": {
+		name:         "apps",
+		singular:     "app",
+		resourceType: codeenginev2.App_ResourceType_AppV2,
+		key:          "name",
+		required:     []string{"name", "image_reference"},
+		status:       codeenginev2.App_Status_Ready,
+		entityTag:    true,
+		region:       true,
+		updatable:    true,
+		prepare: func(server *Server, projectID string, app map[string]interface{}) *apiError {
+			setDefaults(app, map[string]interface{}{
+				"image_port":                    8080,
+				"managed_domain_mappings":       codeenginev2.App_ManagedDomainMappings_LocalPublic,
+				"run_arguments":                 []interface{}{},
+				"run_commands":                  []interface{}{},
+				"run_env_variables":             []interface{}{},
+				"run_volume_mounts":             []interface{}{},
+				"scale_concurrency":             100,
+				"scale_cpu_limit":               "1",
+				"scale_down_delay":              0,
+				"scale_ephemeral_storage_limit": "400M",
+				"scale_initial_instances":       1,
+				"scale_max_instances":           10,
+				"scale_memory_limit":            "4G",
+				"scale_min_instances":           0,
+				"scale_request_timeout":         300,
+			})
+			return nil
+		},
+		created: func(server *Server, projectID string, app map[string]interface{}) {
+			app["endpoint"] = fmt.Sprintf("https://%s.%s.%s.codeengine.appdomain.cloud", app["name"], projectID[:8], DefaultRegion)
+			app["endpoint_internal"] = fmt.Sprintf("http://%s.%s.svc.cluster.local", app["name"], projectID[:8])
+			server.createRevision(projectID, app)
+		},
+		updated: func(server *Server, projectID string, app map[string]interface{}) {
+			server.createRevision(projectID, app)
+		},
+		deleted: func(server *Server, projectID string, app map[string]interface{}) {
+			appRevisions := server.store(projectID, revisions.name)
+			for key, revision := range appRevisions.items {
+				if revision.resource["app_name"] == app["name"] {
+					delete(appRevisions.items, key)
+				}
+			}
+		},
+	},
+	"jobs": {
+		name:         "jobs",
+		singular:     "job",
+		resourceType: codeenginev2.Job_ResourceType_JobV2,
+		key:          "name",
+		required:     []string{"name", "image_reference"},
+		entityTag:    true,
+		region:       true,
+		updatable:    true,
+		prepare: func(server *Server, projectID string, job map[string]interface{}) *apiError {
+			setDefaults(job, map[string]interface{}{
+				"run_arguments":                 []interface{}{},
+				"run_commands":                  []interface{}{},
+				"run_env_variables":             []interface{}{},
+				"run_mode":                      codeenginev2.Job_RunMode_Task,
+				"run_volume_mounts":             []interface{}{},
+				"scale_array_spec":              "0",
+				"scale_cpu_limit":               "1",
+				"scale_ephemeral_storage_limit": "400M",
+				"scale_max_execution_time":      7200,
+				"scale_memory_limit":            "4G",
+				"scale_retry_limit":             3,
+			})
+			return nil
+		},
+	},
+	"job_runs": {
+		name:         "job_runs",
+		singular:     "job run",
+		resourceType: codeenginev2.JobRun_ResourceType_JobRunV2,
+		key:          "name",
+		status:       codeenginev2.JobRun_Status_Completed,
+		region:       true,
+		prepare: func(server *Server, projectID string, jobRun map[string]interface{}) *apiError {
+			if jobName, ok := jobRun["job_name"].(string); ok {
+				job := server.store(projectID, "jobs").items[jobName]
+				if job == nil {
+					return &apiError{http.StatusNotFound, "job_not_found", fmt.Sprintf("job '%s' does not exist", jobName)}
+				}
+				for field, value := range job.resource {
+					if strings.HasPrefix(field, "run_") || strings.HasPrefix(field, "scale_") || strings.HasPrefix(field, "image_") {
+						if _, found := jobRun[field]; !found {
+							jobRun[field] = value
+						}
+					}
+				}
+			}
+			if _, found := jobRun["image_reference"]; !found {
+				return &apiError{http.StatusBadRequest, "missing_field", "the field 'image_reference' is required"}
+			}
+			if _, found := jobRun["name"]; !found {
+				jobRun["name"] = generateName(jobRun["job_name"], "jobrun")
+			}
+			if _, found := jobRun["scale_array_spec"]; !found {
+				jobRun["scale_array_spec"] = "0"
+			}
+			return nil
+		},
+		created: func(server *Server, projectID string, jobRun map[string]interface{}) {
+			spec, _ := jobRun["scale_array_spec"].(string)
+			requested := int64(1)
+			if indices, err := codeenginev2.ParseIndexRanges(spec); err == nil {
+				requested = indices.Len()
+			}
+			jobRun["status_details"] = map[string]interface{}{
+				"requested":         requested,
+				"succeeded":         requested,
+				"succeeded_indices": spec,
+				"failed":            0,
+				"pending":           0,
+				"running":           0,
+				"unknown":           0,
+				"start_time":        jobRun["created_at"],
+				"completion_time":   jobRun["created_at"],
+			}
+		},
+	},
+	"builds": {
+		name:         "builds",
+		singular:     "build",
+		resourceType: codeenginev2.Build_ResourceType_BuildV2,
+		key:          "name",
+		required:     []string{"name", "output_image", "output_secret", "strategy_type"},
+		status:       codeenginev2.Build_Status_Ready,
+		entityTag:    true,
+		region:       true,
+		updatable:    true,
+		prepare: func(server *Server, projectID string, build map[string]interface{}) *apiError {
+			setDefaults(build, map[string]interface{}{
+				"run_build_params": []interface{}{},
+				"source_type":      codeenginev2.Build_SourceType_Git,
+				"strategy_size":    "medium",
+				"timeout":          600,
+			})
+			return nil
+		},
+	},
+	"build_runs": {
+		name:         "build_runs",
+		singular:     "build run",
+		resourceType: codeenginev2.BuildRun_ResourceType_BuildRunV2,
+		key:          "name",
+		status:       codeenginev2.BuildRun_Status_Succeeded,
+		region:       true,
+		prepare: func(server *Server, projectID string, buildRun map[string]interface{}) *apiError {
+			if buildName, ok := buildRun["build_name"].(string); ok {
+				build := server.store(projectID, "builds").items[buildName]
+				if build == nil {
+					return &apiError{http.StatusNotFound, "build_not_found", fmt.Sprintf("build '%s' does not exist", buildName)}
+				}
+				for _, field := range []string{"output_image", "output_secret", "run_build_params", "source_context_dir", "source_revision", "source_secret", "source_type", "source_url", "strategy_size", "strategy_spec_file", "strategy_type", "timeout"} {
+					if _, found := buildRun[field]; !found && build.resource[field] != nil {
+						buildRun[field] = build.resource[field]
+					}
+				}
+			}
+			if _, found := buildRun["output_image"]; !found {
+				return &apiError{http.StatusBadRequest, "missing_field", "the field 'output_image' is required"}
+			}
+			if _, found := buildRun["name"]; !found {
+				buildRun["name"] = generateName(buildRun["build_name"], "buildrun")
+			}
+			return nil
+		},
+		created: func(server *Server, projectID string, buildRun map[string]interface{}) {
+			digest := sha256.Sum256([]byte(projectID + "/" + buildRun["name"].(string)))
+			buildRun["status_details"] = map[string]interface{}{
+				"output_digest":   fmt.Sprintf("sha256:%x", digest),
+				"start_time":      buildRun["created_at"],
+				"completion_time": buildRun["created_at"],
+			}
+		},
+	},
+	"functions": {
+		name:         "functions",
+		singular:     "function",
+		resourceType: codeenginev2.Function_ResourceType_FunctionV2,
+		key:          "name",
+		required:     []string{"name", "code_reference", "runtime"},
+		status:       codeenginev2.Function_Status_Ready,
+		entityTag:    true,
+		region:       true,
+		updatable:    true,
+		prepare: func(server *Server, projectID string, function map[string]interface{}) *apiError {
+			setDefaults(function, map[string]interface{}{
+				"code_binary":              false,
+				"managed_domain_mappings":  codeenginev2.Function_ManagedDomainMappings_LocalPublic,
+				"run_env_variables":        []interface{}{},
+				"scale_concurrency":        1,
+				"scale_cpu_limit":          "1",
+				"scale_down_delay":         1,
+				"scale_max_execution_time": 60,
+				"scale_memory_limit":       "4G",
+			})
+			return nil
+		},
+		created: func(server *Server, projectID string, function map[string]interface{}) {
+			function["endpoint"] = fmt.Sprintf("https://%s.%s.%s.codeengine.appdomain.cloud", function["name"], projectID[:8], DefaultRegion)
+			function["endpoint_internal"] = fmt.Sprintf("http://%s.%s.function.cluster.local", function["name"], projectID[:8])
+		},
+	},
+	"secrets": {
+		name:        "secrets",
+		singular:    "secret",
+		key:         "name",
+		required:    []string{"name", "format"},
+		entityTag:   true,
+		region:      true,
+		replaceable: true,
+		prepare: func(server *Server, projectID string, secret map[string]interface{}) *apiError {
+			resourceType, ok := secretResourceTypes[fmt.Sprint(secret["format"])]
+			if !ok {
+				return &apiError{http.StatusBadRequest, "invalid_format", fmt.Sprintf("the secret format '%v' is invalid", secret["format"])}
+			}
+			secret["resource_type"] = resourceType
+			secret["generated_by"] = codeenginev2.Secret_GeneratedBy_User
+			return nil
+		},
+	},
+	"config_maps": {
+		name:         "config_maps",
+		singular:     "config map",
+		resourceType: codeenginev2.ConfigMap_ResourceType_ConfigMapV2,
+		key:          "name",
+		required:     []string{"name"},
+		entityTag:    true,
+		region:       true,
+		replaceable:  true,
+	},
+	"bindings": {
+		name:         "bindings",
+		singular:     "binding",
+		resourceType: codeenginev2.Binding_ResourceType_BindingV2,
+		key:          "id",
+		required:     []string{"component", "prefix", "secret_name"},
+		status:       "active",
+	},
+	"domain_mappings": {
+		name:         "domain_mappings",
+		singular:     "domain mapping",
+		resourceType: codeenginev2.DomainMapping_ResourceType_DomainMappingV2,
+		key:          "name",
+		required:     []string{"name", "component", "tls_secret"},
+		status:       codeenginev2.DomainMapping_Status_Ready,
+		entityTag:    true,
+		region:       true,
+		updatable:    true,
+		created: func(server *Server, projectID string, domainMapping map[string]interface{}) {
+			domainMapping["cname_target"] = fmt.Sprintf("custom.%s.%s.codeengine.appdomain.cloud", projectID[:8], DefaultRegion)
+			domainMapping["user_managed"] = true
+			domainMapping["visibility"] = codeenginev2.DomainMapping_Visibility_Custom
+		},
+	},
+	"persistent_data_stores": {
+		name:         "persistent_data_stores",
+		singular:     "persistent data store",
+		resourceType: codeenginev2.PersistentDataStore_ResourceType_PersistentDataStoreV2,
+		key:          "name",
+		required:     []string{"name", "storage_type"},
+		entityTag:    true,
+		region:       true,
+	},
+	"allowed_outbound_destinations": {
+		name:      "allowed_outbound_destinations",
+		singular:  "allowed outbound destination",
+		key:       "name",
+		required:  []string{"name", "type"},
+		status:    codeenginev2.AllowedOutboundDestination_Status_Ready,
+		entityTag: true,
+		updatable: true,
+	},
+}
+
+// createRevision records the current configuration of an app as a new revision and makes it the latest revision.
+func (server *Server) createRevision(projectID string, app map[string]interface{}) {
+	appRevisions := server.store(projectID, revisions.name)
+	number := 1
+	for _, revision := range appRevisions.items {
+		if revision.resource["app_name"] == app["name"] {
+			number++
+		}
+	}
+
+	name := fmt.Sprintf("%s-%05d", app["name"], number)
+	revision := map[string]interface{}{
+		"name":          name,
+		"app_name":      app["name"],
+		"id":            newUUID(),
+		"project_id":    projectID,
+		"href":          fmt.Sprintf("%s/v2/projects/%s/apps/%s/revisions/%s", server.URL, projectID, app["name"], name),
+		"created_at":    server.now(),
+		"region":        DefaultRegion,
+		"resource_type": revisions.resourceType,
+		"status":        codeenginev2.AppRevision_Status_Ready,
+	}
+	for _, field := range revisionFields {
+		if value, found := app[field]; found {
+			revision[field] = value
+		}
+	}
+	appRevisions.items[name] = server.newItem(revisions.name, revision)
+
+	app["status_details"] = map[string]interface{}{
+		"latest_created_revision": name,
+		"latest_ready_revision":   name,
+	}
+}
+
+// setDefaults sets the fields of a resource that are not set yet.
+func setDefaults(resource map[string]interface{}, defaults map[string]interface{}) {
+	for field, value := range defaults {
+		if _, found := resource[field]; !found {
+			resource[field] = value
+		}
+	}
+}
+
+// generateName generates the name of a job run or build run from the name of its job or build.
+func generateName(parent interface{}, fallback string) string {
+	prefix, ok := parent.(string)
+	if !ok {
+		prefix = fallback
+	}
+	return prefix + "-" + newUUID()[:5]
+}
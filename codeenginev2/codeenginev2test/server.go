@@ -0,0 +1,687 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package codeenginev2test : An in-memory fake of the Code Engine v2 API for unit tests
+//
+// A Server keeps projects and their apps, app revisions, jobs, job runs, builds, build runs, functions, secrets,
+// config maps, bindings, domain mappings, persistent data stores and allowed outbound destinations in memory and
+// serves them through the REST API of Code Engine, including entity tags and `If-Match` preconditions, pagination
+// tokens and scripted status transitions:
+//
+//	server := codeenginev2test.NewServer()
+//	defer server.Close()
+//	codeEngineService, err := server.NewCodeEngineV2()
+//
+// Deleted projects are soft-deleted, i.e. they keep their name, until they are reclaimed with the ProjectReclaimer of
+// the server. Alternatively, point an existing client at the server with `codeEngineService.SetServiceURL(server.URL)`.
+package codeenginev2test
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/code-engine-go-sdk/codeenginev2"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Pagination defaults of the Code Engine API.
+const (
+	DefaultPageLimit = 50
+	MaxPageLimit     = 100
+)
+
+// DefaultRegion is the region of the projects of the server.
+const DefaultRegion = "us-south"
+
+// StatusStep : A step of a scripted status transition.
+type StatusStep struct {
+	// The status of the resource.
+	Status string
+
+	// Additional fields that are merged into the resource, e.g. `status_details`.
+	Fields map[string]interface{}
+}
+
+// Server : An in-memory fake of the Code Engine v2 API.
+type Server struct {
+	*httptest.Server
+
+	mutex     sync.Mutex
+	projects  *store
+	states    map[string]*projectState
+	scripts   map[string][]StatusStep
+	failures  []*failure
+	sequence  int64
+	traceID   int64
+	startTime time.Time
+}
+
+// projectState holds the resources of a project by collection and its status details.
+type projectState struct {
+	stores        map[string]*store
+	statusDetails map[string]interface{}
+}
+
+// store holds the resources of a collection by their key.
+type store struct {
+	items map[string]*item
+}
+
+// item is a stored resource and its pending status transitions.
+type item struct {
+	resource map[string]interface{}
+	script   []StatusStep
+	sequence int64
+}
+
+// failure is an error response that replaces the next response to matching requests.
+type failure struct {
+	method     string
+	path       string
+	statusCode int
+	header     http.Header
+}
+
+// NewServer starts a server without projects. The server must be closed when it is no longer used.
+func NewServer() *Server {
+	server := &Server{
+		projects:  newStore(),
+		states:    map[string]*projectState{},
+		scripts:   map[string][]StatusStep{},
+		startTime: time.Now().UTC(),
+	}
+	server.Server = httptest.NewServer(http.HandlerFunc(server.serveHTTP))
+	return server
+}
+
+// NewCodeEngineV2 returns a client of the server that does not authenticate.
+func (server *Server) NewCodeEngineV2() (*codeenginev2.CodeEngineV2, error) {
+	return codeenginev2.NewCodeEngineV2(&codeenginev2.CodeEngineV2Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+}
+
+// AddProject creates an active project with the specified name and returns its ID.
+func (server *Server) AddProject(name string) string {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	project := server.createProject(map[string]interface{}{"name": name})
+	return project["id"].(string)
+}
+
+// ScriptStatus scripts the status transitions of the resources that are created afterwards in the collection, e.g.
+// `apps`, `job_runs` or `projects`. A new resource starts in the first step and advances by one step each time it is
+// read, until it reaches the last step. Calling ScriptStatus without steps restores the default behavior, which is
+// that resources are created in their final status, e.g. `ready` or `completed`.
+func (server *Server) ScriptStatus(collection string, steps ...StatusStep) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	server.scripts[collection] = steps
+}
+
+// FailNext makes the server respond with the status code to the next request with the method and path, e.g.
+// `PATCH /projects/<id>/apps/my-app`. The header is added to the error response, e.g. to send `Retry-After`.
+func (server *Server) FailNext(method string, path string, statusCode int, header http.Header) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	server.failures = append(server.failures, &failure{method: method, path: path, statusCode: statusCode, header: header})
+}
+
+// Resource returns a copy of the resource with the key, usually its name, in the collection of the project. Projects
+// are read with the collection `projects` and their ID. Nil is returned if the resource does not exist.
+func (server *Server) Resource(projectID string, collection string, key string) map[string]interface{} {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	resources := server.store(projectID, collection)
+	if resources == nil || resources.items[key] == nil {
+		return nil
+	}
+	return deepCopy(resources.items[key].resource)
+}
+
+// ModifyResource merges the fields into the resource and assigns a new entity tag, as if the resource had been
+// modified by another client. It returns false if the resource does not exist.
+func (server *Server) ModifyResource(projectID string, collection string, key string, fields map[string]interface{}) bool {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	resources := server.store(projectID, collection)
+	if resources == nil || resources.items[key] == nil {
+		return false
+	}
+	resource := resources.items[key].resource
+	mergePatch(resource, deepCopy(fields))
+	if _, found := resource["entity_tag"]; found {
+		resource["entity_tag"] = server.nextEntityTag()
+	}
+	return true
+}
+
+// ModifyStatusDetails merges the fields into the status details of the project, e.g. `{"vpe_not_enabled": true}`. New
+// projects have the status details of a healthy project. It returns false if the project does not exist.
+func (server *Server) ModifyStatusDetails(projectID string, fields map[string]interface{}) bool {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	state := server.states[projectID]
+	if state == nil {
+		return false
+	}
+	mergePatch(state.statusDetails, deepCopy(fields))
+	return true
+}
+
+// ProjectReclaimer returns a stand-in for the ResourceControllerReclaimer, which permanently deletes the soft-deleted
+// projects of the server, e.g. for DeleteProjectAndWait. Projects that are not soft-deleted cannot be reclaimed.
+func (server *Server) ProjectReclaimer() codeenginev2.ProjectReclaimer {
+	return codeenginev2.ProjectReclaimerFunc(func(ctx context.Context, project *codeenginev2.Project) error {
+		server.mutex.Lock()
+		defer server.mutex.Unlock()
+
+		projectID := core.StringNilMapper(project.ID)
+		stored := server.projects.items[projectID]
+		if stored == nil || stored.resource["status"] != codeenginev2.Project_Status_SoftDeleted {
+			return fmt.Errorf("project '%s' has no scheduled reclamation: %w", projectID, codeenginev2.ErrNotFound)
+		}
+		delete(server.projects.items, projectID)
+		return nil
+	})
+}
+
+// store returns the store of the collection of the project, or nil if the project does not exist.
+func (server *Server) store(projectID string, collection string) *store {
+	if collection == "projects" {
+		return server.projects
+	}
+	state := server.states[projectID]
+	if state == nil {
+		return nil
+	}
+	if state.stores[collection] == nil {
+		state.stores[collection] = newStore()
+	}
+	return state.stores[collection]
+}
+
+func newStore() *store {
+	return &store{items: map[string]*item{}}
+}
+
+// serveHTTP routes the requests of the Code Engine API.
+func (server *Server) serveHTTP(res http.ResponseWriter, req *http.Request) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	path := strings.TrimPrefix(req.URL.Path, "/v2")
+	for i, failure := range server.failures {
+		if failure.method == req.Method && failure.path == path {
+			server.failures = append(server.failures[:i], server.failures[i+1:]...)
+			for name, values := range failure.header {
+				res.Header()[name] = values
+			}
+			server.writeError(res, failure.statusCode, "injected_failure", fmt.Sprintf("injected failure of %s %s", req.Method, path))
+			return
+		}
+	}
+
+	var body map[string]interface{}
+	if req.Body != nil {
+		data, _ := io.ReadAll(req.Body)
+		if len(data) > 0 {
+			if err := json.Unmarshal(data, &body); err != nil {
+				server.writeError(res, http.StatusBadRequest, "invalid_body", "the request body is not a JSON object")
+				return
+			}
+		}
+	}
+
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	if segments[0] != "projects" {
+		server.writeError(res, http.StatusNotFound, "not_found", fmt.Sprintf("the path '%s' does not exist", path))
+		return
+	}
+
+	switch {
+	case len(segments) == 1:
+		server.serveProjects(res, req, body)
+	case len(segments) == 2:
+		server.serveProject(res, req, segments[1])
+	case server.states[segments[1]] == nil:
+		server.writeError(res, http.StatusNotFound, "project_not_found", fmt.Sprintf("project '%s' does not exist", segments[1]))
+	case len(segments) == 3 && segments[2] == "status_details" && req.Method == http.MethodGet:
+		server.writeJSON(res, http.StatusOK, server.states[segments[1]].statusDetails)
+	case len(segments) == 3 && segments[2] == "egress_ips" && req.Method == http.MethodGet:
+		server.writeJSON(res, http.StatusOK, map[string]interface{}{
+			"private": []string{"10.0.0.1", "10.0.0.2"},
+			"public":  []string{"169.48.0.1", "169.48.0.2"},
+		})
+	case len(segments) == 5 && segments[2] == "apps" && segments[4] == "instances" && req.Method == http.MethodGet:
+		server.serveInstances(res, req, segments[1], segments[3])
+	case len(segments) == 5 && segments[2] == "apps" && segments[4] == "revisions":
+		server.serveList(res, req, segments[1], revisions, map[string]interface{}{"app_name": segments[3]})
+	case len(segments) == 6 && segments[2] == "apps" && segments[4] == "revisions":
+		server.serveItem(res, req, segments[1], revisions, segments[5], body)
+	case len(segments) == 3 && collections[segments[2]] != nil:
+		switch req.Method {
+		case http.MethodGet:
+			server.serveList(res, req, segments[1], collections[segments[2]], nil)
+		case http.MethodPost:
+			server.serveCreate(res, segments[1], collections[segments[2]], body)
+		default:
+			server.writeError(res, http.StatusMethodNotAllowed, "method_not_allowed", fmt.Sprintf("method %s is not allowed", req.Method))
+		}
+	case len(segments) == 4 && collections[segments[2]] != nil:
+		server.serveItem(res, req, segments[1], collections[segments[2]], segments[3], body)
+	default:
+		server.writeError(res, http.StatusNotFound, "not_found", fmt.Sprintf("the path '%s' does not exist", path))
+	}
+}
+
+// serveProjects lists and creates projects.
+func (server *Server) serveProjects(res http.ResponseWriter, req *http.Request, body map[string]interface{}) {
+	switch req.Method {
+	case http.MethodGet:
+		server.writeList(res, req, "projects", server.projects, nil)
+	case http.MethodPost:
+		name, _ := body["name"].(string)
+		if name == "" {
+			server.writeError(res, http.StatusBadRequest, "missing_field", "the field 'name' is required")
+			return
+		}
+		for _, existing := range server.projects.items {
+			if existing.resource["name"] == name {
+				server.writeError(res, http.StatusConflict, "project_already_exists", fmt.Sprintf("project '%s' already exists", name))
+				return
+			}
+		}
+		server.writeJSON(res, http.StatusAccepted, server.createProject(body))
+	default:
+		server.writeError(res, http.StatusMethodNotAllowed, "method_not_allowed", fmt.Sprintf("method %s is not allowed", req.Method))
+	}
+}
+
+// createProject stores a new project with the fields of the body.
+func (server *Server) createProject(body map[string]interface{}) map[string]interface{} {
+	id := newUUID()
+	project := deepCopy(body)
+	delete(project, "tags")
+	if _, found := project["resource_group_id"]; !found {
+		project["resource_group_id"] = "b91e849cedb04e7e92bd68c040c672dc"
+	}
+	project["id"] = id
+	project["account_id"] = "4329073d16d2f3663f74bfa955259139"
+	project["crn"] = fmt.Sprintf("crn:v1:bluemix:public:codeengine:%s:a/4329073d16d2f3663f74bfa955259139:%s::", DefaultRegion, id)
+	project["href"] = fmt.Sprintf("%s/v2/projects/%s", server.URL, id)
+	project["region"] = DefaultRegion
+	project["resource_type"] = codeenginev2.Project_ResourceType_ProjectV2
+	project["created_at"] = server.now()
+	project["status"] = codeenginev2.Project_Status_Active
+
+	server.projects.items[id] = server.newItem("projects", project)
+	server.states[id] = &projectState{stores: map[string]*store{}, statusDetails: healthyStatusDetails()}
+	return deepCopy(project)
+}
+
+// serveProject reads and deletes a project.
+func (server *Server) serveProject(res http.ResponseWriter, req *http.Request, projectID string) {
+	project := server.projects.items[projectID]
+	if project == nil {
+		server.writeError(res, http.StatusNotFound, "project_not_found", fmt.Sprintf("project '%s' does not exist", projectID))
+		return
+	}
+	switch req.Method {
+	case http.MethodGet:
+		server.advance(project)
+		server.writeJSON(res, http.StatusOK, project.resource)
+	case http.MethodDelete:
+		// Deleted projects lose their resources and are soft-deleted until they are reclaimed, see ProjectReclaimer.
+		delete(server.states, projectID)
+		if project.resource["status"] != codeenginev2.Project_Status_SoftDeleted {
+			project.resource["status"] = codeenginev2.Project_Status_Deleting
+			project.script = []StatusStep{{Status: codeenginev2.Project_Status_Deleting}, {Status: codeenginev2.Project_Status_SoftDeleted}}
+		}
+		res.WriteHeader(http.StatusAccepted)
+	default:
+		server.writeError(res, http.StatusMethodNotAllowed, "method_not_allowed", fmt.Sprintf("method %s is not allowed", req.Method))
+	}
+}
+
+// healthyStatusDetails returns the status details of a healthy project.
+func healthyStatusDetails() map[string]interface{} {
+	return map[string]interface{}{
+		"cbr": map[string]interface{}{
+			"data_plane": map[string]interface{}{
+				"enforcement":     "none",
+				"inbound_private": "allowed",
+				"inbound_public":  "allowed",
+			},
+		},
+		"domain":          "ready",
+		"project":         "enabled",
+		"vpe":             "ready",
+		"vpe_not_enabled": false,
+	}
+}
+
+// serveInstances lists the instances of an app. The fake runs no workloads, so the list is always empty.
+func (server *Server) serveInstances(res http.ResponseWriter, req *http.Request, projectID string, appName string) {
+	if server.store(projectID, "apps").items[appName] == nil {
+		server.writeError(res, http.StatusNotFound, "app_not_found", fmt.Sprintf("app '%s' does not exist", appName))
+		return
+	}
+	server.writeList(res, req, "instances", newStore(), nil)
+}
+
+// serveList lists the resources of a collection whose fields match the filter.
+func (server *Server) serveList(res http.ResponseWriter, req *http.Request, projectID string, collection *collection, filter map[string]interface{}) {
+	server.writeList(res, req, collection.name, server.store(projectID, collection.name), filter)
+}
+
+// serveCreate creates a resource in a collection.
+func (server *Server) serveCreate(res http.ResponseWriter, projectID string, collection *collection, body map[string]interface{}) {
+	resources := server.store(projectID, collection.name)
+	for _, field := range collection.required {
+		if value, found := body[field]; !found || value == "" {
+			server.writeError(res, http.StatusBadRequest, "missing_field", fmt.Sprintf("the field '%s' is required", field))
+			return
+		}
+	}
+
+	resource := deepCopy(body)
+	if resource == nil {
+		resource = map[string]interface{}{}
+	}
+	if collection.prepare != nil {
+		if apiErr := collection.prepare(server, projectID, resource); apiErr != nil {
+			server.writeError(res, apiErr.statusCode, apiErr.code, apiErr.message)
+			return
+		}
+	}
+
+	id := newUUID()
+	key := id
+	if collection.key == "name" {
+		key, _ = resource["name"].(string)
+		if resources.items[key] != nil {
+			server.writeError(res, http.StatusConflict, "already_exists", fmt.Sprintf("%s '%s' already exists", collection.singular, key))
+			return
+		}
+	}
+
+	resource["id"] = id
+	resource["project_id"] = projectID
+	resource["href"] = fmt.Sprintf("%s/v2/projects/%s/%s/%s", server.URL, projectID, collection.name, key)
+	resource["created_at"] = server.now()
+	if collection.region {
+		resource["region"] = DefaultRegion
+	}
+	if collection.resourceType != "" {
+		resource["resource_type"] = collection.resourceType
+	}
+	if collection.entityTag {
+		resource["entity_tag"] = server.nextEntityTag()
+	}
+	if collection.status != "" {
+		resource["status"] = collection.status
+	}
+	if collection.created != nil {
+		collection.created(server, projectID, resource)
+	}
+
+	resources.items[key] = server.newItem(collection.name, resource)
+	server.writeResource(res, http.StatusCreated, collection, resource)
+}
+
+// serveItem reads, updates, replaces and deletes a resource of a collection.
+func (server *Server) serveItem(res http.ResponseWriter, req *http.Request, projectID string, collection *collection, key string, body map[string]interface{}) {
+	resources := server.store(projectID, collection.name)
+	stored := resources.items[key]
+	if stored == nil {
+		server.writeError(res, http.StatusNotFound, collection.singular+"_not_found", fmt.Sprintf("%s '%s' does not exist", collection.singular, key))
+		return
+	}
+
+	switch {
+	case req.Method == http.MethodGet:
+		server.advance(stored)
+		server.writeResource(res, http.StatusOK, collection, stored.resource)
+	case req.Method == http.MethodDelete:
+		delete(resources.items, key)
+		if collection.deleted != nil {
+			collection.deleted(server, projectID, stored.resource)
+		}
+		res.WriteHeader(http.StatusAccepted)
+	case req.Method == http.MethodPatch && collection.updatable, req.Method == http.MethodPut && collection.replaceable:
+		if !server.checkPrecondition(res, req, stored.resource) {
+			return
+		}
+		if req.Method == http.MethodPut {
+			delete(stored.resource, "data")
+		}
+		for _, field := range []string{"name", "id", "href", "project_id", "created_at", "entity_tag", "resource_type"} {
+			delete(body, field)
+		}
+		mergePatch(stored.resource, body)
+		stored.resource["entity_tag"] = server.nextEntityTag()
+		if collection.updated != nil {
+			collection.updated(server, projectID, stored.resource)
+		}
+		server.writeResource(res, http.StatusOK, collection, stored.resource)
+	default:
+		server.writeError(res, http.StatusMethodNotAllowed, "method_not_allowed", fmt.Sprintf("method %s is not allowed", req.Method))
+	}
+}
+
+// checkPrecondition verifies that the `If-Match` header of a modifying request matches the entity tag of the resource.
+func (server *Server) checkPrecondition(res http.ResponseWriter, req *http.Request, resource map[string]interface{}) bool {
+	ifMatch := req.Header.Get("If-Match")
+	switch {
+	case ifMatch == "":
+		server.writeError(res, http.StatusPreconditionRequired, "precondition_required", "the If-Match header is required")
+		return false
+	case ifMatch != "*" && ifMatch != resource["entity_tag"]:
+		server.writeError(res, http.StatusPreconditionFailed, "precondition_failed", fmt.Sprintf("the entity tag '%s' does not match the current entity tag", ifMatch))
+		return false
+	}
+	return true
+}
+
+// newItem wraps a new resource and starts the status script of its collection.
+func (server *Server) newItem(collection string, resource map[string]interface{}) *item {
+	server.sequence++
+	stored := &item{resource: resource, sequence: server.sequence}
+	if script := server.scripts[collection]; len(script) > 0 {
+		stored.script = append([]StatusStep(nil), script...)
+		server.advance(stored)
+	}
+	return stored
+}
+
+// advance moves a resource to the next step of its status script.
+func (server *Server) advance(stored *item) {
+	if len(stored.script) == 0 {
+		return
+	}
+	step := stored.script[0]
+	stored.script = stored.script[1:]
+	mergePatch(stored.resource, deepCopy(step.Fields))
+	if step.Status != "" {
+		stored.resource["status"] = step.Status
+	}
+}
+
+// writeList writes a page of the resources of a store whose fields match the filter. Resources are listed in the
+// order of their creation, and the `start` token of the next page is the offset of its first resource.
+func (server *Server) writeList(res http.ResponseWriter, req *http.Request, name string, resources *store, filter map[string]interface{}) {
+	limit := DefaultPageLimit
+	if value := req.URL.Query().Get("limit"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 1 || parsed > MaxPageLimit {
+			server.writeError(res, http.StatusBadRequest, "invalid_limit", fmt.Sprintf("the limit must be between 1 and %d", MaxPageLimit))
+			return
+		}
+		limit = parsed
+	}
+	offset := 0
+	if start := req.URL.Query().Get("start"); start != "" {
+		decoded, err := base64.RawURLEncoding.DecodeString(start)
+		if err == nil {
+			offset, err = strconv.Atoi(string(decoded))
+		}
+		if err != nil || offset < 0 {
+			server.writeError(res, http.StatusBadRequest, "invalid_start", fmt.Sprintf("the start token '%s' is invalid", start))
+			return
+		}
+	}
+
+	var items []*item
+	for _, stored := range resources.items {
+		if matches(stored.resource, filter) {
+			items = append(items, stored)
+		}
+	}
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].sequence < items[j].sequence
+	})
+
+	page := []map[string]interface{}{}
+	for i := offset; i < len(items) && i < offset+limit; i++ {
+		page = append(page, items[i].resource)
+	}
+
+	pageURL := *req.URL
+	pageURL.Scheme, pageURL.Host = "http", req.Host
+	query := pageURL.Query()
+	query.Set("limit", strconv.Itoa(limit))
+	query.Del("start")
+	pageURL.RawQuery = query.Encode()
+	result := map[string]interface{}{
+		name:    page,
+		"limit": limit,
+		"first": map[string]interface{}{"href": pageURL.String()},
+	}
+	if offset+limit < len(items) {
+		start := base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(offset + limit)))
+		query.Set("start", start)
+		pageURL.RawQuery = query.Encode()
+		result["next"] = map[string]interface{}{"href": pageURL.String(), "start": start}
+	}
+	server.writeJSON(res, http.StatusOK, result)
+}
+
+// writeResource writes a resource with its entity tag as `ETag` header.
+func (server *Server) writeResource(res http.ResponseWriter, statusCode int, collection *collection, resource map[string]interface{}) {
+	if entityTag, ok := resource["entity_tag"].(string); ok {
+		res.Header().Set("ETag", entityTag)
+	}
+	server.writeJSON(res, statusCode, resource)
+}
+
+// writeError writes an error response in the format of the Code Engine API.
+func (server *Server) writeError(res http.ResponseWriter, statusCode int, code string, message string) {
+	server.traceID++
+	trace := fmt.Sprintf("codeenginev2test-%d", server.traceID)
+	res.Header().Set("X-Request-Id", trace)
+	server.writeJSON(res, statusCode, map[string]interface{}{
+		"errors":      []map[string]interface{}{{"code": code, "message": message}},
+		"status_code": statusCode,
+		"trace":       trace,
+	})
+}
+
+func (server *Server) writeJSON(res http.ResponseWriter, statusCode int, body interface{}) {
+	data, _ := json.Marshal(body)
+	res.Header().Set("Content-Type", "application/json")
+	res.WriteHeader(statusCode)
+	res.Write(data)
+}
+
+func (server *Server) nextEntityTag() string {
+	server.sequence++
+	return strconv.FormatInt(server.sequence, 10)
+}
+
+// now returns the current time in the format of the Code Engine API. The time advances by one second per call, so
+// that the creation times of resources are distinct and ordered.
+func (server *Server) now() string {
+	server.sequence++
+	return server.startTime.Add(time.Duration(server.sequence) * time.Second).Format(time.RFC3339)
+}
+
+// matches returns true if the resource has the values of all fields of the filter.
+func matches(resource map[string]interface{}, filter map[string]interface{}) bool {
+	for field, value := range filter {
+		if resource[field] != value {
+			return false
+		}
+	}
+	return true
+}
+
+// mergePatch applies a JSON merge patch (RFC 7396) to the target.
+func mergePatch(target map[string]interface{}, patch map[string]interface{}) {
+	for field, value := range patch {
+		switch value := value.(type) {
+		case nil:
+			delete(target, field)
+		case map[string]interface{}:
+			nested, ok := target[field].(map[string]interface{})
+			if !ok {
+				nested = map[string]interface{}{}
+				target[field] = nested
+			}
+			mergePatch(nested, value)
+		default:
+			target[field] = value
+		}
+	}
+}
+
+// deepCopy copies a JSON object.
+func deepCopy(object map[string]interface{}) map[string]interface{} {
+	if object == nil {
+		return nil
+	}
+	data, _ := json.Marshal(object)
+	var result map[string]interface{}
+	_ = json.Unmarshal(data, &result)
+	return result
+}
+
+// newUUID returns a random version 4 UUID.
+func newUUID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
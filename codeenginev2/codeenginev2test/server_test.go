@@ -0,0 +1,252 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codeenginev2test_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/IBM/code-engine-go-sdk/codeenginev2"
+	"github.com/IBM/code-engine-go-sdk/codeenginev2/codeenginev2test"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Server`, func() {
+	var server *codeenginev2test.Server
+	var codeEngineService *codeenginev2.CodeEngineV2
+	var projectID string
+
+	BeforeEach(func() {
+		server = codeenginev2test.NewServer()
+		var err error
+		codeEngineService, err = server.NewCodeEngineV2()
+		Expect(err).To(BeNil())
+		projectID = server.AddProject("my-project")
+	})
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It(`Serves projects`, func() {
+		project, response, err := codeEngineService.GetProject(codeEngineService.NewGetProjectOptions(projectID))
+		Expect(err).To(BeNil())
+		Expect(response.StatusCode).To(Equal(200))
+		Expect(*project.Name).To(Equal("my-project"))
+		Expect(*project.Status).To(Equal(codeenginev2.Project_Status_Active))
+
+		created, response, err := codeEngineService.CreateProject(codeEngineService.NewCreateProjectOptions("other-project"))
+		Expect(err).To(BeNil())
+		Expect(response.StatusCode).To(Equal(202))
+		Expect(*created.Region).To(Equal(codeenginev2test.DefaultRegion))
+
+		_, response, err = codeEngineService.CreateProject(codeEngineService.NewCreateProjectOptions("other-project"))
+		Expect(err).ToNot(BeNil())
+		Expect(response.StatusCode).To(Equal(409))
+
+		response, err = codeEngineService.DeleteProject(codeEngineService.NewDeleteProjectOptions(*created.ID))
+		Expect(err).To(BeNil())
+		Expect(response.StatusCode).To(Equal(202))
+		_, response, err = codeEngineService.GetApp(codeEngineService.NewGetAppOptions(*created.ID, "my-app"))
+		Expect(err).ToNot(BeNil())
+		Expect(response.StatusCode).To(Equal(404))
+	})
+	It(`Soft-deletes projects until they are reclaimed`, func() {
+		reclaimer := server.ProjectReclaimer()
+		project, _, err := codeEngineService.GetProject(codeEngineService.NewGetProjectOptions(projectID))
+		Expect(err).To(BeNil())
+		Expect(reclaimer.ReclaimProject(context.Background(), project)).ToNot(Succeed())
+
+		_, err = codeEngineService.DeleteProject(codeEngineService.NewDeleteProjectOptions(projectID))
+		Expect(err).To(BeNil())
+		for _, status := range []string{codeenginev2.Project_Status_Deleting, codeenginev2.Project_Status_SoftDeleted} {
+			project, _, err = codeEngineService.GetProject(codeEngineService.NewGetProjectOptions(projectID))
+			Expect(err).To(BeNil())
+			Expect(*project.Status).To(Equal(status))
+		}
+		_, response, err := codeEngineService.CreateProject(codeEngineService.NewCreateProjectOptions("my-project"))
+		Expect(err).ToNot(BeNil())
+		Expect(response.StatusCode).To(Equal(409))
+
+		Expect(reclaimer.ReclaimProject(context.Background(), project)).To(Succeed())
+		_, response, err = codeEngineService.GetProject(codeEngineService.NewGetProjectOptions(projectID))
+		Expect(err).ToNot(BeNil())
+		Expect(response.StatusCode).To(Equal(404))
+		_, _, err = codeEngineService.CreateProject(codeEngineService.NewCreateProjectOptions("my-project"))
+		Expect(err).To(BeNil())
+	})
+	It(`Creates, reads, updates and deletes apps with their revisions`, func() {
+		app, response, err := codeEngineService.CreateApp(codeEngineService.NewCreateAppOptions(projectID, "icr.io/codeengine/helloworld", "my-app"))
+		Expect(err).To(BeNil())
+		Expect(response.StatusCode).To(Equal(201))
+		Expect(*app.Status).To(Equal(codeenginev2.App_Status_Ready))
+		Expect(*app.ImagePort).To(Equal(int64(8080)))
+		Expect(*app.Endpoint).To(HavePrefix("https://my-app."))
+		Expect(*app.StatusDetails.LatestReadyRevision).To(Equal("my-app-00001"))
+		Expect(response.Headers.Get("ETag")).To(Equal(*app.EntityTag))
+
+		_, response, err = codeEngineService.CreateApp(codeEngineService.NewCreateAppOptions(projectID, "icr.io/codeengine/helloworld", "my-app"))
+		Expect(err).ToNot(BeNil())
+		Expect(response.StatusCode).To(Equal(409))
+
+		patch, err := (&codeenginev2.AppPatch{ScaleMinInstances: core.Int64Ptr(1)}).AsPatch()
+		Expect(err).To(BeNil())
+		updated, _, err := codeEngineService.UpdateApp(codeEngineService.NewUpdateAppOptions(projectID, "my-app", *app.EntityTag, patch))
+		Expect(err).To(BeNil())
+		Expect(*updated.ScaleMinInstances).To(Equal(int64(1)))
+		Expect(*updated.EntityTag).ToNot(Equal(*app.EntityTag))
+		Expect(*updated.StatusDetails.LatestCreatedRevision).To(Equal("my-app-00002"))
+
+		revisions, _, err := codeEngineService.ListAppRevisions(codeEngineService.NewListAppRevisionsOptions(projectID, "my-app"))
+		Expect(err).To(BeNil())
+		Expect(revisions.Revisions).To(HaveLen(2))
+		Expect(*revisions.Revisions[1].ScaleMinInstances).To(Equal(int64(1)))
+
+		response, err = codeEngineService.DeleteApp(codeEngineService.NewDeleteAppOptions(projectID, "my-app"))
+		Expect(err).To(BeNil())
+		Expect(response.StatusCode).To(Equal(202))
+		Expect(server.Resource(projectID, "apps", "my-app")).To(BeNil())
+		_, response, err = codeEngineService.GetAppRevision(codeEngineService.NewGetAppRevisionOptions(projectID, "my-app", "my-app-00001"))
+		Expect(err).ToNot(BeNil())
+		Expect(response.StatusCode).To(Equal(404))
+	})
+	It(`Enforces If-Match preconditions`, func() {
+		configMap, _, err := codeEngineService.CreateConfigMap(codeEngineService.NewCreateConfigMapOptions(projectID, "my-config").SetData(map[string]string{"key": "value"}))
+		Expect(err).To(BeNil())
+		Expect(server.ModifyResource(projectID, "config_maps", "my-config", map[string]interface{}{"data": map[string]interface{}{"other": "value"}})).To(BeTrue())
+
+		_, response, err := codeEngineService.ReplaceConfigMap(codeEngineService.NewReplaceConfigMapOptions(projectID, "my-config", *configMap.EntityTag).SetData(map[string]string{"key": "new"}))
+		Expect(err).ToNot(BeNil())
+		Expect(response.StatusCode).To(Equal(412))
+
+		replaced, _, err := codeEngineService.ReplaceConfigMap(codeEngineService.NewReplaceConfigMapOptions(projectID, "my-config", "*").SetData(map[string]string{"key": "new"}))
+		Expect(err).To(BeNil())
+		Expect(replaced.Data).To(Equal(map[string]string{"key": "new"}))
+
+		patch := map[string]interface{}{"image_reference": "icr.io/codeengine/other"}
+		_, _, err = codeEngineService.CreateJob(codeEngineService.NewCreateJobOptions(projectID, "icr.io/codeengine/helloworld", "my-job"))
+		Expect(err).To(BeNil())
+		_, response, err = codeEngineService.UpdateJob(codeEngineService.NewUpdateJobOptions(projectID, "my-job", "0", patch))
+		Expect(err).ToNot(BeNil())
+		Expect(response.StatusCode).To(Equal(412))
+	})
+	It(`Pages through lists with start tokens`, func() {
+		for _, name := range []string{"a", "b", "c", "d", "e"} {
+			_, _, err := codeEngineService.CreateSecret(codeEngineService.NewCreateSecretOptions(projectID, codeenginev2.Secret_Format_Generic, name))
+			Expect(err).To(BeNil())
+		}
+
+		pager, err := codeEngineService.NewSecretsPager(codeEngineService.NewListSecretsOptions(projectID).SetLimit(2))
+		Expect(err).To(BeNil())
+		var names []string
+		pages := 0
+		for pager.HasNext() {
+			page, err := pager.GetNext()
+			Expect(err).To(BeNil())
+			pages++
+			for _, secret := range page {
+				names = append(names, *secret.Name)
+				Expect(*secret.ResourceType).To(Equal(codeenginev2.Secret_ResourceType_SecretGenericV2))
+			}
+		}
+		Expect(pages).To(Equal(3))
+		Expect(names).To(Equal([]string{"a", "b", "c", "d", "e"}))
+
+		_, response, err := codeEngineService.ListSecrets(codeEngineService.NewListSecretsOptions(projectID).SetLimit(101))
+		Expect(err).ToNot(BeNil())
+		Expect(response.StatusCode).To(Equal(400))
+	})
+	It(`Scripts status transitions`, func() {
+		server.ScriptStatus("apps",
+			codeenginev2test.StatusStep{Status: codeenginev2.App_Status_Deploying},
+			codeenginev2test.StatusStep{Status: codeenginev2.App_Status_Deploying},
+			codeenginev2test.StatusStep{Status: codeenginev2.App_Status_Failed, Fields: map[string]interface{}{
+				"status_details": map[string]interface{}{"reason": "image_pull_back_off"},
+			}},
+		)
+		app, _, err := codeEngineService.CreateApp(codeEngineService.NewCreateAppOptions(projectID, "icr.io/codeengine/missing", "my-app"))
+		Expect(err).To(BeNil())
+		Expect(*app.Status).To(Equal(codeenginev2.App_Status_Deploying))
+
+		_, err = codeEngineService.WaitForAppReady(context.Background(), projectID, "my-app", codeenginev2.NewWaitOptions().SetInitialInterval(time.Millisecond))
+		Expect(err).ToNot(BeNil())
+		var failed *codeenginev2.ResourceFailedError
+		Expect(errors.As(err, &failed)).To(BeTrue())
+		Expect(failed.Reason).To(Equal("image_pull_back_off"))
+	})
+	It(`Completes job runs and build runs`, func() {
+		_, _, err := codeEngineService.CreateJob(codeEngineService.NewCreateJobOptions(projectID, "icr.io/codeengine/helloworld", "my-job").SetScaleArraySpec("0-4,7"))
+		Expect(err).To(BeNil())
+		jobRun, _, err := codeEngineService.CreateJobRun(codeEngineService.NewCreateJobRunOptions(projectID).SetJobName("my-job"))
+		Expect(err).To(BeNil())
+		Expect(*jobRun.Name).To(HavePrefix("my-job-"))
+		progress, err := codeEngineService.WaitForJobRunCompletion(context.Background(), projectID, *jobRun.Name, nil, nil)
+		Expect(err).To(BeNil())
+		Expect(progress.Status).To(Equal(codeenginev2.JobRun_Status_Completed))
+		Expect(progress.Succeeded).To(Equal(int64(6)))
+
+		_, response, err := codeEngineService.CreateJobRun(codeEngineService.NewCreateJobRunOptions(projectID).SetJobName("missing"))
+		Expect(err).ToNot(BeNil())
+		Expect(response.StatusCode).To(Equal(404))
+
+		_, _, err = codeEngineService.CreateBuild(codeEngineService.NewCreateBuildOptions(projectID, "my-build", "private.de.icr.io/ns/app", "registry", "dockerfile").SetSourceURL("https://github.com/IBM/CodeEngine"))
+		Expect(err).To(BeNil())
+		buildRun, _, err := codeEngineService.CreateBuildRun(codeEngineService.NewCreateBuildRunOptions(projectID).SetBuildName("my-build"))
+		Expect(err).To(BeNil())
+		result, err := codeEngineService.WaitForBuildRunCompletion(context.Background(), projectID, *buildRun.Name, nil)
+		Expect(err).To(BeNil())
+		Expect(result.OutputDigest).To(HavePrefix("sha256:"))
+	})
+	It(`Deletes bindings by ID`, func() {
+		component, err := codeEngineService.NewComponentRef("my-app", "app_v2")
+		Expect(err).To(BeNil())
+		binding, _, err := codeEngineService.CreateBinding(codeEngineService.NewCreateBindingOptions(projectID, component, "MY_COS", "my-secret"))
+		Expect(err).To(BeNil())
+		Expect(*binding.ID).ToNot(BeEmpty())
+
+		response, err := codeEngineService.DeleteBinding(codeEngineService.NewDeleteBindingOptions(projectID, *binding.ID))
+		Expect(err).To(BeNil())
+		Expect(response.StatusCode).To(Equal(202))
+	})
+	It(`Injects failures`, func() {
+		server.FailNext(http.MethodGet, "/projects/"+projectID, http.StatusTooManyRequests, http.Header{"Retry-After": {"1"}})
+
+		_, response, err := codeEngineService.GetProject(codeEngineService.NewGetProjectOptions(projectID))
+		Expect(err).ToNot(BeNil())
+		Expect(response.StatusCode).To(Equal(429))
+		Expect(response.Headers.Get("Retry-After")).To(Equal("1"))
+
+		_, _, err = codeEngineService.GetProject(codeEngineService.NewGetProjectOptions(projectID))
+		Expect(err).To(BeNil())
+	})
+	It(`Can be used through SetServiceURL`, func() {
+		otherService, err := codeenginev2.NewCodeEngineV2(&codeenginev2.CodeEngineV2Options{
+			URL:           "https://api.us-east.codeengine.cloud.ibm.com/v2",
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+		Expect(otherService.SetServiceURL(server.URL)).To(Succeed())
+
+		projects, _, err := otherService.ListProjects(otherService.NewListProjectsOptions())
+		Expect(err).To(BeNil())
+		Expect(projects.Projects).To(HaveLen(1))
+		Expect(*projects.Projects[0].ID).To(Equal(projectID))
+	})
+})
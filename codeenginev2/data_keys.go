@@ -0,0 +1,345 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codeenginev2
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	common "github.com/IBM/code-engine-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Bounds of the backoff between the attempts of PatchConfigMapKeys, PatchSecretKeys and the Apply* helpers after a
+// failed precondition.
+const (
+	preconditionAttempts        = 5
+	preconditionInitialInterval = 100 * time.Millisecond
+	preconditionMaxInterval     = 2 * time.Second
+)
+
+// KeyChanges : The keys of a config map or secret before and after PatchConfigMapKeys or PatchSecretKeys.
+type KeyChanges struct {
+	// The sorted keys before the patch.
+	Before []string
+
+	// The sorted keys after the patch.
+	After []string
+
+	// The sorted keys that have been added.
+	Added []string
+
+	// The sorted keys whose value has been changed.
+	Updated []string
+
+	// The sorted keys that have been deleted.
+	Deleted []string
+}
+
+// Changed returns true if a key has been added, updated or deleted.
+func (keyChanges *KeyChanges) Changed() bool {
+	return len(keyChanges.Added)+len(keyChanges.Updated)+len(keyChanges.Deleted) > 0
+}
+
+// PatchConfigMapKeysOptions : The PatchConfigMapKeys options.
+type PatchConfigMapKeysOptions struct {
+	// The ID of the project.
+	ProjectID *string `validate:"required,ne="`
+
+	// The name of your configmap.
+	Name *string `validate:"required,ne="`
+
+	// The keys to set and their values.
+	Data map[string]string
+
+	// The keys to delete. Keys that do not exist are ignored.
+	DeleteKeys []string
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewPatchConfigMapKeysOptions : Instantiate PatchConfigMapKeysOptions
+func (*CodeEngineV2) NewPatchConfigMapKeysOptions(projectID string, name string) *PatchConfigMapKeysOptions {
+	return &PatchConfigMapKeysOptions{
+		ProjectID: core.StringPtr(projectID),
+		Name:      core.StringPtr(name),
+	}
+}
+
+// SetProjectID : Allow user to set ProjectID
+func (_options *PatchConfigMapKeysOptions) SetProjectID(projectID string) *PatchConfigMapKeysOptions {
+	_options.ProjectID = core.StringPtr(projectID)
+	return _options
+}
+
+// SetName : Allow user to set Name
+func (_options *PatchConfigMapKeysOptions) SetName(name string) *PatchConfigMapKeysOptions {
+	_options.Name = core.StringPtr(name)
+	return _options
+}
+
+// SetData : Allow user to set Data
+func (_options *PatchConfigMapKeysOptions) SetData(data map[string]string) *PatchConfigMapKeysOptions {
+	_options.Data = data
+	return _options
+}
+
+// SetKey : Allow user to set the value of a single key
+func (_options *PatchConfigMapKeysOptions) SetKey(key string, value string) *PatchConfigMapKeysOptions {
+	if _options.Data == nil {
+		_options.Data = map[string]string{}
+	}
+	_options.Data[key] = value
+	return _options
+}
+
+// SetDeleteKeys : Allow user to set DeleteKeys
+func (_options *PatchConfigMapKeysOptions) SetDeleteKeys(deleteKeys []string) *PatchConfigMapKeysOptions {
+	_options.DeleteKeys = deleteKeys
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *PatchConfigMapKeysOptions) SetHeaders(param map[string]string) *PatchConfigMapKeysOptions {
+	options.Headers = param
+	return options
+}
+
+// PatchSecretKeysOptions : The PatchSecretKeys options.
+type PatchSecretKeysOptions struct {
+	// The ID of the project.
+	ProjectID *string `validate:"required,ne="`
+
+	// The name of your secret.
+	Name *string `validate:"required,ne="`
+
+	// The keys to set and their values.
+	Data map[string]string
+
+	// The keys to delete. Keys that do not exist are ignored.
+	DeleteKeys []string
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewPatchSecretKeysOptions : Instantiate PatchSecretKeysOptions
+func (*CodeEngineV2) NewPatchSecretKeysOptions(projectID string, name string) *PatchSecretKeysOptions {
+	return &PatchSecretKeysOptions{
+		ProjectID: core.StringPtr(projectID),
+		Name:      core.StringPtr(name),
+	}
+}
+
+// SetProjectID : Allow user to set ProjectID
+func (_options *PatchSecretKeysOptions) SetProjectID(projectID string) *PatchSecretKeysOptions {
+	_options.ProjectID = core.StringPtr(projectID)
+	return _options
+}
+
+// SetName : Allow user to set Name
+func (_options *PatchSecretKeysOptions) SetName(name string) *PatchSecretKeysOptions {
+	_options.Name = core.StringPtr(name)
+	return _options
+}
+
+// SetData : Allow user to set Data
+func (_options *PatchSecretKeysOptions) SetData(data map[string]string) *PatchSecretKeysOptions {
+	_options.Data = data
+	return _options
+}
+
+// SetKey : Allow user to set the value of a single key
+func (_options *PatchSecretKeysOptions) SetKey(key string, value string) *PatchSecretKeysOptions {
+	if _options.Data == nil {
+		_options.Data = map[string]string{}
+	}
+	_options.Data[key] = value
+	return _options
+}
+
+// SetDeleteKeys : Allow user to set DeleteKeys
+func (_options *PatchSecretKeysOptions) SetDeleteKeys(deleteKeys []string) *PatchSecretKeysOptions {
+	_options.DeleteKeys = deleteKeys
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *PatchSecretKeysOptions) SetHeaders(param map[string]string) *PatchSecretKeysOptions {
+	options.Headers = param
+	return options
+}
+
+// PatchConfigMapKeys : Set or delete individual keys of a config map
+// Read the config map, set and delete the keys of the options, and replace the data of the config map using its entity
+// tag. If the config map is modified concurrently, the read-modify-write is repeated with a bounded backoff. The config
+// map is not replaced if the keys already have the requested values. The returned key changes report the keys before
+// and after the patch.
+func (codeEngine *CodeEngineV2) PatchConfigMapKeys(ctx context.Context, patchConfigMapKeysOptions *PatchConfigMapKeysOptions) (result *ConfigMap, keyChanges *KeyChanges, err error) {
+	err = validateApplyOptions(patchConfigMapKeysOptions, "patchConfigMapKeysOptions")
+	if err != nil {
+		return
+	}
+	options := *patchConfigMapKeysOptions
+	options.ProjectID, err = codeEngine.resolveProjectIDPtr(ctx, options.ProjectID)
+	if err != nil {
+		return
+	}
+
+	err = retryPreconditionFailed(ctx, func(ctx context.Context) error {
+		getConfigMapOptions := codeEngine.NewGetConfigMapOptions(*options.ProjectID, *options.Name)
+		getConfigMapOptions.Headers = options.Headers
+		configMap, _, err := codeEngine.GetConfigMapWithContext(ctx, getConfigMapOptions)
+		if err != nil {
+			return err
+		}
+
+		var data map[string]string
+		data, keyChanges = patchKeys(configMap.Data, options.Data, options.DeleteKeys)
+		if !keyChanges.Changed() {
+			result = configMap
+			return nil
+		}
+
+		replaceConfigMapOptions := codeEngine.NewReplaceConfigMapOptions(*options.ProjectID, *options.Name, core.StringNilMapper(configMap.EntityTag))
+		replaceConfigMapOptions.Data = data
+		replaceConfigMapOptions.Headers = options.Headers
+		result, _, err = codeEngine.ReplaceConfigMapWithContext(ctx, replaceConfigMapOptions)
+		return err
+	})
+	if err != nil {
+		result, keyChanges = nil, nil
+		err = core.SDKErrorf(err, "", "patch-config-map-keys-error", common.GetComponentInfo())
+	}
+	return
+}
+
+// PatchSecretKeys : Set or delete individual keys of a secret
+// Read the secret, set and delete the keys of the options, and replace the data of the secret using its entity tag and
+// its format. If the secret is modified concurrently, the read-modify-write is repeated with a bounded backoff. The
+// secret is not replaced if the keys already have the requested values. The data of service access and service
+// operator secrets is managed by Code Engine and cannot be patched. The returned key changes report the keys before
+// and after the patch.
+func (codeEngine *CodeEngineV2) PatchSecretKeys(ctx context.Context, patchSecretKeysOptions *PatchSecretKeysOptions) (result *Secret, keyChanges *KeyChanges, err error) {
+	err = validateApplyOptions(patchSecretKeysOptions, "patchSecretKeysOptions")
+	if err != nil {
+		return
+	}
+	options := *patchSecretKeysOptions
+	options.ProjectID, err = codeEngine.resolveProjectIDPtr(ctx, options.ProjectID)
+	if err != nil {
+		return
+	}
+
+	err = retryPreconditionFailed(ctx, func(ctx context.Context) error {
+		getSecretOptions := codeEngine.NewGetSecretOptions(*options.ProjectID, *options.Name)
+		getSecretOptions.Headers = options.Headers
+		secret, _, err := codeEngine.GetSecretWithContext(ctx, getSecretOptions)
+		if err != nil {
+			return err
+		}
+		format := core.StringNilMapper(secret.Format)
+		switch format {
+		case Secret_Format_ServiceAccess, Secret_Format_ServiceOperator:
+			return fmt.Errorf("the data of secret '%s' with format '%s' is managed by Code Engine", *options.Name, format)
+		}
+
+		var data map[string]string
+		data, keyChanges = patchKeys(secret.Data, options.Data, options.DeleteKeys)
+		if !keyChanges.Changed() {
+			result = secret
+			return nil
+		}
+
+		secretData := &SecretDataGenericSecretData{}
+		for key, value := range data {
+			secretData.SetProperty(key, core.StringPtr(value))
+		}
+		replaceSecretOptions := codeEngine.NewReplaceSecretOptions(*options.ProjectID, *options.Name, core.StringNilMapper(secret.EntityTag), format)
+		replaceSecretOptions.Data = secretData
+		replaceSecretOptions.Headers = options.Headers
+		result, _, err = codeEngine.ReplaceSecretWithContext(ctx, replaceSecretOptions)
+		return err
+	})
+	if err != nil {
+		result, keyChanges = nil, nil
+		err = core.SDKErrorf(err, "", "patch-secret-keys-error", common.GetComponentInfo())
+	}
+	return
+}
+
+// patchKeys returns a copy of the data with the keys set and deleted, and the changes of its keys.
+func patchKeys(data map[string]string, set map[string]string, deleteKeys []string) (map[string]string, *KeyChanges) {
+	result := maps.Clone(data)
+	if result == nil {
+		result = map[string]string{}
+	}
+	keyChanges := &KeyChanges{Before: slices.Sorted(maps.Keys(result))}
+
+	for _, key := range deleteKeys {
+		if _, found := result[key]; found {
+			delete(result, key)
+			keyChanges.Deleted = append(keyChanges.Deleted, key)
+		}
+	}
+	for key, value := range set {
+		current, found := data[key]
+		switch {
+		case !found:
+			keyChanges.Added = append(keyChanges.Added, key)
+		case current != value:
+			keyChanges.Updated = append(keyChanges.Updated, key)
+		}
+		result[key] = value
+	}
+
+	// A key that is both deleted and set is updated, or kept if its value does not change.
+	keyChanges.Deleted = slices.DeleteFunc(keyChanges.Deleted, func(key string) bool {
+		_, found := set[key]
+		return found
+	})
+	slices.Sort(keyChanges.Added)
+	slices.Sort(keyChanges.Updated)
+	slices.Sort(keyChanges.Deleted)
+	keyChanges.After = slices.Sorted(maps.Keys(result))
+	return result, keyChanges
+}
+
+// retryPreconditionFailed invokes the read-modify-write until it does not fail with a failed precondition, with an
+// exponential backoff between the attempts and at most preconditionAttempts attempts.
+func retryPreconditionFailed(ctx context.Context, readModifyWrite func(context.Context) error) error {
+	interval := preconditionInitialInterval
+	for attempt := 1; ; attempt++ {
+		err := readModifyWrite(ctx)
+		if !IsPreconditionFailed(err) || attempt == preconditionAttempts {
+			return err
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+		interval = min(2*interval, preconditionMaxInterval)
+	}
+}
@@ -0,0 +1,120 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codeenginev2_test
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/IBM/code-engine-go-sdk/codeenginev2"
+	"github.com/IBM/code-engine-go-sdk/codeenginev2/codeenginev2test"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`CodeEngineV2 key patch helpers`, func() {
+	var server *codeenginev2test.Server
+	var codeEngineService *codeenginev2.CodeEngineV2
+	var projectID string
+	ctx := context.Background()
+
+	BeforeEach(func() {
+		server = codeenginev2test.NewServer()
+		var err error
+		codeEngineService, err = server.NewCodeEngineV2()
+		Expect(err).To(BeNil())
+		projectID = server.AddProject("my-project")
+	})
+	AfterEach(func() {
+		server.Close()
+	})
+
+	Describe(`PatchConfigMapKeys(ctx, patchConfigMapKeysOptions)`, func() {
+		BeforeEach(func() {
+			_, _, err := codeEngineService.CreateConfigMap(codeEngineService.NewCreateConfigMapOptions(projectID, "my-config").
+				SetData(map[string]string{"a": "1", "b": "2", "c": "3"}))
+			Expect(err).To(BeNil())
+		})
+
+		It(`Sets and deletes keys`, func() {
+			patchOptions := codeEngineService.NewPatchConfigMapKeysOptions(projectID, "my-config").
+				SetKey("b", "20").
+				SetKey("d", "4").
+				SetDeleteKeys([]string{"c", "missing"})
+			configMap, keyChanges, err := codeEngineService.PatchConfigMapKeys(ctx, patchOptions)
+			Expect(err).To(BeNil())
+			Expect(configMap.Data).To(Equal(map[string]string{"a": "1", "b": "20", "d": "4"}))
+			Expect(keyChanges.Before).To(Equal([]string{"a", "b", "c"}))
+			Expect(keyChanges.After).To(Equal([]string{"a", "b", "d"}))
+			Expect(keyChanges.Added).To(Equal([]string{"d"}))
+			Expect(keyChanges.Updated).To(Equal([]string{"b"}))
+			Expect(keyChanges.Deleted).To(Equal([]string{"c"}))
+
+			entityTag := *configMap.EntityTag
+			configMap, keyChanges, err = codeEngineService.PatchConfigMapKeys(ctx, patchOptions)
+			Expect(err).To(BeNil())
+			Expect(keyChanges.Changed()).To(BeFalse())
+			Expect(*configMap.EntityTag).To(Equal(entityTag))
+		})
+		It(`Retries on failed preconditions and keeps concurrent changes`, func() {
+			server.FailNext(http.MethodPut, "/projects/"+projectID+"/config_maps/my-config", http.StatusPreconditionFailed, nil)
+			Expect(server.ModifyResource(projectID, "config_maps", "my-config", map[string]interface{}{"data": map[string]interface{}{"e": "5"}})).To(BeTrue())
+
+			configMap, _, err := codeEngineService.PatchConfigMapKeys(ctx, codeEngineService.NewPatchConfigMapKeysOptions(projectID, "my-config").SetKey("a", "10"))
+			Expect(err).To(BeNil())
+			Expect(configMap.Data).To(Equal(map[string]string{"a": "10", "b": "2", "c": "3", "e": "5"}))
+		})
+		It(`Gives up after a bounded number of failed preconditions`, func() {
+			path := "/projects/" + projectID + "/config_maps/my-config"
+			for i := 0; i < 5; i++ {
+				server.FailNext(http.MethodPut, path, http.StatusPreconditionFailed, nil)
+			}
+
+			_, _, err := codeEngineService.PatchConfigMapKeys(ctx, codeEngineService.NewPatchConfigMapKeysOptions(projectID, "my-config").SetKey("a", "10"))
+			Expect(err).ToNot(BeNil())
+			Expect(codeenginev2.IsPreconditionFailed(err)).To(BeTrue())
+		})
+	})
+
+	Describe(`PatchSecretKeys(ctx, patchSecretKeysOptions)`, func() {
+		It(`Sets keys and keeps the format`, func() {
+			data := &codeenginev2.SecretDataGenericSecretData{}
+			data.SetProperty("username", core.StringPtr("admin"))
+			_, _, err := codeEngineService.CreateSecret(codeEngineService.NewCreateSecretOptions(projectID, codeenginev2.Secret_Format_BasicAuth, "my-secret").SetData(data))
+			Expect(err).To(BeNil())
+
+			secret, keyChanges, err := codeEngineService.PatchSecretKeys(ctx, codeEngineService.NewPatchSecretKeysOptions(projectID, "my-secret").SetKey("password", "s3cr3t"))
+			Expect(err).To(BeNil())
+			Expect(*secret.Format).To(Equal(codeenginev2.Secret_Format_BasicAuth))
+			Expect(secret.Data).To(Equal(map[string]string{"username": "admin", "password": "s3cr3t"}))
+			Expect(keyChanges.Added).To(Equal([]string{"password"}))
+		})
+		It(`Refuses to patch the data of service access secrets`, func() {
+			_, _, err := codeEngineService.CreateSecret(codeEngineService.NewCreateSecretOptions(projectID, codeenginev2.Secret_Format_ServiceAccess, "cos-access"))
+			Expect(err).To(BeNil())
+
+			_, _, err = codeEngineService.PatchSecretKeys(ctx, codeEngineService.NewPatchSecretKeysOptions(projectID, "cos-access").SetKey("apikey", "x"))
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("is managed by Code Engine"))
+		})
+		It(`Returns an error if the secret does not exist`, func() {
+			_, _, err := codeEngineService.PatchSecretKeys(ctx, codeEngineService.NewPatchSecretKeysOptions(projectID, "missing").SetKey("a", "b"))
+			Expect(codeenginev2.IsNotFound(err)).To(BeTrue())
+		})
+	})
+})
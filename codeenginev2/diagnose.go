@@ -0,0 +1,396 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codeenginev2
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	common "github.com/IBM/code-engine-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Severity : The severity of a finding of a project health report.
+type Severity string
+
+// The severities of the findings, in increasing order.
+const (
+	SeverityInfo     Severity = "info"
+	SeverityWarning  Severity = "warning"
+	SeverityCritical Severity = "critical"
+)
+
+// rank returns the order of the severity, which is 0 for unknown severities.
+func (severity Severity) rank() int {
+	return slices.Index([]Severity{SeverityInfo, SeverityWarning, SeverityCritical}, severity) + 1
+}
+
+// Components of a project that the findings of a health report refer to.
+const (
+	HealthComponentProject                    = "project"
+	HealthComponentDomain                     = "domain"
+	HealthComponentVpe                        = "vpe"
+	HealthComponentCbr                        = "cbr"
+	HealthComponentEgressIps                  = "egress_ips"
+	HealthComponentAllowedOutboundDestination = "allowed_outbound_destination"
+)
+
+// HealthFinding : A finding of a project health report.
+type HealthFinding struct {
+	// The identifier of the finding, e.g. `vpe_not_enabled`, which is stable across reports.
+	Code string `json:"code"`
+
+	// The severity of the finding.
+	Severity Severity `json:"severity"`
+
+	// The component of the project, e.g. HealthComponentVpe.
+	Component string `json:"component"`
+
+	// The name of the resource of the finding, e.g. of an allowed outbound destination.
+	Resource string `json:"resource,omitempty"`
+
+	// A human-readable description of the finding and its impact.
+	Message string `json:"message"`
+
+	// A human-readable hint how to remediate the finding.
+	Remediation string `json:"remediation,omitempty"`
+}
+
+// ProjectHealthReport : The health report of a project, as returned by DiagnoseProject
+// The report combines the status details, the egress IP addresses and the allowed outbound destinations of the
+// project. Its findings are sorted by decreasing severity, and the report renders as JSON, e.g. for monitoring.
+type ProjectHealthReport struct {
+	// The ID of the project.
+	ProjectID string `json:"project_id"`
+
+	// The time of the diagnosis.
+	DiagnosedAt time.Time `json:"diagnosed_at"`
+
+	// The highest severity of the findings, or SeverityInfo if there are none.
+	Severity Severity `json:"severity"`
+
+	// The findings of the diagnosis.
+	Findings []HealthFinding `json:"findings"`
+
+	// The status details of the project.
+	StatusDetails *ProjectStatusDetails `json:"status_details,omitempty"`
+
+	// The egress IP addresses of the project, or nil if they could not be retrieved.
+	EgressIps *ProjectEgressIPAddresses `json:"egress_ips,omitempty"`
+
+	// The allowed outbound destinations of the project, or nil if they could not be retrieved.
+	AllowedOutboundDestinations []AllowedOutboundDestinationIntf `json:"allowed_outbound_destinations,omitempty"`
+}
+
+// Healthy returns true if the report has no warning or critical findings.
+func (report *ProjectHealthReport) Healthy() bool {
+	return report.Severity.rank() < SeverityWarning.rank()
+}
+
+// FindingsOf returns the findings of the report with at least the severity.
+func (report *ProjectHealthReport) FindingsOf(severity Severity) []HealthFinding {
+	var result []HealthFinding
+	for _, finding := range report.Findings {
+		if finding.Severity.rank() >= severity.rank() {
+			result = append(result, finding)
+		}
+	}
+	return result
+}
+
+// add adds a finding to the report.
+func (report *ProjectHealthReport) add(finding HealthFinding) {
+	report.Findings = append(report.Findings, finding)
+}
+
+// DiagnoseProjectOptions : The DiagnoseProject options.
+type DiagnoseProjectOptions struct {
+	// The ID or name of the project.
+	ProjectID *string `validate:"required,ne="`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewDiagnoseProjectOptions : Instantiate DiagnoseProjectOptions
+func (*CodeEngineV2) NewDiagnoseProjectOptions(projectID string) *DiagnoseProjectOptions {
+	return &DiagnoseProjectOptions{
+		ProjectID: core.StringPtr(projectID),
+	}
+}
+
+// SetProjectID : Allow user to set ProjectID
+func (_options *DiagnoseProjectOptions) SetProjectID(projectID string) *DiagnoseProjectOptions {
+	_options.ProjectID = core.StringPtr(projectID)
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *DiagnoseProjectOptions) SetHeaders(param map[string]string) *DiagnoseProjectOptions {
+	options.Headers = param
+	return options
+}
+
+// DiagnoseProject : Diagnose the health of a project
+// Retrieve the status details, the egress IP addresses and the allowed outbound destinations of a project and
+// interpret them as a health report with findings, their severity and remediation hints, e.g. `VPE not enabled:
+// private endpoints unreachable`. The status details are required, so an error is returned if they cannot be
+// retrieved, e.g. if the project does not exist. If the egress IP addresses or the allowed outbound destinations
+// cannot be retrieved, the report has a warning finding instead.
+func (codeEngine *CodeEngineV2) DiagnoseProject(ctx context.Context, diagnoseProjectOptions *DiagnoseProjectOptions) (report *ProjectHealthReport, err error) {
+	err = core.ValidateNotNil(diagnoseProjectOptions, "diagnoseProjectOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(diagnoseProjectOptions, "diagnoseProjectOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	projectID, err := codeEngine.ResolveProjectID(ctx, *diagnoseProjectOptions.ProjectID)
+	if err != nil {
+		return
+	}
+	headers := diagnoseProjectOptions.Headers
+
+	statusDetails, _, err := codeEngine.GetProjectStatusDetailsWithContext(ctx, codeEngine.NewGetProjectStatusDetailsOptions(projectID).SetHeaders(headers))
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "diagnose-project-error")
+		return
+	}
+	report = &ProjectHealthReport{
+		ProjectID:     projectID,
+		DiagnosedAt:   time.Now().UTC(),
+		StatusDetails: statusDetails,
+	}
+	diagnoseStatusDetails(report, statusDetails)
+
+	egressIps, _, egressErr := codeEngine.GetProjectEgressIpsWithContext(ctx, codeEngine.NewGetProjectEgressIpsOptions(projectID).SetHeaders(headers))
+	if egressErr != nil {
+		report.add(HealthFinding{
+			Code:        "egress_ips_unavailable",
+			Severity:    SeverityWarning,
+			Component:   HealthComponentEgressIps,
+			Message:     fmt.Sprintf("Egress IP addresses unavailable: %s", egressErr.Error()),
+			Remediation: "Retry the diagnosis; if the error persists, check the permissions of the caller on the project.",
+		})
+	} else {
+		report.EgressIps = egressIps
+		diagnoseEgressIps(report, egressIps)
+	}
+
+	pager, err := codeEngine.NewAllowedOutboundDestinationsPager(codeEngine.NewListAllowedOutboundDestinationsOptions(projectID).SetHeaders(headers))
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "diagnose-project-error")
+		return
+	}
+	destinations, destinationsErr := pager.GetAllWithContext(ctx)
+	if destinationsErr != nil {
+		report.add(HealthFinding{
+			Code:        "allowed_outbound_destinations_unavailable",
+			Severity:    SeverityWarning,
+			Component:   HealthComponentAllowedOutboundDestination,
+			Message:     fmt.Sprintf("Allowed outbound destinations unavailable: %s", destinationsErr.Error()),
+			Remediation: "Retry the diagnosis; if the error persists, check the permissions of the caller on the project.",
+		})
+	} else {
+		report.AllowedOutboundDestinations = destinations
+		for _, destination := range destinations {
+			diagnoseAllowedOutboundDestination(report, destination)
+		}
+	}
+
+	slices.SortStableFunc(report.Findings, func(a, b HealthFinding) int {
+		return cmp.Compare(b.Severity.rank(), a.Severity.rank())
+	})
+	report.Severity = SeverityInfo
+	if len(report.Findings) > 0 {
+		report.Severity = report.Findings[0].Severity
+	}
+	if report.Findings == nil {
+		report.Findings = []HealthFinding{}
+	}
+	return
+}
+
+// diagnoseStatusDetails adds the findings of the status details of a project.
+func diagnoseStatusDetails(report *ProjectHealthReport, statusDetails *ProjectStatusDetails) {
+	if core.StringNilMapper(statusDetails.Project) == ProjectStatusDetails_Project_Disabled {
+		report.add(HealthFinding{
+			Code:        "project_disabled",
+			Severity:    SeverityCritical,
+			Component:   HealthComponentProject,
+			Message:     "Project disabled: its workloads cannot be managed or consumed",
+			Remediation: "Check that the account is active and in good standing, e.g. that its trial or billing is not suspended, and contact IBM Cloud support if the project stays disabled.",
+		})
+	}
+
+	if core.StringNilMapper(statusDetails.Domain) != ProjectStatusDetails_Domain_Ready {
+		report.add(HealthFinding{
+			Code:        "domain_not_ready",
+			Severity:    SeverityWarning,
+			Component:   HealthComponentDomain,
+			Message:     fmt.Sprintf("Project domain %s: public endpoints of apps and functions may be unreachable", statusOrUnknown(statusDetails.Domain)),
+			Remediation: "The domain of a new project is usually ready within minutes; if it is not, contact IBM Cloud support.",
+		})
+	}
+
+	switch {
+	case statusDetails.VpeNotEnabled != nil && *statusDetails.VpeNotEnabled:
+		report.add(HealthFinding{
+			Code:        "vpe_not_enabled",
+			Severity:    SeverityWarning,
+			Component:   HealthComponentVpe,
+			Message:     "VPE not enabled: private endpoints unreachable",
+			Remediation: "Workloads of the project cannot be reached through the IBM Cloud private network; create a new project, which is enabled for the virtual private endpoint, to use private endpoints.",
+		})
+	case core.StringNilMapper(statusDetails.Vpe) != ProjectStatusDetails_Vpe_Ready:
+		report.add(HealthFinding{
+			Code:        "vpe_not_ready",
+			Severity:    SeverityWarning,
+			Component:   HealthComponentVpe,
+			Message:     fmt.Sprintf("VPE %s: private endpoints may be unreachable", statusOrUnknown(statusDetails.Vpe)),
+			Remediation: "Retry later; if the virtual private endpoint does not become ready, contact IBM Cloud support.",
+		})
+	}
+
+	if statusDetails.Cbr == nil || statusDetails.Cbr.DataPlane == nil {
+		return
+	}
+	dataPlane := statusDetails.Cbr.DataPlane
+	switch enforcement := core.StringNilMapper(dataPlane.Enforcement); enforcement {
+	case EnforcementStatus_Enforcement_OutOfSync, EnforcementStatus_Enforcement_Unknown, "":
+		report.add(HealthFinding{
+			Code:        "cbr_enforcement_" + statusOrUnknown(dataPlane.Enforcement),
+			Severity:    SeverityWarning,
+			Component:   HealthComponentCbr,
+			Message:     fmt.Sprintf("Context-based restrictions %s: the inbound access of the project may not match its rules", statusOrUnknown(dataPlane.Enforcement)),
+			Remediation: "Changes of context-based restrictions take a few minutes to be enforced; if the enforcement stays out of sync, review the rules of the Code Engine service in the context-based restrictions of the account.",
+		})
+	}
+	if core.StringNilMapper(dataPlane.InboundPublic) == EnforcementStatus_InboundPublic_Blocked {
+		report.add(HealthFinding{
+			Code:        "cbr_inbound_public_blocked",
+			Severity:    SeverityInfo,
+			Component:   HealthComponentCbr,
+			Message:     "Public inbound traffic blocked by context-based restrictions: public endpoints unreachable",
+			Remediation: "If the public endpoints of apps and functions should be reachable, add the public network zones of the clients to the context-based restriction rules.",
+		})
+	}
+	switch inboundPrivate := core.StringNilMapper(dataPlane.InboundPrivate); inboundPrivate {
+	case EnforcementStatus_InboundPrivate_Blocked, EnforcementStatus_InboundPrivate_PartiallyRestricted:
+		report.add(HealthFinding{
+			Code:        "cbr_inbound_private_" + inboundPrivate,
+			Severity:    SeverityInfo,
+			Component:   HealthComponentCbr,
+			Message:     fmt.Sprintf("Private inbound traffic %s by context-based restrictions: private endpoints may be unreachable", strings.ReplaceAll(inboundPrivate, "_", " ")),
+			Remediation: "If the private endpoints of apps and functions should be reachable, add the private network zones of the clients to the context-based restriction rules.",
+		})
+	}
+}
+
+// diagnoseEgressIps adds the findings of the egress IP addresses of a project.
+func diagnoseEgressIps(report *ProjectHealthReport, egressIps *ProjectEgressIPAddresses) {
+	if len(egressIps.Public) == 0 && len(egressIps.Private) == 0 {
+		report.add(HealthFinding{
+			Code:        "egress_ips_empty",
+			Severity:    SeverityInfo,
+			Component:   HealthComponentEgressIps,
+			Message:     "No egress IP addresses: outbound traffic cannot be allowed by IP address at its destinations",
+			Remediation: "The egress IP addresses are assigned once the project is ready; retry the diagnosis later.",
+		})
+	}
+}
+
+// allowedOutboundDestinationRemediations are the remediation hints of the reasons of failed or deploying allowed
+// outbound destinations.
+var allowedOutboundDestinationRemediations = map[string]string{
+	AllowedOutboundStatusDetails_Reason_PrivatePathConnectionAlreadyExists:   "The project is already connected to the Private Path service; delete the duplicate allowed outbound destination.",
+	AllowedOutboundStatusDetails_Reason_PrivatePathConnectionApprovalDenied:  "The owner of the Private Path service denied the connection; ask them to approve the account of the project and recreate the destination.",
+	AllowedOutboundStatusDetails_Reason_PrivatePathConnectionApprovalPending: "Ask the owner of the Private Path service to approve the connection request of the project.",
+	AllowedOutboundStatusDetails_Reason_PrivatePathCrnInvalid:                "Correct the CRN of the Private Path service gateway of the destination.",
+	AllowedOutboundStatusDetails_Reason_PrivatePathNotFound:                  "Check that the Private Path service gateway of the destination exists and that its CRN is correct.",
+	AllowedOutboundStatusDetails_Reason_PrivatePathNotInSameAccountFamily:    "Use a Private Path service of the account or enterprise account family of the project.",
+	AllowedOutboundStatusDetails_Reason_PrivatePathNotInSameRegion:           "Use a Private Path service in the region of the project.",
+	AllowedOutboundStatusDetails_Reason_PrivatePathNotPublished:              "Ask the owner of the Private Path service to publish it.",
+}
+
+// diagnoseAllowedOutboundDestination adds the findings of an allowed outbound destination of a project.
+func diagnoseAllowedOutboundDestination(report *ProjectHealthReport, destination AllowedOutboundDestinationIntf) {
+	var name, status string
+	var statusDetails AllowedOutboundStatusDetailsIntf
+	switch destination := destination.(type) {
+	case *AllowedOutboundDestination:
+		name, status, statusDetails = core.StringNilMapper(destination.Name), core.StringNilMapper(destination.Status), destination.StatusDetails
+	case *AllowedOutboundDestinationCidrBlockData:
+		name, status, statusDetails = core.StringNilMapper(destination.Name), core.StringNilMapper(destination.Status), destination.StatusDetails
+	case *AllowedOutboundDestinationPrivatePathServiceGatewayData:
+		name, status, statusDetails = core.StringNilMapper(destination.Name), core.StringNilMapper(destination.Status), destination.StatusDetails
+	}
+	var reason string
+	switch statusDetails := statusDetails.(type) {
+	case *AllowedOutboundStatusDetails:
+		reason = core.StringNilMapper(statusDetails.Reason)
+	case *AllowedOutboundStatusDetailsPrivatePathServiceGatewayStatusDetails:
+		reason = core.StringNilMapper(statusDetails.Reason)
+	}
+
+	finding := HealthFinding{
+		Component:   HealthComponentAllowedOutboundDestination,
+		Resource:    name,
+		Remediation: allowedOutboundDestinationRemediations[reason],
+	}
+	switch {
+	case status == AllowedOutboundDestination_Status_Failed:
+		finding.Code = "allowed_outbound_destination_failed"
+		finding.Severity = SeverityCritical
+		finding.Message = fmt.Sprintf("Allowed outbound destination '%s' failed: outbound traffic to it is blocked", name)
+		if finding.Remediation == "" {
+			finding.Remediation = "Check the configuration of the destination and recreate it."
+		}
+	case reason == AllowedOutboundStatusDetails_Reason_PrivatePathConnectionApprovalPending:
+		finding.Code = "allowed_outbound_destination_approval_pending"
+		finding.Severity = SeverityWarning
+		finding.Message = fmt.Sprintf("Allowed outbound destination '%s' awaits approval: outbound traffic to it is blocked", name)
+	case status == AllowedOutboundDestination_Status_Deploying:
+		finding.Code = "allowed_outbound_destination_deploying"
+		finding.Severity = SeverityInfo
+		finding.Message = fmt.Sprintf("Allowed outbound destination '%s' is deploying: outbound traffic to it is not allowed yet", name)
+		if finding.Remediation == "" {
+			finding.Remediation = "Wait until the destination is ready."
+		}
+	default:
+		return
+	}
+	if reason != "" && reason != status {
+		finding.Message += fmt.Sprintf(" (reason: %s)", reason)
+	}
+	report.add(finding)
+}
+
+// statusOrUnknown returns the status, or `unknown` if it is not set.
+func statusOrUnknown(status *string) string {
+	if status == nil || *status == "" {
+		return "unknown"
+	}
+	return *status
+}
@@ -0,0 +1,140 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codeenginev2_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/IBM/code-engine-go-sdk/codeenginev2"
+	"github.com/IBM/code-engine-go-sdk/codeenginev2/codeenginev2test"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`CodeEngineV2 project diagnosis`, func() {
+	var server *codeenginev2test.Server
+	var codeEngineService *codeenginev2.CodeEngineV2
+	var projectID string
+	ctx := context.Background()
+
+	// codes returns the codes of the findings.
+	codes := func(findings []codeenginev2.HealthFinding) []string {
+		var result []string
+		for _, finding := range findings {
+			result = append(result, finding.Code)
+		}
+		return result
+	}
+
+	createDestination := func(name string) {
+		_, _, err := codeEngineService.CreateAllowedOutboundDestination(codeEngineService.NewCreateAllowedOutboundDestinationOptions(projectID,
+			&codeenginev2.AllowedOutboundDestinationPrototypeCidrBlockDataPrototype{
+				Type:      core.StringPtr(codeenginev2.AllowedOutboundDestinationPrototype_Type_CidrBlock),
+				CidrBlock: core.StringPtr("192.168.0.0/24"),
+				Name:      core.StringPtr(name),
+			}))
+		Expect(err).To(BeNil())
+	}
+
+	BeforeEach(func() {
+		server = codeenginev2test.NewServer()
+		var err error
+		codeEngineService, err = server.NewCodeEngineV2()
+		Expect(err).To(BeNil())
+		projectID = server.AddProject("my-project")
+	})
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It(`Reports a healthy project`, func() {
+		createDestination("datacenter")
+		report, err := codeEngineService.DiagnoseProject(ctx, codeEngineService.NewDiagnoseProjectOptions("my-project"))
+		Expect(err).To(BeNil())
+		Expect(report.ProjectID).To(Equal(projectID))
+		Expect(report.Healthy()).To(BeTrue())
+		Expect(report.Severity).To(Equal(codeenginev2.SeverityInfo))
+		Expect(report.Findings).To(BeEmpty())
+		Expect(report.EgressIps.Public).ToNot(BeEmpty())
+		Expect(report.AllowedOutboundDestinations).To(HaveLen(1))
+	})
+	It(`Interprets the status details, egress IPs and allowed outbound destinations`, func() {
+		Expect(server.ModifyStatusDetails(projectID, map[string]interface{}{
+			"vpe_not_enabled": true,
+			"cbr": map[string]interface{}{
+				"data_plane": map[string]interface{}{"enforcement": "out_of_sync", "inbound_public": "blocked"},
+			},
+		})).To(BeTrue())
+		createDestination("datacenter")
+		createDestination("partner")
+		Expect(server.ModifyResource(projectID, "allowed_outbound_destinations", "partner", map[string]interface{}{
+			"status":         codeenginev2.AllowedOutboundDestination_Status_Failed,
+			"status_details": map[string]interface{}{"reason": codeenginev2.AllowedOutboundStatusDetails_Reason_PrivatePathNotFound},
+		})).To(BeTrue())
+
+		report, err := codeEngineService.DiagnoseProject(ctx, codeEngineService.NewDiagnoseProjectOptions(projectID))
+		Expect(err).To(BeNil())
+		Expect(report.Healthy()).To(BeFalse())
+		Expect(report.Severity).To(Equal(codeenginev2.SeverityCritical))
+		Expect(codes(report.Findings)).To(Equal([]string{
+			"allowed_outbound_destination_failed",
+			"vpe_not_enabled",
+			"cbr_enforcement_out_of_sync",
+			"cbr_inbound_public_blocked",
+		}))
+		Expect(report.Findings[0].Resource).To(Equal("partner"))
+		Expect(report.Findings[0].Message).To(ContainSubstring("reason: private_path_not_found"))
+		Expect(report.Findings[0].Remediation).ToNot(BeEmpty())
+		Expect(report.Findings[1].Message).To(Equal("VPE not enabled: private endpoints unreachable"))
+		Expect(codes(report.FindingsOf(codeenginev2.SeverityWarning))).To(HaveLen(3))
+	})
+	It(`Renders the report as JSON`, func() {
+		Expect(server.ModifyStatusDetails(projectID, map[string]interface{}{"project": "disabled"})).To(BeTrue())
+		report, err := codeEngineService.DiagnoseProject(ctx, codeEngineService.NewDiagnoseProjectOptions(projectID))
+		Expect(err).To(BeNil())
+
+		data, err := json.Marshal(report)
+		Expect(err).To(BeNil())
+		var rendered map[string]interface{}
+		Expect(json.Unmarshal(data, &rendered)).To(Succeed())
+		Expect(rendered).To(HaveKeyWithValue("project_id", projectID))
+		Expect(rendered).To(HaveKeyWithValue("severity", "critical"))
+		Expect(rendered).To(HaveKey("diagnosed_at"))
+		Expect(rendered["findings"]).To(ConsistOf(HaveKeyWithValue("code", "project_disabled")))
+		Expect(rendered["status_details"]).To(HaveKeyWithValue("project", "disabled"))
+	})
+	It(`Reports unavailable egress IPs and allowed outbound destinations as findings`, func() {
+		server.FailNext(http.MethodGet, "/projects/"+projectID+"/egress_ips", http.StatusInternalServerError, nil)
+		server.FailNext(http.MethodGet, "/projects/"+projectID+"/allowed_outbound_destinations", http.StatusForbidden, nil)
+		report, err := codeEngineService.DiagnoseProject(ctx, codeEngineService.NewDiagnoseProjectOptions(projectID))
+		Expect(err).To(BeNil())
+		Expect(codes(report.Findings)).To(ConsistOf("egress_ips_unavailable", "allowed_outbound_destinations_unavailable"))
+		Expect(report.EgressIps).To(BeNil())
+		Expect(report.AllowedOutboundDestinations).To(BeNil())
+	})
+	It(`Returns an error if the status details cannot be retrieved`, func() {
+		_, err := codeEngineService.DiagnoseProject(ctx, codeEngineService.NewDiagnoseProjectOptions("unknown-project"))
+		Expect(codeenginev2.IsNotFound(err)).To(BeTrue())
+		_, err = codeEngineService.DiagnoseProject(ctx, codeEngineService.NewDiagnoseProjectOptions("15314cc3-85b4-4338-903f-c28cdee6d005"))
+		Expect(codeenginev2.IsNotFound(err)).To(BeTrue())
+		_, err = codeEngineService.DiagnoseProject(ctx, nil)
+		Expect(err).ToNot(BeNil())
+	})
+})
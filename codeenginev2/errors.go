@@ -0,0 +1,217 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codeenginev2
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Sentinel errors for the classes of API errors. An *APIError matches the sentinel of its class with errors.Is, and
+// the Is* helpers accept both the errors returned by the operations and errors that wrap an *APIError or a sentinel.
+// The errors of the generated operations carry the *core.HTTPProblem of the response rather than an *APIError, so
+// they are classified with the Is* helpers or AsAPIError rather than with errors.Is.
+var (
+	// ErrNotFound : The resource does not exist (404).
+	ErrNotFound = errors.New("resource not found")
+
+	// ErrConflict : The resource already exists or is in a conflicting state (409).
+	ErrConflict = errors.New("resource conflict")
+
+	// ErrPreconditionFailed : The entity tag in the `If-Match` header does not match the resource (412).
+	ErrPreconditionFailed = errors.New("precondition failed")
+
+	// ErrQuotaExceeded : The request exceeds a quota or limit of the account or project.
+	ErrQuotaExceeded = errors.New("quota exceeded")
+
+	// ErrRateLimited : Too many requests have been sent (429).
+	ErrRateLimited = errors.New("rate limited")
+)
+
+// APIError : An error response of the Code Engine API.
+type APIError struct {
+	// The HTTP status code of the response.
+	StatusCode int
+
+	// The Code Engine error code of the first error in the response, e.g. `app_not_found`.
+	Code string
+
+	// The message of the first error in the response.
+	Message string
+
+	// The trace ID of the request, which identifies the request in support cases.
+	Trace string
+
+	// The ID of the operation that failed, e.g. `get_app`.
+	OperationID string
+
+	// The delay requested by the `Retry-After` header of the response, if any.
+	RetryAfter time.Duration
+
+	// The full response.
+	Response *core.DetailedResponse
+
+	err error
+}
+
+// AsAPIError returns the API error of an error returned by an operation, or of an error that wraps an *APIError.
+func AsAPIError(err error) (*APIError, bool) {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr, true
+	}
+	var httpProblem *core.HTTPProblem
+	if !errors.As(err, &httpProblem) || httpProblem.Response == nil {
+		return nil, false
+	}
+	return newAPIError(err, httpProblem), true
+}
+
+// newAPIError extracts the API error of an HTTP problem. Error responses have the form
+// `{"errors": [{"code": "...", "message": "..."}], "trace": "...", "status_code": 404}`.
+func newAPIError(err error, httpProblem *core.HTTPProblem) *APIError {
+	response := httpProblem.Response
+	apiErr := &APIError{
+		StatusCode:  response.GetStatusCode(),
+		OperationID: httpProblem.OperationID,
+		Response:    response,
+		err:         err,
+	}
+	if body, ok := response.GetResult().(map[string]interface{}); ok {
+		if errs, ok := body["errors"].([]interface{}); ok && len(errs) > 0 {
+			if first, ok := errs[0].(map[string]interface{}); ok {
+				apiErr.Code, _ = first["code"].(string)
+				apiErr.Message, _ = first["message"].(string)
+			}
+		}
+		apiErr.Trace, _ = body["trace"].(string)
+	}
+	if apiErr.Message == "" && httpProblem.IBMProblem != nil {
+		apiErr.Message = httpProblem.Summary
+	}
+	if apiErr.Trace == "" && response.Headers != nil {
+		apiErr.Trace = response.Headers.Get("X-Request-Id")
+	}
+	if response.Headers != nil {
+		apiErr.RetryAfter = parseRetryAfter(response.Headers.Get("Retry-After"))
+	}
+	return apiErr
+}
+
+// parseRetryAfter parses the value of a `Retry-After` header, which is either a number of seconds or an HTTP date.
+func parseRetryAfter(value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return max(time.Until(date), 0)
+	}
+	return 0
+}
+
+// Error returns the message of the error with its status code, error code and trace.
+func (e *APIError) Error() string {
+	msg := e.Message
+	if msg == "" {
+		msg = http.StatusText(e.StatusCode)
+	}
+	details := []string{fmt.Sprintf("status code %d", e.StatusCode)}
+	if e.Code != "" {
+		details = append(details, fmt.Sprintf("code '%s'", e.Code))
+	}
+	if e.Trace != "" {
+		details = append(details, fmt.Sprintf("trace '%s'", e.Trace))
+	}
+	return fmt.Sprintf("%s (%s)", msg, strings.Join(details, ", "))
+}
+
+// Unwrap returns the error that the API error has been extracted from.
+func (e *APIError) Unwrap() error {
+	return e.err
+}
+
+// Is returns true if the target is the sentinel error of the class of the API error.
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrConflict:
+		return e.StatusCode == http.StatusConflict && !e.isQuotaExceeded()
+	case ErrPreconditionFailed:
+		return e.StatusCode == http.StatusPreconditionFailed
+	case ErrQuotaExceeded:
+		return e.isQuotaExceeded()
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests && !e.isQuotaExceeded()
+	}
+	return false
+}
+
+// isQuotaExceeded returns true if the error code or message refer to an exceeded quota or limit. Code Engine reports
+// exceeded quotas with different status codes, e.g. 400, 403, 409 or 429, so the status code alone is not sufficient.
+func (e *APIError) isQuotaExceeded() bool {
+	code := strings.ToLower(e.Code)
+	return e.StatusCode >= 400 && e.StatusCode < 500 &&
+		(strings.Contains(code, "quota") || strings.Contains(code, "limit_exceeded") || strings.Contains(code, "limit_reached") ||
+			strings.Contains(strings.ToLower(e.Message), "quota"))
+}
+
+// IsNotFound returns true if the error reports that the resource does not exist.
+func IsNotFound(err error) bool {
+	return isClass(err, ErrNotFound)
+}
+
+// IsConflict returns true if the error reports that the resource already exists or is in a conflicting state.
+func IsConflict(err error) bool {
+	return isClass(err, ErrConflict)
+}
+
+// IsPreconditionFailed returns true if the error reports that the `If-Match` entity tag is outdated.
+func IsPreconditionFailed(err error) bool {
+	return isClass(err, ErrPreconditionFailed)
+}
+
+// IsQuotaExceeded returns true if the error reports that a quota or limit of the account or project is exceeded.
+func IsQuotaExceeded(err error) bool {
+	return isClass(err, ErrQuotaExceeded)
+}
+
+// IsRateLimited returns true if the error reports that too many requests have been sent. The delay requested by the
+// server is available in the RetryAfter field of the *APIError.
+func IsRateLimited(err error) bool {
+	return isClass(err, ErrRateLimited)
+}
+
+func isClass(err error, sentinel error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, sentinel) {
+		return true
+	}
+	apiErr, ok := AsAPIError(err)
+	return ok && apiErr.Is(sentinel)
+}
@@ -0,0 +1,136 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codeenginev2_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/IBM/code-engine-go-sdk/codeenginev2"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`CodeEngineV2 errors`, func() {
+	var testServer *httptest.Server
+	projectID := "15314cc3-85b4-4338-903f-c28cdee6d005"
+
+	// getAppError returns the error of GetApp for an error response with the status code, header and body.
+	getAppError := func(statusCode int, header http.Header, body string) error {
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			for name, values := range header {
+				res.Header()[name] = values
+			}
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(statusCode)
+			fmt.Fprint(res, body)
+		}))
+		codeEngineService, serviceErr := codeenginev2.NewCodeEngineV2(&codeenginev2.CodeEngineV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		codeEngineService.DisableRetries()
+
+		_, _, err := codeEngineService.GetApp(codeEngineService.NewGetAppOptions(projectID, "my-app"))
+		Expect(err).ToNot(BeNil())
+		return err
+	}
+
+	AfterEach(func() {
+		if testServer != nil {
+			testServer.Close()
+		}
+	})
+
+	It(`Extracts the API error of an error response`, func() {
+		err := getAppError(404, nil, `{"errors": [{"code": "app_not_found", "message": "The app 'my-app' does not exist"}], "trace": "abc-123", "status_code": 404}`)
+
+		apiErr, ok := codeenginev2.AsAPIError(err)
+		Expect(ok).To(BeTrue())
+		Expect(apiErr.StatusCode).To(Equal(404))
+		Expect(apiErr.Code).To(Equal("app_not_found"))
+		Expect(apiErr.Message).To(Equal("The app 'my-app' does not exist"))
+		Expect(apiErr.Trace).To(Equal("abc-123"))
+		Expect(apiErr.OperationID).To(Equal("get_app"))
+		Expect(apiErr.Error()).To(Equal("The app 'my-app' does not exist (status code 404, code 'app_not_found', trace 'abc-123')"))
+
+		Expect(errors.Is(apiErr, codeenginev2.ErrNotFound)).To(BeTrue())
+		Expect(errors.Is(apiErr, codeenginev2.ErrConflict)).To(BeFalse())
+		var httpProblem *core.HTTPProblem
+		Expect(errors.As(apiErr, &httpProblem)).To(BeTrue())
+
+		Expect(codeenginev2.IsNotFound(err)).To(BeTrue())
+		Expect(codeenginev2.IsConflict(err)).To(BeFalse())
+		Expect(codeenginev2.IsNotFound(fmt.Errorf("reconcile: %w", apiErr))).To(BeTrue())
+	})
+	It(`Classifies operation errors that are wrapped again`, func() {
+		err := getAppError(404, nil, `{"errors": [{"code": "app_not_found", "message": "The app 'my-app' does not exist"}], "trace": "abc-123", "status_code": 404}`)
+		Expect(err).To(BeAssignableToTypeOf(&core.SDKProblem{}))
+
+		for _, wrapped := range []error{
+			err,
+			core.RepurposeSDKProblem(err, "helper-error"),
+			core.SDKErrorf(err, "", "helper-error", &core.ProblemComponent{Name: "test"}),
+			fmt.Errorf("reconcile: %w", err),
+		} {
+			Expect(codeenginev2.IsNotFound(wrapped)).To(BeTrue())
+			Expect(codeenginev2.IsConflict(wrapped)).To(BeFalse())
+			apiErr, ok := codeenginev2.AsAPIError(wrapped)
+			Expect(ok).To(BeTrue())
+			Expect(apiErr.Code).To(Equal("app_not_found"))
+			Expect(apiErr.OperationID).To(Equal("get_app"))
+		}
+	})
+	It(`Classifies conflicts and failed preconditions`, func() {
+		Expect(codeenginev2.IsConflict(getAppError(409, nil, `{"errors": [{"code": "already_exists", "message": "exists"}]}`))).To(BeTrue())
+		testServer.Close()
+		err := getAppError(412, nil, `{"errors": [{"code": "precondition_failed", "message": "outdated"}]}`)
+		Expect(codeenginev2.IsPreconditionFailed(err)).To(BeTrue())
+		Expect(codeenginev2.IsConflict(err)).To(BeFalse())
+	})
+	It(`Classifies exceeded quotas regardless of the status code`, func() {
+		err := getAppError(409, nil, `{"errors": [{"code": "project_quota_exceeded", "message": "limit of 20 projects reached"}]}`)
+		Expect(codeenginev2.IsQuotaExceeded(err)).To(BeTrue())
+		Expect(codeenginev2.IsConflict(err)).To(BeFalse())
+	})
+	It(`Classifies rate limiting with the requested delay`, func() {
+		err := getAppError(429, http.Header{"Retry-After": {"3"}}, `{"errors": [{"code": "too_many_requests", "message": "slow down"}]}`)
+		Expect(codeenginev2.IsRateLimited(err)).To(BeTrue())
+		apiErr, ok := codeenginev2.AsAPIError(err)
+		Expect(ok).To(BeTrue())
+		Expect(apiErr.RetryAfter).To(Equal(3 * time.Second))
+	})
+	It(`Falls back to the trace header and the status text`, func() {
+		err := getAppError(404, http.Header{"X-Request-Id": {"req-1"}}, `{}`)
+		apiErr, ok := codeenginev2.AsAPIError(err)
+		Expect(ok).To(BeTrue())
+		Expect(apiErr.Trace).To(Equal("req-1"))
+		Expect(apiErr.Error()).To(HaveSuffix("(status code 404, trace 'req-1')"))
+	})
+	It(`Matches sentinel errors and ignores other errors`, func() {
+		Expect(codeenginev2.IsNotFound(fmt.Errorf("lookup: %w", codeenginev2.ErrNotFound))).To(BeTrue())
+		Expect(codeenginev2.IsRateLimited(errors.New("other"))).To(BeFalse())
+		Expect(codeenginev2.IsNotFound(nil)).To(BeFalse())
+		_, ok := codeenginev2.AsAPIError(errors.New("other"))
+		Expect(ok).To(BeFalse())
+	})
+})
@@ -0,0 +1,205 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codeenginev2
+
+import (
+	"iter"
+	"path"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	common "github.com/IBM/code-engine-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Filter : A predicate that selects the items of a pager, e.g. apps or job runs, on the client side.
+//
+// Filters are applied to the items of a pager iterator with Select and are composed with And, Or and Not:
+//
+//	failed := codeenginev2.Select(pager.All(ctx),
+//		codeenginev2.StatusIn[codeenginev2.JobRun](codeenginev2.JobRun_Status_Failed),
+//		codeenginev2.ReferencesJob[codeenginev2.JobRun]("nightly-import"),
+//		codeenginev2.CreatedAfter[codeenginev2.JobRun](time.Now().Add(-24*time.Hour)))
+//
+// The filters that inspect a field, like StatusIn, accept only the types with that field, e.g. StatusIn does not
+// compile for jobs, which have no status.
+type Filter[T any] func(item T) bool
+
+// Select returns an iterator over the items of the iterator that match all filters. Errors are passed through. Like
+// the pager iterators, it retrieves the pages while the iteration proceeds and stops when the loop breaks.
+func Select[T any](items iter.Seq2[T, error], filters ...Filter[T]) iter.Seq2[T, error] {
+	filter := And(filters...)
+	return func(yield func(T, error) bool) {
+		for item, err := range items {
+			if err != nil || filter(item) {
+				if !yield(item, err) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// And returns a filter that selects the items that match all filters. Without filters, it selects all items.
+func And[T any](filters ...Filter[T]) Filter[T] {
+	return func(item T) bool {
+		for _, filter := range filters {
+			if !filter(item) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// Or returns a filter that selects the items that match any of the filters. Without filters, it selects no items.
+func Or[T any](filters ...Filter[T]) Filter[T] {
+	return func(item T) bool {
+		for _, filter := range filters {
+			if filter(item) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// Not returns a filter that selects the items that do not match the filter.
+func Not[T any](filter Filter[T]) Filter[T] {
+	return func(item T) bool {
+		return !filter(item)
+	}
+}
+
+// NameGlob returns a filter that selects the items whose name matches the shell pattern, e.g. `frontend-*`. The
+// syntax of the pattern is that of path.Match.
+func NameGlob[T interface{ GetName() string }](pattern string) (Filter[T], error) {
+	if _, err := path.Match(pattern, ""); err != nil {
+		return nil, core.SDKErrorf(err, "", "invalid-name-pattern", common.GetComponentInfo())
+	}
+	return func(item T) bool {
+		matched, _ := path.Match(pattern, item.GetName())
+		return matched
+	}, nil
+}
+
+// NameRegexp returns a filter that selects the items whose name matches the regular expression.
+func NameRegexp[T interface{ GetName() string }](expression *regexp.Regexp) Filter[T] {
+	return func(item T) bool {
+		return expression.MatchString(item.GetName())
+	}
+}
+
+// StatusIn returns a filter that selects the items with one of the statuses, e.g. App_Status_Failed.
+func StatusIn[T interface{ GetStatus() string }](statuses ...string) Filter[T] {
+	return func(item T) bool {
+		status := item.GetStatus()
+		return status != "" && slices.Contains(statuses, status)
+	}
+}
+
+// CreatedAfter returns a filter that selects the items that have been created at or after the time.
+func CreatedAfter[T interface{ GetCreatedAt() string }](after time.Time) Filter[T] {
+	return func(item T) bool {
+		createdAt, err := time.Parse(time.RFC3339, item.GetCreatedAt())
+		return err == nil && !createdAt.Before(after)
+	}
+}
+
+// CreatedBefore returns a filter that selects the items that have been created before the time.
+func CreatedBefore[T interface{ GetCreatedAt() string }](before time.Time) Filter[T] {
+	return func(item T) bool {
+		createdAt, err := time.Parse(time.RFC3339, item.GetCreatedAt())
+		return err == nil && createdAt.Before(before)
+	}
+}
+
+// ReferencesImage returns a filter that selects the items that run or build the image, e.g. apps, jobs, job runs,
+// builds and build runs. An image without tag or digest, e.g. `icr.io/ns/app`, matches all tags and digests of the
+// image.
+func ReferencesImage[T interface{ ReferencedImages() []string }](image string) Filter[T] {
+	return func(item T) bool {
+		for _, reference := range item.ReferencedImages() {
+			if reference == image || strings.HasPrefix(reference, image+":") || strings.HasPrefix(reference, image+"@") {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// ReferencesBuild returns a filter that selects the items that have been created from the build, e.g. build runs,
+// and apps and jobs whose image has been built by it.
+func ReferencesBuild[T interface{ ReferencedBuilds() []string }](build string) Filter[T] {
+	return func(item T) bool {
+		return slices.Contains(item.ReferencedBuilds(), build)
+	}
+}
+
+// ReferencesJob returns a filter that selects the job runs of the job.
+func ReferencesJob[T interface{ GetJobName() string }](job string) Filter[T] {
+	return func(item T) bool {
+		name := item.GetJobName()
+		return name != "" && name == job
+	}
+}
+
+// ReferencesSecret returns a filter that selects the items that use the secret, e.g. as image pull secret, as
+// environment variables or volume mounts, as TLS secret of domain mappings, or in bindings.
+func ReferencesSecret[T interface{ ReferencedSecrets() []string }](secret string) Filter[T] {
+	return func(item T) bool {
+		return slices.Contains(item.ReferencedSecrets(), secret)
+	}
+}
+
+// ReferencesConfigMap returns a filter that selects the items that use the config map as environment variables or
+// volume mounts.
+func ReferencesConfigMap[T interface{ ReferencedConfigMaps() []string }](configMap string) Filter[T] {
+	return func(item T) bool {
+		return slices.Contains(item.ReferencedConfigMaps(), configMap)
+	}
+}
+
+// referencedNames returns the names in the fields of an item that are set. It is used by the generated accessors.
+func referencedNames(fields ...*string) []string {
+	var names []string
+	for _, field := range fields {
+		if field != nil {
+			names = append(names, *field)
+		}
+	}
+	return names
+}
+
+// mountedReferences returns the references of the environment variables and volume mounts of an item that have one of
+// the types. It is used by the generated accessors.
+func mountedReferences(envVariables []EnvVar, volumeMounts []VolumeMount, types ...string) []string {
+	var names []string
+	for _, envVariable := range envVariables {
+		if envVariable.Reference != nil && slices.Contains(types, core.StringNilMapper(envVariable.Type)) {
+			names = append(names, *envVariable.Reference)
+		}
+	}
+	for _, volumeMount := range volumeMounts {
+		if volumeMount.Reference != nil && slices.Contains(types, core.StringNilMapper(volumeMount.Type)) {
+			names = append(names, *volumeMount.Reference)
+		}
+	}
+	return names
+}
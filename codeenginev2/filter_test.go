@@ -0,0 +1,131 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codeenginev2_test
+
+import (
+	"context"
+	"iter"
+	"regexp"
+	"time"
+
+	"github.com/IBM/code-engine-go-sdk/codeenginev2"
+	"github.com/IBM/code-engine-go-sdk/codeenginev2/codeenginev2test"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`CodeEngineV2 filters`, func() {
+	var server *codeenginev2test.Server
+	var codeEngineService *codeenginev2.CodeEngineV2
+	var projectID string
+	ctx := context.Background()
+
+	// collect returns the names of the items that an iterator yields.
+	collect := func(items iter.Seq2[codeenginev2.JobRun, error]) []string {
+		var result []string
+		for jobRun, err := range items {
+			Expect(err).To(BeNil())
+			result = append(result, *jobRun.Name)
+		}
+		return result
+	}
+
+	BeforeEach(func() {
+		server = codeenginev2test.NewServer()
+		var err error
+		codeEngineService, err = server.NewCodeEngineV2()
+		Expect(err).To(BeNil())
+		projectID = server.AddProject("my-project")
+	})
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It(`Selects the failed job runs of a job in the last 24 hours`, func() {
+		for _, job := range []string{"import", "export"} {
+			_, _, err := codeEngineService.CreateJob(codeEngineService.NewCreateJobOptions(projectID, "icr.io/codeengine/"+job, job))
+			Expect(err).To(BeNil())
+		}
+		createJobRun := func(job string, name string) {
+			_, _, err := codeEngineService.CreateJobRun(codeEngineService.NewCreateJobRunOptions(projectID).SetJobName(job).SetName(name))
+			Expect(err).To(BeNil())
+		}
+		server.ScriptStatus("job_runs", codeenginev2test.StatusStep{Status: codeenginev2.JobRun_Status_Failed})
+		createJobRun("import", "import-1")
+		createJobRun("import", "import-2")
+		createJobRun("export", "export-1")
+		server.ScriptStatus("job_runs")
+		createJobRun("import", "import-3")
+		Expect(server.ModifyResource(projectID, "job_runs", "import-1", map[string]interface{}{
+			"created_at": time.Now().Add(-48 * time.Hour).UTC().Format(time.RFC3339),
+		})).To(BeTrue())
+
+		pager, err := codeEngineService.NewJobRunsPager(codeEngineService.NewListJobRunsOptions(projectID).SetLimit(2))
+		Expect(err).To(BeNil())
+		Expect(collect(codeenginev2.Select(pager.All(ctx),
+			codeenginev2.StatusIn[codeenginev2.JobRun](codeenginev2.JobRun_Status_Failed),
+			codeenginev2.ReferencesJob[codeenginev2.JobRun]("import"),
+			codeenginev2.CreatedAfter[codeenginev2.JobRun](time.Now().Add(-24*time.Hour)),
+		))).To(Equal([]string{"import-2"}))
+
+		pager, err = codeEngineService.NewJobRunsPager(codeEngineService.NewListJobRunsOptions(projectID))
+		Expect(err).To(BeNil())
+		Expect(collect(codeenginev2.Select(pager.All(ctx), codeenginev2.Or(
+			codeenginev2.ReferencesImage[codeenginev2.JobRun]("icr.io/codeengine/export"),
+			codeenginev2.Not(codeenginev2.StatusIn[codeenginev2.JobRun](codeenginev2.JobRun_Status_Failed)),
+		)))).To(Equal([]string{"export-1", "import-3"}))
+	})
+	It(`Matches names by glob and regular expression`, func() {
+		glob, err := codeenginev2.NameGlob[codeenginev2.App]("front*")
+		Expect(err).To(BeNil())
+		frontend := codeenginev2.App{Name: core.StringPtr("frontend-v2")}
+		backend := codeenginev2.App{Name: core.StringPtr("backend")}
+		Expect(glob(frontend)).To(BeTrue())
+		Expect(glob(backend)).To(BeFalse())
+
+		expression := codeenginev2.NameRegexp[codeenginev2.App](regexp.MustCompile(`-v\d+$`))
+		Expect(expression(frontend)).To(BeTrue())
+		Expect(expression(codeenginev2.App{})).To(BeFalse())
+
+		_, err = codeenginev2.NameGlob[codeenginev2.App]("[")
+		Expect(err).ToNot(BeNil())
+	})
+	It(`Selects the resources that reference a secret, config map or build`, func() {
+		app := &codeenginev2.App{
+			Name:  core.StringPtr("app"),
+			Build: core.StringPtr("app-build"),
+			RunEnvVariables: []codeenginev2.EnvVar{
+				{Type: core.StringPtr(codeenginev2.EnvVar_Type_SecretFullReference), Reference: core.StringPtr("credentials")},
+			},
+			RunVolumeMounts: []codeenginev2.VolumeMount{
+				{Type: core.StringPtr(codeenginev2.VolumeMount_Type_ConfigMap), Reference: core.StringPtr("settings")},
+			},
+		}
+		Expect(codeenginev2.ReferencesSecret[*codeenginev2.App]("credentials")(app)).To(BeTrue())
+		Expect(codeenginev2.ReferencesSecret[*codeenginev2.App]("other")(app)).To(BeFalse())
+		Expect(codeenginev2.ReferencesConfigMap[*codeenginev2.App]("settings")(app)).To(BeTrue())
+		Expect(codeenginev2.ReferencesConfigMap[*codeenginev2.App]("credentials")(app)).To(BeFalse())
+		Expect(codeenginev2.ReferencesBuild[*codeenginev2.App]("app-build")(app)).To(BeTrue())
+
+		binding := codeenginev2.Binding{SecretName: core.StringPtr("credentials")}
+		Expect(codeenginev2.ReferencesSecret[codeenginev2.Binding]("credentials")(binding)).To(BeTrue())
+		domainMapping := codeenginev2.DomainMapping{TlsSecret: core.StringPtr("tls")}
+		Expect(codeenginev2.ReferencesSecret[codeenginev2.DomainMapping]("tls")(domainMapping)).To(BeTrue())
+		Expect(codeenginev2.StatusIn[codeenginev2.DomainMapping](codeenginev2.DomainMapping_Status_Ready)(domainMapping)).To(BeFalse())
+	})
+})
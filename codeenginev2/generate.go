@@ -0,0 +1,23 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codeenginev2
+
+// The interfaces of the operations in code_engine_v2_api.go, the iterators of the pagers in code_engine_v2_pagers.go,
+// the routes of the operations in code_engine_v2_routes.go, the project-scoped clients in code_engine_v2_project.go,
+// the accessors of the resources in code_engine_v2_accessors.go and the mock in codeenginev2mock are generated from
+// code_engine_v2.go. Run `go generate` after the service code has been regenerated.
+//go:generate go run ./internal/apigen
@@ -0,0 +1,119 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codeenginev2
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	common "github.com/IBM/code-engine-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// IndexRange : A contiguous range of array job indices. Both bounds are inclusive.
+type IndexRange struct {
+	// The first index of the range.
+	Start int64
+
+	// The last index of the range.
+	End int64
+}
+
+// Len returns the number of indices covered by the range.
+func (indexRange IndexRange) Len() int64 {
+	return indexRange.End - indexRange.Start + 1
+}
+
+// String formats the range the way the API does, e.g. "3" or "5-9".
+func (indexRange IndexRange) String() string {
+	if indexRange.Start == indexRange.End {
+		return strconv.FormatInt(indexRange.Start, 10)
+	}
+	return fmt.Sprintf("%d-%d", indexRange.Start, indexRange.End)
+}
+
+// IndexRanges : A list of array job index ranges, as used by `ScaleArraySpec` and the `*Indices` fields of
+// JobRunStatus.
+type IndexRanges []IndexRange
+
+// ParseIndexRanges parses an index list such as "0,3-5,8" into its ranges.
+// Whitespace around entries is ignored and an empty string yields an empty list.
+func ParseIndexRanges(indices string) (IndexRanges, error) {
+	var ranges IndexRanges
+	if strings.TrimSpace(indices) == "" {
+		return ranges, nil
+	}
+
+	for _, entry := range strings.Split(indices, ",") {
+		entry = strings.TrimSpace(entry)
+		startStr, endStr, isRange := strings.Cut(entry, "-")
+
+		start, err := strconv.ParseInt(strings.TrimSpace(startStr), 10, 64)
+		if err != nil || start < 0 {
+			return nil, core.SDKErrorf(nil, fmt.Sprintf("invalid index range '%s' in '%s'", entry, indices), "invalid-index-range", common.GetComponentInfo())
+		}
+		end := start
+		if isRange {
+			end, err = strconv.ParseInt(strings.TrimSpace(endStr), 10, 64)
+			if err != nil || end < start {
+				return nil, core.SDKErrorf(nil, fmt.Sprintf("invalid index range '%s' in '%s'", entry, indices), "invalid-index-range", common.GetComponentInfo())
+			}
+		}
+		ranges = append(ranges, IndexRange{Start: start, End: end})
+	}
+	return ranges, nil
+}
+
+// Len returns the total number of indices covered by all ranges.
+func (ranges IndexRanges) Len() int64 {
+	var count int64
+	for _, indexRange := range ranges {
+		count += indexRange.Len()
+	}
+	return count
+}
+
+// Contains returns true if "index" is covered by any of the ranges.
+func (ranges IndexRanges) Contains(index int64) bool {
+	for _, indexRange := range ranges {
+		if index >= indexRange.Start && index <= indexRange.End {
+			return true
+		}
+	}
+	return false
+}
+
+// Indices expands the ranges into the individual indices they cover.
+func (ranges IndexRanges) Indices() []int64 {
+	indices := make([]int64, 0, ranges.Len())
+	for _, indexRange := range ranges {
+		for index := indexRange.Start; index <= indexRange.End; index++ {
+			indices = append(indices, index)
+		}
+	}
+	return indices
+}
+
+// String formats the ranges the way the API does, e.g. "0,3-5,8".
+func (ranges IndexRanges) String() string {
+	entries := make([]string, len(ranges))
+	for i, indexRange := range ranges {
+		entries[i] = indexRange.String()
+	}
+	return strings.Join(entries, ",")
+}
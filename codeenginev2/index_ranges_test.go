@@ -0,0 +1,50 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codeenginev2_test
+
+import (
+	"github.com/IBM/code-engine-go-sdk/codeenginev2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`CodeEngineV2 index ranges`, func() {
+	Describe(`ParseIndexRanges(indices)`, func() {
+		It(`Parses single indices and ranges`, func() {
+			ranges, err := codeenginev2.ParseIndexRanges("0, 3-5,8,10-10")
+			Expect(err).To(BeNil())
+			Expect(ranges).To(Equal(codeenginev2.IndexRanges{{Start: 0, End: 0}, {Start: 3, End: 5}, {Start: 8, End: 8}, {Start: 10, End: 10}}))
+			Expect(ranges.Len()).To(Equal(int64(6)))
+			Expect(ranges.Contains(4)).To(BeTrue())
+			Expect(ranges.Contains(6)).To(BeFalse())
+			Expect(ranges.Indices()).To(Equal([]int64{0, 3, 4, 5, 8, 10}))
+			Expect(ranges.String()).To(Equal("0,3-5,8,10"))
+		})
+		It(`Returns an empty list for an empty string`, func() {
+			ranges, err := codeenginev2.ParseIndexRanges("")
+			Expect(err).To(BeNil())
+			Expect(ranges).To(BeEmpty())
+			Expect(ranges.Len()).To(BeZero())
+		})
+		It(`Rejects malformed entries`, func() {
+			for _, indices := range []string{"a", "1,", "5-3", "-1", "1-2-3"} {
+				_, err := codeenginev2.ParseIndexRanges(indices)
+				Expect(err).ToNot(BeNil(), indices)
+			}
+		})
+	})
+})
@@ -0,0 +1,861 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Command apigen generates the interfaces of the CodeEngineV2 operations and their recording mock.
+//
+// It reads the operations from code_engine_v2.go, groups them by resource, and writes code_engine_v2_api.go and
+// codeenginev2mock/code_engine_v2_mock.go. It also writes the iterator and checkpoint methods of the pagers to
+// code_engine_v2_pagers.go, and the routes of the operations, which locate the project and resource in the path of a
+// request, to code_engine_v2_routes.go, and the project-scoped clients of the operations of a project, which bind its
+// ID, to code_engine_v2_project.go, and the accessors of the resources that the filters use to
+// code_engine_v2_accessors.go. It is run from the codeenginev2 directory by `go generate`.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+const (
+	sourceFile    = "code_engine_v2.go"
+	interfaceFile = "code_engine_v2_api.go"
+	mockFile      = "codeenginev2mock/code_engine_v2_mock.go"
+	pagerFile     = "code_engine_v2_pagers.go"
+	routeFile     = "code_engine_v2_routes.go"
+	projectFile   = "code_engine_v2_project.go"
+	accessorFile  = "code_engine_v2_accessors.go"
+)
+
+// The fields of the resources that hold the name of a referenced resource, by the kind of the referenced resource.
+var (
+	imageFields  = []string{"ImageReference", "OutputImage"}
+	buildFields  = []string{"Build", "BuildName"}
+	secretFields = []string{"CodeSecret", "ImageSecret", "OutputSecret", "SecretName", "SourceSecret", "TlsSecret"}
+)
+
+// groups maps the resource groups to the resources of the operations that belong to them. The resource of an
+// operation is its name without the verb, e.g. `AppRevisions` for `ListAppRevisions`.
+var groups = []struct {
+	name      string
+	resources []string
+}{
+	{"Projects", []string{"Projects", "Project", "ProjectEgressIps", "ProjectStatusDetails"}},
+	{"AllowedOutboundDestinations", []string{"AllowedOutboundDestinations", "AllowedOutboundDestination"}},
+	{"Apps", []string{"Apps", "App", "AppInstances", "AppRevisions", "AppRevision"}},
+	{"JobRuns", []string{"JobRuns", "JobRun"}},
+	{"Jobs", []string{"Jobs", "Job"}},
+	{"Functions", []string{"FunctionRuntimes", "Functions", "Function"}},
+	{"Bindings", []string{"Bindings", "Binding"}},
+	{"BuildRuns", []string{"BuildRuns", "BuildRun"}},
+	{"Builds", []string{"Builds", "Build"}},
+	{"DomainMappings", []string{"DomainMappings", "DomainMapping"}},
+	{"ConfigMaps", []string{"ConfigMaps", "ConfigMap"}},
+	{"Secrets", []string{"Secrets", "Secret"}},
+	{"PersistentDataStores", []string{"PersistentDataStores", "PersistentDataStore"}},
+}
+
+var verbs = []string{"List", "Create", "Get", "Update", "Replace", "Delete"}
+
+const header = `/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by apigen from code_engine_v2.go. DO NOT EDIT.
+`
+
+// operation is an operation of the service, e.g. `GetApp`.
+type operation struct {
+	Name string
+
+	// The doc comment of the operation, without comment markers.
+	Doc []string
+
+	// The name and type of the options parameter.
+	OptionsName string
+	OptionsType string
+
+	// The type of the result, or empty if the operation only returns a response.
+	ResultType string
+
+	// The qualified types for the mock.
+	QualifiedOptionsType string
+	QualifiedResultType  string
+}
+
+// pager is a pager of a List operation, e.g. `AppsPager`.
+type pager struct {
+	Name string
+
+	// The type of the items of the pages.
+	ItemType string
+
+	// The type of the options of the List operation.
+	OptionsType string
+}
+
+// route is the path template of an operation, e.g. `/projects/{project_id}/apps/{name}` for GetApp.
+type route struct {
+	Operation string
+	Path      string
+
+	// True if the operation returns a page of resources, i.e. if it has a pager.
+	Paginated bool
+}
+
+// group is a resource group with its operations.
+type group struct {
+	Name       string
+	Operations []*operation
+}
+
+// projectGroup is the project-scoped client of a resource group, e.g. `ProjectApps`, with the operations of the group
+// that take a project ID. The operations of the Projects group belong to ProjectClient itself.
+type projectGroup struct {
+	Name     string
+	Type     string
+	Receiver string
+	Methods  []*projectMethod
+	Pagers   []*projectPager
+}
+
+// projectMethod is an operation of a project-scoped client, e.g. `Create` for `CreateApp`.
+type projectMethod struct {
+	Name      string
+	Operation *operation
+
+	// The doc comment of the operation with the name of the method.
+	Doc []string
+
+	// The options type without pointer, and its field and constructor parameter of the project ID.
+	Options      string
+	Field        string
+	ProjectParam string
+
+	// The other parameters of the options constructor.
+	Params []*param
+}
+
+// param is a parameter of an options constructor.
+type param struct {
+	Name string
+	Type string
+}
+
+// accessor is a resource type of the pagers with the fields that its accessors read. The accessors map unset fields
+// to empty values.
+type accessor struct {
+	Type     string
+	Receiver string
+
+	// The kind of resource in prose, e.g. `build run`.
+	Noun string
+
+	// The *string fields, e.g. `Name`, that have a Get accessor.
+	Getters []string
+
+	// The *string fields that hold the images, builds and secrets that the resource references.
+	Images  []string
+	Builds  []string
+	Secrets []string
+
+	// The environment variables and volume mounts, which reference secrets and config maps.
+	EnvVariables string
+	VolumeMounts string
+}
+
+// References returns true if the resource references secrets.
+func (a *accessor) References() bool {
+	return len(a.Secrets) > 0 || a.Mounts()
+}
+
+// Mounts returns true if the resource has environment variables or volume mounts, which reference secrets and config
+// maps.
+func (a *accessor) Mounts() bool {
+	return a.EnvVariables != "" || a.VolumeMounts != ""
+}
+
+// projectPager is the pager constructor of a project-scoped client, e.g. `NewPager` for `AppsPager`.
+type projectPager struct {
+	Name    string
+	Pager   string
+	Options string
+}
+
+func main() {
+	fileSet := token.NewFileSet()
+	file, err := parser.ParseFile(fileSet, sourceFile, nil, parser.ParseComments)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	result, err := collect(file)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := render(interfaceFile, interfaceTemplate, result); err != nil {
+		log.Fatal(err)
+	}
+	if err := render(mockFile, mockTemplate, result); err != nil {
+		log.Fatal(err)
+	}
+	pagers := collectPagers(file)
+	if err := render(pagerFile, pagerTemplate, pagers); err != nil {
+		log.Fatal(err)
+	}
+	routes, err := collectRoutes(file, pagers)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := render(routeFile, routeTemplate, routes); err != nil {
+		log.Fatal(err)
+	}
+	projectGroups, err := collectProjectGroups(file, result, pagers)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := render(projectFile, projectTemplate, projectGroups); err != nil {
+		log.Fatal(err)
+	}
+	if err := render(accessorFile, accessorTemplate, collectAccessors(file, pagers)); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// collect returns the resource groups with the operations of the file, in the order of the groups table. Operations
+// are the methods of CodeEngineV2 that have a `WithContext` variant. Operations of unknown resources are an error, so
+// that new operations of the API are assigned to a group.
+func collect(file *ast.File) ([]*group, error) {
+	result := make([]*group, len(groups))
+	byResource := map[string]*group{}
+	for i, g := range groups {
+		result[i] = &group{Name: g.name}
+		for _, resource := range g.resources {
+			byResource[resource] = result[i]
+		}
+	}
+
+	methods := map[string]*ast.FuncDecl{}
+	var names []string
+	for _, decl := range file.Decls {
+		funcDecl, ok := decl.(*ast.FuncDecl)
+		if !ok || funcDecl.Recv == nil || !funcDecl.Name.IsExported() || !isServiceReceiver(funcDecl.Recv) {
+			continue
+		}
+		methods[funcDecl.Name.Name] = funcDecl
+		names = append(names, funcDecl.Name.Name)
+	}
+
+	for _, name := range names {
+		withContext := methods[name+"WithContext"]
+		if withContext == nil {
+			continue
+		}
+		g := byResource[resourceOf(name)]
+		if g == nil {
+			return nil, fmt.Errorf("operation %s does not belong to a resource group", name)
+		}
+		op, err := newOperation(methods[name], withContext)
+		if err != nil {
+			return nil, err
+		}
+		g.Operations = append(g.Operations, op)
+	}
+	return result, nil
+}
+
+// collectPagers returns the pagers of the file in the order of their GetNextWithContext methods.
+func collectPagers(file *ast.File) []*pager {
+	optionsTypes := map[string]string{}
+	for _, decl := range file.Decls {
+		funcDecl, ok := decl.(*ast.FuncDecl)
+		if ok && funcDecl.Recv != nil && isServiceReceiver(funcDecl.Recv) && strings.HasSuffix(funcDecl.Name.Name, "Pager") {
+			optionsType := typeString(funcDecl.Type.Params.List[0].Type, false)
+			optionsTypes[strings.TrimPrefix(funcDecl.Name.Name, "New")] = strings.TrimPrefix(optionsType, "*")
+		}
+	}
+
+	var result []*pager
+	for _, decl := range file.Decls {
+		funcDecl, ok := decl.(*ast.FuncDecl)
+		if !ok || funcDecl.Recv == nil || funcDecl.Name.Name != "GetNextWithContext" {
+			continue
+		}
+		star, ok := funcDecl.Recv.List[0].Type.(*ast.StarExpr)
+		if !ok {
+			continue
+		}
+		page, ok := funcDecl.Type.Results.List[0].Type.(*ast.ArrayType)
+		if !ok {
+			continue
+		}
+		name := typeString(star.X, false)
+		result = append(result, &pager{Name: name, ItemType: typeString(page.Elt, false), OptionsType: optionsTypes[name]})
+	}
+	return result
+}
+
+// collectRoutes returns the routes of the operations in the order of their WithContext methods. The path template and
+// operation ID of a route are the arguments of the ResolveRequestURL and common.GetSdkHeaders calls of the method. The
+// List operations of the pagers are paginated.
+func collectRoutes(file *ast.File, pagers []*pager) ([]*route, error) {
+	paginated := map[string]bool{}
+	for _, p := range pagers {
+		paginated[strings.TrimSuffix(p.OptionsType, "Options")] = true
+	}
+
+	var result []*route
+	for _, decl := range file.Decls {
+		funcDecl, ok := decl.(*ast.FuncDecl)
+		if !ok || funcDecl.Recv == nil || !isServiceReceiver(funcDecl.Recv) || !strings.HasSuffix(funcDecl.Name.Name, "WithContext") {
+			continue
+		}
+		r := &route{}
+		ast.Inspect(funcDecl.Body, func(node ast.Node) bool {
+			call, ok := node.(*ast.CallExpr)
+			if !ok {
+				return true
+			}
+			selector, ok := call.Fun.(*ast.SelectorExpr)
+			if !ok {
+				return true
+			}
+			switch selector.Sel.Name {
+			case "ResolveRequestURL":
+				r.Path = stringLiteral(call.Args[1])
+			case "GetSdkHeaders":
+				r.Operation = stringLiteral(call.Args[2])
+			}
+			return true
+		})
+		if r.Path == "" || r.Operation == "" {
+			return nil, fmt.Errorf("the route of %s cannot be determined", funcDecl.Name.Name)
+		}
+		r.Paginated = paginated[r.Operation]
+		result = append(result, r)
+	}
+	return result, nil
+}
+
+// collectProjectGroups returns the project-scoped clients of the groups. An operation belongs to the client of its
+// group if the first parameter of its options constructor is the project ID, i.e. `projectID`, or `id` for the
+// operations of a project. The name of the method is the name of the operation without the resource of the group,
+// e.g. `ListInstances` for `ListAppInstances`.
+func collectProjectGroups(file *ast.File, groups []*group, pagers []*pager) ([]*projectGroup, error) {
+	constructors := map[string]*ast.FuncDecl{}
+	for _, decl := range file.Decls {
+		funcDecl, ok := decl.(*ast.FuncDecl)
+		if ok && funcDecl.Recv != nil && isServiceReceiver(funcDecl.Recv) && strings.HasPrefix(funcDecl.Name.Name, "New") {
+			constructors[strings.TrimPrefix(funcDecl.Name.Name, "New")] = funcDecl
+		}
+	}
+	pagersByOptions := map[string]*pager{}
+	for _, p := range pagers {
+		pagersByOptions[p.OptionsType] = p
+	}
+
+	var result []*projectGroup
+	for _, g := range groups {
+		pg := &projectGroup{Name: g.Name, Type: "Project" + g.Name, Receiver: strings.ToLower(g.Name[:1]) + g.Name[1:]}
+		if g.Name == "Projects" {
+			pg.Type, pg.Receiver = "ProjectClient", "project"
+		}
+		singular := strings.TrimSuffix(g.Name, "s")
+		for _, op := range g.Operations {
+			options := strings.TrimPrefix(op.OptionsType, "*")
+			constructor := constructors[options]
+			if constructor == nil {
+				return nil, fmt.Errorf("the options of %s have no constructor", op.Name)
+			}
+			var params []*param
+			for _, field := range constructor.Type.Params.List {
+				for _, name := range field.Names {
+					params = append(params, &param{Name: name.Name, Type: typeString(field.Type, false)})
+				}
+			}
+			var fieldName string
+			switch {
+			case len(params) > 0 && params[0].Name == "projectID":
+				fieldName = "ProjectID"
+			case len(params) > 0 && params[0].Name == "id" && g.Name == "Projects":
+				fieldName = "ID"
+			default:
+				continue
+			}
+
+			resource := resourceOf(op.Name)
+			verb := strings.TrimSuffix(op.Name, resource)
+			name := verb + strings.TrimPrefix(strings.TrimPrefix(resource, g.Name), singular)
+			m := &projectMethod{
+				Name:         name,
+				Operation:    op,
+				Options:      options,
+				Field:        fieldName,
+				ProjectParam: params[0].Name,
+				Params:       params[1:],
+			}
+			for i, line := range op.Doc {
+				if i == 0 {
+					line = name + strings.TrimPrefix(line, op.Name)
+				}
+				m.Doc = append(m.Doc, line)
+			}
+			pg.Methods = append(pg.Methods, m)
+			if p := pagersByOptions[options]; p != nil {
+				pg.Pagers = append(pg.Pagers, &projectPager{Name: "New" + strings.TrimPrefix(name, "List") + "Pager", Pager: p.Name, Options: options})
+			}
+		}
+		if len(pg.Methods) > 0 {
+			result = append(result, pg)
+		}
+	}
+	return result, nil
+}
+
+// collectAccessors returns the accessors of the item types of the pagers that are structs, in the order of the pagers.
+func collectAccessors(file *ast.File, pagers []*pager) []*accessor {
+	structs := map[string]*ast.StructType{}
+	for _, decl := range file.Decls {
+		genDecl, ok := decl.(*ast.GenDecl)
+		if !ok || genDecl.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range genDecl.Specs {
+			typeSpec := spec.(*ast.TypeSpec)
+			if structType, ok := typeSpec.Type.(*ast.StructType); ok {
+				structs[typeSpec.Name.Name] = structType
+			}
+		}
+	}
+
+	var result []*accessor
+	for _, p := range pagers {
+		structType := structs[p.ItemType]
+		if structType == nil {
+			continue
+		}
+		fields := map[string]string{}
+		for _, field := range structType.Fields.List {
+			for _, name := range field.Names {
+				fields[name.Name] = typeString(field.Type, false)
+			}
+		}
+		stringFields := func(names ...string) []string {
+			var present []string
+			for _, name := range names {
+				if fields[name] == "*string" {
+					present = append(present, name)
+				}
+			}
+			return present
+		}
+
+		a := &accessor{
+			Type:     p.ItemType,
+			Receiver: strings.ToLower(p.ItemType[:1]) + p.ItemType[1:],
+			Noun:     noun(p.ItemType),
+			Getters:  stringFields("Name", "Status", "CreatedAt", "JobName"),
+			Images:   stringFields(imageFields...),
+			Builds:   stringFields(buildFields...),
+			Secrets:  stringFields(secretFields...),
+		}
+		if fields["RunEnvVariables"] == "[]EnvVar" {
+			a.EnvVariables = "RunEnvVariables"
+		}
+		if fields["RunVolumeMounts"] == "[]VolumeMount" {
+			a.VolumeMounts = "RunVolumeMounts"
+		}
+		result = append(result, a)
+	}
+	return result
+}
+
+// noun returns the name of a type in prose, e.g. `build run` for `BuildRun`.
+func noun(typeName string) string {
+	var words []string
+	start := 0
+	for i := 1; i < len(typeName); i++ {
+		if typeName[i] >= 'A' && typeName[i] <= 'Z' {
+			words = append(words, strings.ToLower(typeName[start:i]))
+			start = i
+		}
+	}
+	return strings.Join(append(words, strings.ToLower(typeName[start:])), " ")
+}
+
+// stringLiteral returns the value of a string literal, or an empty string if the expression is not one.
+func stringLiteral(expr ast.Expr) string {
+	literal, ok := expr.(*ast.BasicLit)
+	if !ok || literal.Kind != token.STRING {
+		return ""
+	}
+	value, err := strconv.Unquote(literal.Value)
+	if err != nil {
+		return ""
+	}
+	return value
+}
+
+// isServiceReceiver returns true if the receiver is `*CodeEngineV2`.
+func isServiceReceiver(recv *ast.FieldList) bool {
+	star, ok := recv.List[0].Type.(*ast.StarExpr)
+	if !ok {
+		return false
+	}
+	ident, ok := star.X.(*ast.Ident)
+	return ok && ident.Name == "CodeEngineV2"
+}
+
+// resourceOf returns the name of the operation without its verb.
+func resourceOf(name string) string {
+	for _, verb := range verbs {
+		if strings.HasPrefix(name, verb) {
+			return strings.TrimPrefix(name, verb)
+		}
+	}
+	return name
+}
+
+func newOperation(funcDecl *ast.FuncDecl, withContext *ast.FuncDecl) (*operation, error) {
+	params := withContext.Type.Params.List
+	if len(params) != 2 || len(params[1].Names) != 1 {
+		return nil, fmt.Errorf("operation %s does not take a context and options", withContext.Name.Name)
+	}
+	op := &operation{
+		Name:                 funcDecl.Name.Name,
+		OptionsName:          params[1].Names[0].Name,
+		OptionsType:          typeString(params[1].Type, false),
+		QualifiedOptionsType: typeString(params[1].Type, true),
+	}
+	if funcDecl.Doc != nil {
+		for _, line := range strings.Split(strings.TrimSpace(funcDecl.Doc.Text()), "\n") {
+			op.Doc = append(op.Doc, strings.TrimSpace(line))
+		}
+	}
+	switch results := withContext.Type.Results.List; len(results) {
+	case 2:
+	case 3:
+		op.ResultType = typeString(results[0].Type, false)
+		op.QualifiedResultType = typeString(results[0].Type, true)
+	default:
+		return nil, fmt.Errorf("operation %s has unexpected results", withContext.Name.Name)
+	}
+	return op, nil
+}
+
+// typeString renders a type expression, optionally qualifying the types of the codeenginev2 package.
+func typeString(expr ast.Expr, qualify bool) string {
+	switch expr := expr.(type) {
+	case *ast.StarExpr:
+		return "*" + typeString(expr.X, qualify)
+	case *ast.ArrayType:
+		return "[]" + typeString(expr.Elt, qualify)
+	case *ast.MapType:
+		return "map[" + typeString(expr.Key, qualify) + "]" + typeString(expr.Value, qualify)
+	case *ast.InterfaceType:
+		if expr.Methods.NumFields() > 0 {
+			panic("unsupported interface type with methods")
+		}
+		return "interface{}"
+	case *ast.SelectorExpr:
+		return typeString(expr.X, false) + "." + expr.Sel.Name
+	case *ast.Ident:
+		if qualify && expr.IsExported() {
+			return "codeenginev2." + expr.Name
+		}
+		return expr.Name
+	default:
+		panic(fmt.Sprintf("unsupported type expression %T", expr))
+	}
+}
+
+func render(path string, text string, data interface{}) error {
+	tmpl, err := template.New(filepath.Base(path)).Parse(text)
+	if err != nil {
+		return err
+	}
+	var buffer bytes.Buffer
+	buffer.WriteString(header)
+	if err := tmpl.Execute(&buffer, data); err != nil {
+		return err
+	}
+	source, err := format.Source(buffer.Bytes())
+	if err != nil {
+		return fmt.Errorf("formatting %s: %w", path, err)
+	}
+	return os.WriteFile(path, source, 0644) // #nosec G306
+}
+
+const interfaceTemplate = `
+package codeenginev2
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// CodeEngineAPI : The operations of all resource groups of the Code Engine API, which are implemented by CodeEngineV2.
+type CodeEngineAPI interface {
+{{- range .}}
+	{{.Name}}API
+{{- end}}
+}
+{{range .}}
+// {{.Name}}API : The operations of the {{.Name}} resource group.
+type {{.Name}}API interface {
+{{- range $i, $operation := .Operations}}
+{{- if $i}}
+{{end}}
+{{- range $operation.Doc}}
+	// {{.}}
+{{- end}}
+	{{.Name}}({{.OptionsName}} {{.OptionsType}}) ({{if .ResultType}}result {{.ResultType}}, {{end}}response *core.DetailedResponse, err error)
+
+	// {{.Name}}WithContext is an alternate form of the {{.Name}} method which supports a Context parameter
+	{{.Name}}WithContext(ctx context.Context, {{.OptionsName}} {{.OptionsType}}) ({{if .ResultType}}result {{.ResultType}}, {{end}}response *core.DetailedResponse, err error)
+{{- end}}
+}
+{{end}}
+// Compile-time assertions that CodeEngineV2 implements the interfaces.
+var (
+	_ CodeEngineAPI = (*CodeEngineV2)(nil)
+{{- range .}}
+	_ {{.Name}}API = (*CodeEngineV2)(nil)
+{{- end}}
+)
+`
+
+const mockTemplate = `
+package codeenginev2mock
+
+import (
+	"context"
+
+	"github.com/IBM/code-engine-go-sdk/codeenginev2"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Mock : A mock of the CodeEngineAPI that records its calls and returns the results of its programmable functions.
+//
+// The result of an operation, e.g. GetApp, is programmed by setting the corresponding function, e.g. GetAppFunc. Both
+// variants of an operation, e.g. GetApp and GetAppWithContext, call the same function and are recorded under the name
+// of the operation. Operations whose function is not set return an ErrNotProgrammed error.
+type Mock struct {
+	recorder
+{{range .}}{{range .Operations}}
+	// {{.Name}}Func is called by {{.Name}} and {{.Name}}WithContext.
+	{{.Name}}Func func(ctx context.Context, {{.OptionsName}} {{.QualifiedOptionsType}}) ({{if .QualifiedResultType}}{{.QualifiedResultType}}, {{end}}*core.DetailedResponse, error)
+{{end}}{{end}}
+}
+
+// Compile-time assertions that Mock implements the interfaces.
+var (
+	_ codeenginev2.CodeEngineAPI = (*Mock)(nil)
+{{- range .}}
+	_ codeenginev2.{{.Name}}API = (*Mock)(nil)
+{{- end}}
+)
+{{range .}}{{range .Operations}}
+// {{.Name}} records the call and calls {{.Name}}Func.
+func (mock *Mock) {{.Name}}({{.OptionsName}} {{.QualifiedOptionsType}}) ({{if .QualifiedResultType}}result {{.QualifiedResultType}}, {{end}}response *core.DetailedResponse, err error) {
+	return mock.{{.Name}}WithContext(context.Background(), {{.OptionsName}})
+}
+
+// {{.Name}}WithContext records the call and calls {{.Name}}Func.
+func (mock *Mock) {{.Name}}WithContext(ctx context.Context, {{.OptionsName}} {{.QualifiedOptionsType}}) ({{if .QualifiedResultType}}result {{.QualifiedResultType}}, {{end}}response *core.DetailedResponse, err error) {
+	mock.record("{{.Name}}", ctx, {{.OptionsName}})
+	if mock.{{.Name}}Func == nil {
+		err = notProgrammed("{{.Name}}")
+		return
+	}
+	return mock.{{.Name}}Func(ctx, {{.OptionsName}})
+}
+{{end}}{{end}}
+{{- define "mounts"}}{{if .EnvVariables}}{{.Receiver}}.{{.EnvVariables}}{{else}}nil{{end}}, {{if .VolumeMounts}}{{.Receiver}}.{{.VolumeMounts}}{{else}}nil{{end}}{{end}}`
+
+const pagerTemplate = `
+package codeenginev2
+
+import (
+	"context"
+	"iter"
+)
+
+// Compile-time assertions that the pagers implement Pager.
+var (
+{{- range .}}
+	_ Pager[{{.ItemType}}] = (*{{.Name}})(nil)
+{{- end}}
+)
+{{range .}}
+// All returns an iterator over the items of the remaining pages, which are retrieved one page at a time while the
+// iteration proceeds. An error ends the iteration after it has been yielded.
+func (pager *{{.Name}}) All(ctx context.Context) iter.Seq2[{{.ItemType}}, error] {
+	return allItems[{{.ItemType}}](ctx, pager)
+}
+
+// Checkpoint returns an opaque checkpoint of the pager, which covers its options, including the page size, and the
+// start token of the next page. New{{.Name}}FromCheckpoint continues the pagination from
+// the checkpoint. The headers of the options, which may contain credentials, are not part of the checkpoint.
+func (pager *{{.Name}}) Checkpoint() (checkpoint string, err error) {
+	return encodePagerCheckpoint("{{.Name}}", pager.options, pager.pageContext.next, pager.hasNext)
+}
+
+// New{{.Name}}FromCheckpoint returns a {{.Name}} that continues the pagination of the pager that returned the
+// checkpoint with the next page.
+func (codeEngine *CodeEngineV2) New{{.Name}}FromCheckpoint(checkpoint string) (pager *{{.Name}}, err error) {
+	var options {{.OptionsType}}
+	next, hasNext, err := decodePagerCheckpoint("{{.Name}}", checkpoint, &options)
+	if err != nil {
+		return
+	}
+	pager = &{{.Name}}{
+		hasNext: hasNext,
+		options: &options,
+		client:  codeEngine,
+	}
+	pager.pageContext.next = next
+	return
+}
+{{end}}`
+
+const routeTemplate = `
+package codeenginev2
+
+// operationRoutes are the routes of the operations by their operation ID, which locate the project and resource in
+// the path of a request.
+var operationRoutes = map[string]operationRoute{
+{{- range .}}
+	"{{.Operation}}": {"{{.Path}}", {{.Paginated}}},
+{{- end}}
+}
+`
+
+const projectTemplate = `
+package codeenginev2
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+{{range .}}{{if ne .Type "ProjectClient"}}
+// {{.Type}} : The operations of the {{.Name}} resource group in a project.
+type {{.Type}} struct {
+	codeEngine *CodeEngineV2
+	projectID  string
+}
+
+// {{.Name}} returns the operations of the {{.Name}} resource group in the project.
+func (project *ProjectClient) {{.Name}}() *{{.Type}} {
+	return &{{.Type}}{codeEngine: project.codeEngine, projectID: project.projectID}
+}
+{{end}}{{$group := .}}{{range .Methods}}
+{{- range .Doc}}
+// {{.}}
+{{- end}}
+//
+// The {{.Field}} of the options is set to the ID of the project; nil options are treated as empty options.
+func ({{$group.Receiver}} *{{$group.Type}}) {{.Name}}(ctx context.Context, {{.Operation.OptionsName}} {{.Operation.OptionsType}}) ({{if .Operation.ResultType}}result {{.Operation.ResultType}}, {{end}}response *core.DetailedResponse, err error) {
+	options := {{.Options}}{}
+	if {{.Operation.OptionsName}} != nil {
+		options = *{{.Operation.OptionsName}}
+	}
+	options.{{.Field}} = core.StringPtr({{$group.Receiver}}.projectID)
+	return {{$group.Receiver}}.codeEngine.{{.Operation.Name}}WithContext(ctx, &options)
+}
+
+// New{{.Name}}Options : Instantiate {{.Options}} for the project
+func ({{$group.Receiver}} *{{$group.Type}}) New{{.Name}}Options({{range $i, $param := .Params}}{{if $i}}, {{end}}{{.Name}} {{.Type}}{{end}}) *{{.Options}} {
+	return {{$group.Receiver}}.codeEngine.New{{.Options}}({{$group.Receiver}}.projectID{{range .Params}}, {{.Name}}{{end}})
+}
+{{end}}{{range .Pagers}}
+// {{.Name}} returns a new {{.Pager}} of the project. The ProjectID of the options is set to the ID of the project; nil
+// options are treated as empty options.
+func ({{$group.Receiver}} *{{$group.Type}}) {{.Name}}(options *{{.Options}}) (pager *{{.Pager}}, err error) {
+	copied := {{.Options}}{}
+	if options != nil {
+		copied = *options
+	}
+	copied.ProjectID = core.StringPtr({{$group.Receiver}}.projectID)
+	return {{$group.Receiver}}.codeEngine.New{{.Pager}}(&copied)
+}
+{{end}}{{end}}
+{{- define "mounts"}}{{if .EnvVariables}}{{.Receiver}}.{{.EnvVariables}}{{else}}nil{{end}}, {{if .VolumeMounts}}{{.Receiver}}.{{.VolumeMounts}}{{else}}nil{{end}}{{end}}`
+
+const accessorTemplate = `
+package codeenginev2
+
+import (
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+{{range $a := .}}{{range .Getters}}
+// Get{{.}} returns the {{.}} field of the {{$a.Noun}}, or an empty string if it is not set.
+func ({{$a.Receiver}} {{$a.Type}}) Get{{.}}() string {
+	return core.StringNilMapper({{$a.Receiver}}.{{.}})
+}
+{{end}}{{if .Images}}
+// ReferencedImages returns the images that the {{.Noun}} runs or builds.
+func ({{.Receiver}} {{.Type}}) ReferencedImages() []string {
+	return referencedNames({{range $i, $field := .Images}}{{if $i}}, {{end}}{{$a.Receiver}}.{{$field}}{{end}})
+}
+{{end}}{{if .Builds}}
+// ReferencedBuilds returns the builds that the {{.Noun}} has been created from.
+func ({{.Receiver}} {{.Type}}) ReferencedBuilds() []string {
+	return referencedNames({{range $i, $field := .Builds}}{{if $i}}, {{end}}{{$a.Receiver}}.{{$field}}{{end}})
+}
+{{end}}{{if .References}}
+// ReferencedSecrets returns the secrets that the {{.Noun}} uses, with duplicates.
+func ({{.Receiver}} {{.Type}}) ReferencedSecrets() []string {
+{{- if not .Mounts}}
+	return referencedNames({{range $i, $field := .Secrets}}{{if $i}}, {{end}}{{$a.Receiver}}.{{$field}}{{end}})
+{{- else if not .Secrets}}
+	return mountedReferences({{template "mounts" .}}, EnvVar_Type_SecretFullReference, EnvVar_Type_SecretKeyReference, VolumeMount_Type_Secret)
+{{- else}}
+	return append(referencedNames({{range $i, $field := .Secrets}}{{if $i}}, {{end}}{{$a.Receiver}}.{{$field}}{{end}}),
+		mountedReferences({{template "mounts" .}}, EnvVar_Type_SecretFullReference, EnvVar_Type_SecretKeyReference, VolumeMount_Type_Secret)...)
+{{- end}}
+}
+{{end}}{{if .Mounts}}
+
+// ReferencedConfigMaps returns the config maps that the {{.Noun}} uses, with duplicates.
+func ({{.Receiver}} {{.Type}}) ReferencedConfigMaps() []string {
+	return mountedReferences({{template "mounts" .}}, EnvVar_Type_ConfigMapFullReference, EnvVar_Type_ConfigMapKeyReference, VolumeMount_Type_ConfigMap)
+}
+{{end}}{{end}}
+{{- define "mounts"}}{{if .EnvVariables}}{{.Receiver}}.{{.EnvVariables}}{{else}}nil{{end}}, {{if .VolumeMounts}}{{.Receiver}}.{{.VolumeMounts}}{{else}}nil{{end}}{{end}}`
@@ -0,0 +1,152 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package jsondiff compares resources by their JSON representation. It is shared by the Apply* helpers of the
+// codeenginev2 package and the plans of the manifest package, so that both report the same fields as changed.
+package jsondiff
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+)
+
+// Diff returns the sorted fields of the desired resource that differ from the live resource, and a merge patch that
+// sets them. The ignored fields are never reported. The exact fields must be equal, all other fields match if the
+// live value contains the desired value, since the live resource has additional fields that are set by Code Engine,
+// e.g. the default values of environment variables.
+func Diff(desired interface{}, live interface{}, ignoredFields []string, exactFields []string) (fields []string, patch map[string]interface{}, err error) {
+	desiredFields, err := ToObject(desired)
+	if err != nil {
+		return
+	}
+	liveFields, err := ToObject(live)
+	if err != nil {
+		return
+	}
+
+	patch = map[string]interface{}{}
+	for field, value := range desiredFields {
+		if contains(ignoredFields, field) {
+			continue
+		}
+		var equal bool
+		if contains(exactFields, field) {
+			equal = Equal(liveFields[field], value)
+		} else {
+			equal = Contains(liveFields[field], value)
+		}
+		if !equal {
+			fields = append(fields, field)
+			patch[field] = value
+		}
+	}
+	sort.Strings(fields)
+	return
+}
+
+// ToObject converts a value to its generic JSON representation.
+func ToObject(value interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	var result map[string]interface{}
+	err = json.Unmarshal(data, &result)
+	return result, err
+}
+
+// Contains returns true if the live JSON value contains the desired JSON value: objects contain the fields of the
+// desired object, arrays have the same length and contain the desired elements, and other values are equal. A missing
+// live value contains an empty desired array or object.
+func Contains(live interface{}, desired interface{}) bool {
+	switch desired := desired.(type) {
+	case map[string]interface{}:
+		liveMap, ok := live.(map[string]interface{})
+		if !ok {
+			return live == nil && len(desired) == 0
+		}
+		for field, value := range desired {
+			if !Contains(liveMap[field], value) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		liveSlice, ok := live.([]interface{})
+		if !ok {
+			return live == nil && len(desired) == 0
+		}
+		if len(liveSlice) != len(desired) {
+			return false
+		}
+		for i := range desired {
+			if !Contains(liveSlice[i], desired[i]) {
+				return false
+			}
+		}
+		return true
+	default:
+		return reflect.DeepEqual(live, desired)
+	}
+}
+
+// Equal returns true if the JSON values are equal, except that a missing value equals an empty array or object.
+func Equal(a interface{}, b interface{}) bool {
+	switch a := a.(type) {
+	case map[string]interface{}:
+		bMap, ok := b.(map[string]interface{})
+		if !ok {
+			return b == nil && len(a) == 0
+		}
+		if len(a) != len(bMap) {
+			return false
+		}
+		for field, value := range a {
+			if !Equal(value, bMap[field]) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		bSlice, ok := b.([]interface{})
+		if !ok {
+			return b == nil && len(a) == 0
+		}
+		if len(a) != len(bSlice) {
+			return false
+		}
+		for i := range a {
+			if !Equal(a[i], bSlice[i]) {
+				return false
+			}
+		}
+		return true
+	case nil:
+		return b == nil || Equal(b, a)
+	default:
+		return reflect.DeepEqual(a, b)
+	}
+}
+
+func contains(values []string, value string) bool {
+	for _, candidate := range values {
+		if candidate == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,61 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package jsondiff
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type resource struct {
+	Name      string            `json:"name"`
+	Arguments []string          `json:"arguments"`
+	Data      map[string]string `json:"data"`
+	Probe     map[string]int    `json:"probe"`
+}
+
+func TestDiffMatchesMissingLiveValuesWithEmptyValues(t *testing.T) {
+	desired := resource{Name: "a", Arguments: []string{}, Data: map[string]string{}, Probe: map[string]int{}}
+	live := map[string]interface{}{"name": "a"}
+	fields, patch, err := Diff(desired, live, nil, []string{"data"})
+	assert.Nil(t, err)
+	assert.Empty(t, fields)
+	assert.Empty(t, patch)
+}
+
+func TestDiffReportsChangedFields(t *testing.T) {
+	desired := resource{Name: "b", Arguments: []string{"x"}, Data: map[string]string{"k": "v"}, Probe: map[string]int{"port": 8080}}
+	live := map[string]interface{}{
+		"name":      "a",
+		"arguments": []string{"x"},
+		"data":      map[string]string{"k": "v", "extra": "v"},
+		"probe":     map[string]int{"port": 8080, "timeout": 1},
+	}
+	fields, patch, err := Diff(desired, live, []string{"name"}, []string{"data"})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"data"}, fields)
+	assert.Equal(t, map[string]interface{}{"data": map[string]interface{}{"k": "v"}}, patch)
+}
+
+func TestEqual(t *testing.T) {
+	assert.True(t, Equal(nil, []interface{}{}))
+	assert.True(t, Equal(map[string]interface{}{}, nil))
+	assert.True(t, Equal(map[string]interface{}{"a": []interface{}{"b"}}, map[string]interface{}{"a": []interface{}{"b"}}))
+	assert.False(t, Equal(map[string]interface{}{"a": "b"}, map[string]interface{}{"a": "b", "c": "d"}))
+	assert.False(t, Equal(nil, "a"))
+}
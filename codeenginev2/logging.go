@@ -0,0 +1,115 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codeenginev2
+
+import (
+	"log/slog"
+	"net/http"
+
+	common "github.com/IBM/code-engine-go-sdk/common"
+)
+
+// LoggingOptions : The options of the structured logging of the requests of a service.
+type LoggingOptions struct {
+	// The logger of the requests. Defaults to slog.Default().
+	Logger *slog.Logger
+
+	// The level of the log records. Defaults to slog.LevelDebug.
+	Level slog.Leveler
+
+	// Whether to log the JSON bodies of the requests and responses, with their sensitive values redacted.
+	LogBodies bool
+
+	// The number of bytes of a body that are logged. Defaults to common.DefaultMaxLoggedBodySize.
+	MaxBodySize int
+}
+
+// NewLoggingOptions : Instantiate LoggingOptions
+func NewLoggingOptions() *LoggingOptions {
+	return &LoggingOptions{}
+}
+
+// SetLogger : Allow user to set Logger
+func (_options *LoggingOptions) SetLogger(logger *slog.Logger) *LoggingOptions {
+	_options.Logger = logger
+	return _options
+}
+
+// SetLevel : Allow user to set Level
+func (_options *LoggingOptions) SetLevel(level slog.Leveler) *LoggingOptions {
+	_options.Level = level
+	return _options
+}
+
+// SetLogBodies : Allow user to set LogBodies
+func (_options *LoggingOptions) SetLogBodies(logBodies bool) *LoggingOptions {
+	_options.LogBodies = logBodies
+	return _options
+}
+
+// SetMaxBodySize : Allow user to set MaxBodySize
+func (_options *LoggingOptions) SetMaxBodySize(maxBodySize int) *LoggingOptions {
+	_options.MaxBodySize = maxBodySize
+	return _options
+}
+
+// EnableLogging : Log the requests and responses of the service with log/slog
+// Each attempt of a request is logged with its method, path, operation ID, e.g. `ListApps`, and headers, and its
+// response with the status code and duration. The values of sensitive headers, like the authorization header, and of
+// sensitive fields of the bodies, like the data of secrets, TLS keys, passwords, HMAC keys and inlined function code,
+// are redacted, see common.RedactHeaders and common.RedactBody. Like the rate limiter, the logging applies to the
+// service and all its clones. Unlike the logger of the core, it does not require a global log level.
+func (codeEngine *CodeEngineV2) EnableLogging(loggingOptions *LoggingOptions) {
+	if loggingOptions == nil {
+		loggingOptions = NewLoggingOptions()
+	}
+	codeEngine.setTransportLayer(rankLogging, &loggingTransport{
+		LoggingTransport: common.LoggingTransport{
+			Logger:      loggingOptions.Logger,
+			Level:       loggingOptions.Level,
+			LogBodies:   loggingOptions.LogBodies,
+			MaxBodySize: loggingOptions.MaxBodySize,
+			Operation: func(req *http.Request) string {
+				return operationOf(req).operation
+			},
+		},
+	})
+}
+
+// DisableLogging : Stop logging the requests of the service
+func (codeEngine *CodeEngineV2) DisableLogging() {
+	codeEngine.setTransportLayer(rankLogging, nil)
+}
+
+// loggingTransport logs the requests of an HTTP client.
+type loggingTransport struct {
+	common.LoggingTransport
+}
+
+func (transport *loggingTransport) rank() int {
+	return rankLogging
+}
+
+func (transport *loggingTransport) wrap(next http.RoundTripper) transportLayer {
+	wrapped := *transport
+	wrapped.Next = next
+	return &wrapped
+}
+
+func (transport *loggingTransport) unwrap() http.RoundTripper {
+	return transport.Next
+}
@@ -0,0 +1,156 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codeenginev2_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/IBM/code-engine-go-sdk/codeenginev2"
+	"github.com/IBM/code-engine-go-sdk/codeenginev2/codeenginev2test"
+	common "github.com/IBM/code-engine-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`CodeEngineV2 logging`, func() {
+	var server *codeenginev2test.Server
+	var codeEngineService *codeenginev2.CodeEngineV2
+	var projectID string
+	var output *bytes.Buffer
+	var logger *slog.Logger
+
+	// records returns the log records that have been written to the output.
+	records := func() []map[string]interface{} {
+		var result []map[string]interface{}
+		for _, line := range strings.Split(strings.TrimSpace(output.String()), "\n") {
+			if line == "" {
+				continue
+			}
+			var record map[string]interface{}
+			Expect(json.Unmarshal([]byte(line), &record)).To(Succeed())
+			result = append(result, record)
+		}
+		return result
+	}
+
+	BeforeEach(func() {
+		server = codeenginev2test.NewServer()
+		var err error
+		codeEngineService, err = codeenginev2.NewCodeEngineV2(&codeenginev2.CodeEngineV2Options{
+			URL:           server.URL,
+			Authenticator: &core.BearerTokenAuthenticator{BearerToken: "my-bearer-token"},
+		})
+		Expect(err).To(BeNil())
+		projectID = server.AddProject("my-project")
+		output = &bytes.Buffer{}
+		logger = slog.New(slog.NewJSONHandler(output, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	})
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It(`Logs the requests and responses of the operations`, func() {
+		codeEngineService.EnableLogging(codeenginev2.NewLoggingOptions().SetLogger(logger))
+		_, _, err := codeEngineService.Clone().GetConfigMap(codeEngineService.NewGetConfigMapOptions(projectID, "missing"))
+		Expect(codeenginev2.IsNotFound(err)).To(BeTrue())
+
+		logged := records()
+		Expect(logged).To(HaveLen(2))
+		Expect(logged[0]["msg"]).To(Equal("Code Engine API request"))
+		Expect(logged[0]["level"]).To(Equal("DEBUG"))
+		Expect(logged[0]["operation"]).To(Equal("GetConfigMap"))
+		Expect(logged[0]["method"]).To(Equal(http.MethodGet))
+		Expect(logged[0]["path"]).To(Equal("/projects/" + projectID + "/config_maps/missing"))
+		Expect(logged[0]["headers"]).To(HaveKeyWithValue("Authorization", common.RedactedValue))
+		Expect(logged[1]["msg"]).To(Equal("Code Engine API response"))
+		Expect(logged[1]["status"]).To(Equal(float64(http.StatusNotFound)))
+		Expect(logged[1]).To(HaveKey("duration"))
+		Expect(logged[1]).ToNot(HaveKey("body"))
+		Expect(output.String()).ToNot(ContainSubstring("my-bearer-token"))
+	})
+	It(`Redacts the sensitive values of the bodies`, func() {
+		codeEngineService.EnableLogging(codeenginev2.NewLoggingOptions().SetLogger(logger).SetLogBodies(true))
+
+		createSecretOptions := codeEngineService.NewCreateSecretOptions(projectID, codeenginev2.CreateSecretOptions_Format_Generic, "credentials")
+		genericData := &codeenginev2.SecretDataGenericSecretData{}
+		genericData.SetProperty("password", core.StringPtr("generic-value"))
+		createSecretOptions.SetData(genericData)
+		_, _, err := codeEngineService.CreateSecret(createSecretOptions)
+		Expect(err).To(BeNil())
+
+		createSecretOptions = codeEngineService.NewCreateSecretOptions(projectID, codeenginev2.CreateSecretOptions_Format_HmacAuth, "hmac")
+		createSecretOptions.SetData(&codeenginev2.SecretDataHMACAuthSecretData{
+			AccessKeyID:     core.StringPtr("hmac-access-key-id"),
+			SecretAccessKey: core.StringPtr("hmac-secret-access-key"),
+		})
+		_, _, err = codeEngineService.CreateSecret(createSecretOptions)
+		Expect(err).To(BeNil())
+
+		_, _, err = codeEngineService.CreateFunction(codeEngineService.NewCreateFunctionOptions(projectID,
+			"data:text/plain;base64,ZnVuY3Rpb24tY29kZQ==", "my-function", "nodejs-20").SetCodeBinary(true))
+		Expect(err).To(BeNil())
+
+		_, _, err = codeEngineService.CreateConfigMap(codeEngineService.NewCreateConfigMapOptions(projectID, "settings").
+			SetData(map[string]string{"color": "visible-value"}))
+		Expect(err).To(BeNil())
+
+		Expect(records()).To(HaveLen(8))
+		Expect(output.String()).To(ContainSubstring(common.RedactedValue))
+		Expect(output.String()).To(ContainSubstring("visible-value"))
+		Expect(output.String()).To(ContainSubstring(`\"name\":\"credentials\"`))
+		for _, secret := range []string{"generic-value", "hmac-access-key-id", "hmac-secret-access-key", "ZnVuY3Rpb24tY29kZQ==", "my-bearer-token"} {
+			Expect(output.String()).ToNot(ContainSubstring(secret))
+		}
+	})
+	It(`Logs the attempts of retried requests`, func() {
+		codeEngineService.EnableLogging(codeenginev2.NewLoggingOptions().SetLogger(logger).SetLogBodies(true).SetMaxBodySize(16))
+		codeEngineService.EnableRetries(2, 10*time.Millisecond)
+		server.FailNext(http.MethodPost, "/projects/"+projectID+"/config_maps", http.StatusServiceUnavailable, http.Header{"Retry-After": {"0"}})
+
+		_, _, err := codeEngineService.CreateConfigMap(codeEngineService.NewCreateConfigMapOptions(projectID, "settings"))
+		Expect(err).To(BeNil())
+
+		logged := records()
+		Expect(logged).To(HaveLen(4))
+		Expect(logged[1]["status"]).To(Equal(float64(http.StatusServiceUnavailable)))
+		Expect(logged[2]["body"]).To(Equal(`{"name":"settings"}`[:16] + "..."))
+		Expect(logged[3]["status"]).To(Equal(float64(http.StatusCreated)))
+	})
+	It(`Logs at the configured level`, func() {
+		logger = slog.New(slog.NewJSONHandler(output, &slog.HandlerOptions{Level: slog.LevelInfo}))
+		codeEngineService.EnableLogging(codeenginev2.NewLoggingOptions().SetLogger(logger))
+		_, _, err := codeEngineService.ListConfigMaps(codeEngineService.NewListConfigMapsOptions(projectID))
+		Expect(err).To(BeNil())
+		Expect(records()).To(BeEmpty())
+
+		codeEngineService.EnableLogging(codeenginev2.NewLoggingOptions().SetLogger(logger).SetLevel(slog.LevelInfo))
+		_, _, err = codeEngineService.ListConfigMaps(codeEngineService.NewListConfigMapsOptions(projectID))
+		Expect(err).To(BeNil())
+		Expect(records()).To(HaveLen(2))
+
+		codeEngineService.DisableLogging()
+		_, _, err = codeEngineService.ListConfigMaps(codeEngineService.NewListConfigMapsOptions(projectID))
+		Expect(err).To(BeNil())
+		Expect(records()).To(HaveLen(2))
+	})
+})
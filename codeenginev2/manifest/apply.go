@@ -0,0 +1,85 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package manifest
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/IBM/code-engine-go-sdk/codeenginev2"
+	common "github.com/IBM/code-engine-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// deletionPollInterval is the interval at which a recreated resource is polled until its deletion completed.
+const deletionPollInterval = 2 * time.Second
+
+// ApplyPlan applies the changes of the plan in order and returns the changes that have been applied.
+//
+// Updates and replacements send the entity tag of the live resource that was read by CreatePlan as `If-Match`
+// header, so that a resource that has been modified since the plan was created is not overwritten. In that case the
+// API responds with status code 412 and a new plan must be created. Apply stops at the first change that fails.
+func ApplyPlan(ctx context.Context, codeEngine *codeenginev2.CodeEngineV2, plan *Plan) (applied []*Change, err error) {
+	err = core.ValidateNotNil(plan, "plan cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+
+	for _, change := range plan.Changes {
+		kind := kindOf(change.Kind)
+		if kind == nil {
+			err = core.SDKErrorf(nil, fmt.Sprintf("unsupported kind '%s'", change.Kind), "unsupported-kind", common.GetComponentInfo())
+			return
+		}
+		if err = kind.apply(ctx, codeEngine, plan.ProjectID, change, plan.headers); err != nil {
+			err = core.SDKErrorf(err, fmt.Sprintf("failed to %s %s '%s': %s", change.Action, change.Kind, change.Name, err.Error()), "apply-error", common.GetComponentInfo())
+			return
+		}
+		applied = append(applied, change)
+	}
+	return
+}
+
+// kindOf returns the resource kind with the specified name.
+func kindOf(name Kind) resourceKind {
+	for _, kind := range kinds {
+		if kind.kind() == name {
+			return kind
+		}
+	}
+	return nil
+}
+
+// waitForDeletion polls the resources of a kind until the resource with the specified key no longer exists.
+func waitForDeletion(ctx context.Context, codeEngine *codeenginev2.CodeEngineV2, kind resourceKind, projectID string, key string, headers map[string]string) error {
+	for {
+		live, err := kind.list(ctx, codeEngine, projectID, headers)
+		if err != nil {
+			return err
+		}
+		if _, found := live[key]; !found {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(deletionPollInterval):
+		}
+	}
+}
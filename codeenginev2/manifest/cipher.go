@@ -0,0 +1,114 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package manifest
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	common "github.com/IBM/code-engine-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// encryptedValuePrefix marks the secret values that have been encrypted by the AES-GCM cipher.
+const encryptedValuePrefix = "aes-gcm:"
+
+// SecretCipher encrypts the values of secrets in exported manifests and decrypts them before they are applied.
+// Encrypted values must carry the `aes-gcm:` prefix, by which DecryptSecrets and CreatePlan recognize them. The
+// additional data identifies the value, see SecretValueAdditionalData; a value must only decrypt with the additional
+// data it has been encrypted with, so that it cannot be moved to another secret or key.
+type SecretCipher interface {
+	Encrypt(plaintext string, additionalData []byte) (ciphertext string, err error)
+	Decrypt(ciphertext string, additionalData []byte) (plaintext string, err error)
+}
+
+// SecretValueAdditionalData returns the additional data that binds an encrypted value to the key of the secret.
+func SecretValueAdditionalData(secretName string, key string) []byte {
+	additionalData, _ := json.Marshal([]string{"secret", secretName, key})
+	return additionalData
+}
+
+// aesGCMCipher is a SecretCipher that uses AES in Galois/Counter Mode with a random nonce per value.
+type aesGCMCipher struct {
+	aead cipher.AEAD
+}
+
+// NewAESGCMCipher returns a SecretCipher that encrypts values with AES-GCM. The key must be 16, 24 or 32 bytes long.
+// Encrypted values are rendered as `aes-gcm:<base64 of nonce and ciphertext>`.
+func NewAESGCMCipher(key []byte) (SecretCipher, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, core.SDKErrorf(err, "", "invalid-cipher-key", common.GetComponentInfo())
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, core.SDKErrorf(err, "", "invalid-cipher-key", common.GetComponentInfo())
+	}
+	return &aesGCMCipher{aead: aead}, nil
+}
+
+// Encrypt encrypts the plaintext with a random nonce and authenticates it along with the additional data.
+func (c *aesGCMCipher) Encrypt(plaintext string, additionalData []byte) (string, error) {
+	nonce := make([]byte, c.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	sealed := c.aead.Seal(nonce, nonce, []byte(plaintext), additionalData)
+	return encryptedValuePrefix + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// Decrypt decrypts a value that has been encrypted by Encrypt with the same additional data.
+func (c *aesGCMCipher) Decrypt(ciphertext string, additionalData []byte) (string, error) {
+	if !strings.HasPrefix(ciphertext, encryptedValuePrefix) {
+		return "", fmt.Errorf("the value is not encrypted")
+	}
+	sealed, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(ciphertext, encryptedValuePrefix))
+	if err != nil {
+		return "", err
+	}
+	if len(sealed) < c.aead.NonceSize() {
+		return "", fmt.Errorf("the encrypted value is too short")
+	}
+	plaintext, err := c.aead.Open(nil, sealed[:c.aead.NonceSize()], sealed[c.aead.NonceSize():], additionalData)
+	if err != nil {
+		return "", err
+	}
+	return string(plaintext), nil
+}
+
+// DecryptSecrets decrypts the encrypted values of all secrets of the manifest in place. Values without the `aes-gcm:`
+// prefix, which have not been encrypted, are left unchanged.
+func (manifest *Manifest) DecryptSecrets(secretCipher SecretCipher) error {
+	for _, secret := range manifest.Secrets {
+		for key, value := range secret.Data {
+			if !strings.HasPrefix(value, encryptedValuePrefix) {
+				continue
+			}
+			plaintext, err := secretCipher.Decrypt(value, SecretValueAdditionalData(core.StringNilMapper(secret.Name), key))
+			if err != nil {
+				return core.SDKErrorf(err, fmt.Sprintf("failed to decrypt key '%s' of secret '%s': %s", key, core.StringNilMapper(secret.Name), err.Error()), "decrypt-error", common.GetComponentInfo())
+			}
+			secret.Data[key] = plaintext
+		}
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package manifest
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM/code-engine-go-sdk/codeenginev2"
+	common "github.com/IBM/code-engine-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// RedactedValue replaces the values of secrets in manifests that are exported without a SecretCipher.
+const RedactedValue = "<redacted>"
+
+// ExportOptions : The Export options.
+type ExportOptions struct {
+	// The ID of the project.
+	ProjectID *string `validate:"required,ne="`
+
+	// Encrypts the values of secrets. If not set, the values of secrets are replaced by RedactedValue.
+	SecretCipher SecretCipher
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewExportOptions : Instantiate ExportOptions
+func NewExportOptions(projectID string) *ExportOptions {
+	return &ExportOptions{
+		ProjectID: core.StringPtr(projectID),
+	}
+}
+
+// SetProjectID : Allow user to set ProjectID
+func (_options *ExportOptions) SetProjectID(projectID string) *ExportOptions {
+	_options.ProjectID = core.StringPtr(projectID)
+	return _options
+}
+
+// SetSecretCipher : Allow user to set SecretCipher
+func (_options *ExportOptions) SetSecretCipher(secretCipher SecretCipher) *ExportOptions {
+	_options.SecretCipher = secretCipher
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *ExportOptions) SetHeaders(param map[string]string) *ExportOptions {
+	options.Headers = param
+	return options
+}
+
+// Export reads all resources of the project through the `List*` pagers and returns them as a manifest, which can be
+// rendered with Marshal and applied to the same or another project.
+//
+// Only user-settable fields are exported; server-owned fields like the ID, href, creation time, status and computed
+// environment variables are dropped. Resources that Code Engine manages itself, such as system generated secrets and
+// domain mappings, are skipped. Resources are sorted by name. The values of secrets are redacted unless a SecretCipher
+// is set, and the data of service access and service operator secrets, which Code Engine generates, is dropped.
+func Export(ctx context.Context, codeEngine *codeenginev2.CodeEngineV2, exportOptions *ExportOptions) (manifest *Manifest, err error) {
+	err = core.ValidateNotNil(exportOptions, "exportOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(exportOptions, "exportOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	projectID, err := codeEngine.ResolveProjectID(ctx, *exportOptions.ProjectID)
+	if err != nil {
+		return
+	}
+
+	result := new(Manifest)
+	for _, kind := range kinds {
+		err = kind.export(ctx, codeEngine, projectID, result, exportOptions.Headers)
+		if err != nil {
+			err = core.SDKErrorf(err, "", "export-error", common.GetComponentInfo())
+			return
+		}
+	}
+
+	for i := range result.Secrets {
+		secret := &result.Secrets[i]
+		switch core.StringNilMapper(secret.Format) {
+		case codeenginev2.Secret_Format_ServiceAccess, codeenginev2.Secret_Format_ServiceOperator:
+			secret.Data = nil
+			continue
+		}
+		for key, value := range secret.Data {
+			if exportOptions.SecretCipher == nil {
+				secret.Data[key] = RedactedValue
+				continue
+			}
+			secret.Data[key], err = exportOptions.SecretCipher.Encrypt(value, SecretValueAdditionalData(*secret.Name, key))
+			if err != nil {
+				err = core.SDKErrorf(err, fmt.Sprintf("failed to encrypt key '%s' of secret '%s': %s", key, *secret.Name, err.Error()), "encrypt-error", common.GetComponentInfo())
+				return
+			}
+		}
+	}
+	manifest = result
+	return
+}
@@ -0,0 +1,168 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package manifest_test
+
+import (
+	"context"
+	"strings"
+
+	"github.com/IBM/code-engine-go-sdk/codeenginev2"
+	"github.com/IBM/code-engine-go-sdk/codeenginev2/manifest"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Export`, func() {
+	projectID := "15314cc3-85b4-4338-903f-c28cdee6d005"
+	var server *projectServer
+
+	newService := func() *codeenginev2.CodeEngineV2 {
+		codeEngineService, serviceErr := codeenginev2.NewCodeEngineV2(&codeenginev2.CodeEngineV2Options{
+			URL:           server.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		return codeEngineService
+	}
+
+	BeforeEach(func() {
+		server = newProjectServer(projectID, map[string]string{
+			"apps": `[
+				{"name": "z-app", "id": "a2", "href": "https://api/apps/z-app", "created_at": "2026-01-01T00:00:00Z",
+				 "entity_tag": "9", "status": "ready", "image_reference": "icr.io/ns/z:1", "endpoint": "https://z-app.example"},
+				{"name": "a-app", "id": "a1", "entity_tag": "3", "status": "deploying", "image_reference": "icr.io/ns/a:1",
+				 "scale_min_instances": 1, "run_env_variables": [{"type": "literal", "name": "LEVEL", "value": "info"}],
+				 "computed_env_variables": [{"type": "literal", "name": "CE_APP", "value": "a-app"}]}
+			]`,
+			"secrets": `[
+				{"name": "ce-auto-icr-private-us-south", "format": "registry", "generated_by": "system", "data": {"password": "apikey"}},
+				{"name": "credentials", "format": "generic", "generated_by": "user", "data": {"user": "admin", "password": "s3cr3t"}},
+				{"name": "cos-access", "format": "service_access", "generated_by": "user", "data": {"apikey": "generated"},
+				 "service_access": {"resource_key": {"id": "rk-1", "name": "key"}, "service_instance": {"id": "si-1", "type": "cos"}}}
+			]`,
+			"domain_mappings": `[
+				{"name": "a-app.abc.us-south.codeengine.appdomain.cloud", "user_managed": false,
+				 "component": {"name": "a-app", "resource_type": "app_v2"}, "tls_secret": "ce-auto-tls"}
+			]`,
+			"allowed_outbound_destinations": `[
+				{"name": "internal", "type": "cidr_block", "cidr_block": "10.0.0.0/8", "entity_tag": "1", "status": "ready"}
+			]`,
+		})
+	})
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It(`Exports the user-settable fields of all resources to a stable YAML document`, func() {
+		exported, err := manifest.Export(context.Background(), newService(), manifest.NewExportOptions(projectID))
+		Expect(err).To(BeNil())
+		document, err := exported.Marshal()
+		Expect(err).To(BeNil())
+		Expect(string(document)).To(Equal(strings.TrimLeft(`
+allowed_outbound_destinations:
+- cidr_block: 10.0.0.0/8
+  name: internal
+  type: cidr_block
+apps:
+- image_reference: icr.io/ns/a:1
+  name: a-app
+  run_env_variables:
+  - name: LEVEL
+    type: literal
+    value: info
+  scale_min_instances: 1
+- image_reference: icr.io/ns/z:1
+  name: z-app
+secrets:
+- format: service_access
+  name: cos-access
+  service_access:
+    resource_key:
+      id: rk-1
+    service_instance:
+      id: si-1
+- data:
+    password: <redacted>
+    user: <redacted>
+  format: generic
+  name: credentials
+`, "\n")))
+
+		again, err := manifest.Export(context.Background(), newService(), manifest.NewExportOptions(projectID))
+		Expect(err).To(BeNil())
+		Expect(again.Marshal()).To(Equal(document))
+	})
+	It(`Encrypts secret values that can be decrypted after parsing`, func() {
+		secretCipher, err := manifest.NewAESGCMCipher([]byte("0123456789abcdef0123456789abcdef"))
+		Expect(err).To(BeNil())
+		exported, err := manifest.Export(context.Background(), newService(), manifest.NewExportOptions(projectID).SetSecretCipher(secretCipher))
+		Expect(err).To(BeNil())
+		Expect(exported.Secrets[1].Data["password"]).To(HavePrefix("aes-gcm:"))
+		Expect(exported.Secrets[1].Data["password"]).ToNot(ContainSubstring("s3cr3t"))
+
+		document, err := exported.Marshal()
+		Expect(err).To(BeNil())
+		parsed, err := manifest.Parse(document)
+		Expect(err).To(BeNil())
+
+		_, err = manifest.CreatePlan(context.Background(), newService(), manifest.NewPlanOptions(projectID, parsed))
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(Equal("key 'password' of secret 'credentials' is redacted or encrypted"))
+
+		Expect(parsed.DecryptSecrets(secretCipher)).To(Succeed())
+		Expect(parsed.Secrets[1].Data).To(Equal(map[string]string{"user": "admin", "password": "s3cr3t"}))
+
+		plan, err := manifest.CreatePlan(context.Background(), newService(), manifest.NewPlanOptions(projectID, parsed))
+		Expect(err).To(BeNil())
+		Expect(plan.HasChanges()).To(BeFalse())
+	})
+	It(`Leaves secret values that are not encrypted unchanged when decrypting`, func() {
+		secretCipher, err := manifest.NewAESGCMCipher([]byte("0123456789abcdef0123456789abcdef"))
+		Expect(err).To(BeNil())
+		encrypted, err := secretCipher.Encrypt("s3cr3t", manifest.SecretValueAdditionalData("credentials", "password"))
+		Expect(err).To(BeNil())
+
+		mixed := &manifest.Manifest{
+			Secrets: []manifest.Secret{{Name: core.StringPtr("credentials"), Data: map[string]string{"user": "admin", "password": encrypted}}},
+		}
+		Expect(mixed.DecryptSecrets(secretCipher)).To(Succeed())
+		Expect(mixed.Secrets[0].Data).To(Equal(map[string]string{"user": "admin", "password": "s3cr3t"}))
+	})
+	It(`Rejects encrypted values that have been moved to another secret or key`, func() {
+		secretCipher, err := manifest.NewAESGCMCipher([]byte("0123456789abcdef0123456789abcdef"))
+		Expect(err).To(BeNil())
+		encrypted, err := secretCipher.Encrypt("s3cr3t", manifest.SecretValueAdditionalData("credentials", "password"))
+		Expect(err).To(BeNil())
+
+		movedKey := &manifest.Manifest{
+			Secrets: []manifest.Secret{{Name: core.StringPtr("credentials"), Data: map[string]string{"token": encrypted}}},
+		}
+		err = movedKey.DecryptSecrets(secretCipher)
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("failed to decrypt key 'token' of secret 'credentials'"))
+
+		movedSecret := &manifest.Manifest{
+			Secrets: []manifest.Secret{{Name: core.StringPtr("other"), Data: map[string]string{"password": encrypted}}},
+		}
+		Expect(movedSecret.DecryptSecrets(secretCipher)).ToNot(Succeed())
+	})
+	It(`Rejects invalid cipher keys`, func() {
+		_, err := manifest.NewAESGCMCipher([]byte("short"))
+		Expect(err).ToNot(BeNil())
+	})
+})
@@ -0,0 +1,423 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package manifest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/IBM/code-engine-go-sdk/codeenginev2"
+	"github.com/IBM/code-engine-go-sdk/codeenginev2/internal/jsondiff"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// listPageLimit is the page size used to read the live state of a project.
+const listPageLimit = 100
+
+// kinds lists the supported kinds of resources in dependency order: resources are created before the resources
+// that may reference them.
+var kinds = []resourceKind{
+	&resourceOps[AllowedOutboundDestination, codeenginev2.AllowedOutboundDestinationIntf]{
+		name:       KindAllowedOutboundDestination,
+		resources:  func(manifest *Manifest) *[]AllowedOutboundDestination { return &manifest.AllowedOutboundDestinations },
+		desiredKey: func(destination AllowedOutboundDestination) string { return core.StringNilMapper(destination.Name) },
+		liveKey: func(destination codeenginev2.AllowedOutboundDestinationIntf) string {
+			return stringField(destination, "name")
+		},
+		entityTag: func(destination codeenginev2.AllowedOutboundDestinationIntf) string {
+			return stringField(destination, "entity_tag")
+		},
+		immutableFields: []string{"type"},
+		listAll: func(ctx context.Context, codeEngine *codeenginev2.CodeEngineV2, projectID string, headers map[string]string) ([]codeenginev2.AllowedOutboundDestinationIntf, error) {
+			pager, err := codeEngine.NewAllowedOutboundDestinationsPager(codeEngine.NewListAllowedOutboundDestinationsOptions(projectID).SetLimit(listPageLimit).SetHeaders(headers))
+			if err != nil {
+				return nil, err
+			}
+			return pager.GetAllWithContext(ctx)
+		},
+		create: func(ctx context.Context, codeEngine *codeenginev2.CodeEngineV2, projectID string, destination AllowedOutboundDestination, headers map[string]string) error {
+			prototype := new(codeenginev2.AllowedOutboundDestinationPrototype)
+			if err := convert(destination, prototype); err != nil {
+				return err
+			}
+			_, _, err := codeEngine.CreateAllowedOutboundDestinationWithContext(ctx, codeEngine.NewCreateAllowedOutboundDestinationOptions(projectID, prototype).SetHeaders(headers))
+			return err
+		},
+		update: func(ctx context.Context, codeEngine *codeenginev2.CodeEngineV2, projectID string, change *Change, _ AllowedOutboundDestination, _ codeenginev2.AllowedOutboundDestinationIntf, headers map[string]string) error {
+			_, _, err := codeEngine.UpdateAllowedOutboundDestinationWithContext(ctx, codeEngine.NewUpdateAllowedOutboundDestinationOptions(projectID, change.Name, change.entityTag, change.patch).SetHeaders(headers))
+			return err
+		},
+		delete: func(ctx context.Context, codeEngine *codeenginev2.CodeEngineV2, projectID string, destination codeenginev2.AllowedOutboundDestinationIntf, headers map[string]string) error {
+			_, err := codeEngine.DeleteAllowedOutboundDestinationWithContext(ctx, codeEngine.NewDeleteAllowedOutboundDestinationOptions(projectID, stringField(destination, "name")).SetHeaders(headers))
+			return err
+		},
+	},
+	&resourceOps[ConfigMap, codeenginev2.ConfigMap]{
+		name:        KindConfigMap,
+		resources:   func(manifest *Manifest) *[]ConfigMap { return &manifest.ConfigMaps },
+		desiredKey:  func(configMap ConfigMap) string { return core.StringNilMapper(configMap.Name) },
+		liveKey:     func(configMap codeenginev2.ConfigMap) string { return core.StringNilMapper(configMap.Name) },
+		entityTag:   func(configMap codeenginev2.ConfigMap) string { return core.StringNilMapper(configMap.EntityTag) },
+		exactFields: []string{"data"},
+		listAll: func(ctx context.Context, codeEngine *codeenginev2.CodeEngineV2, projectID string, headers map[string]string) ([]codeenginev2.ConfigMap, error) {
+			pager, err := codeEngine.NewConfigMapsPager(codeEngine.NewListConfigMapsOptions(projectID).SetLimit(listPageLimit).SetHeaders(headers))
+			if err != nil {
+				return nil, err
+			}
+			return pager.GetAllWithContext(ctx)
+		},
+		create: func(ctx context.Context, codeEngine *codeenginev2.CodeEngineV2, projectID string, configMap ConfigMap, headers map[string]string) error {
+			createConfigMapOptions := &codeenginev2.CreateConfigMapOptions{ProjectID: &projectID, Headers: headers}
+			if err := convert(configMap, createConfigMapOptions); err != nil {
+				return err
+			}
+			_, _, err := codeEngine.CreateConfigMapWithContext(ctx, createConfigMapOptions)
+			return err
+		},
+		update: func(ctx context.Context, codeEngine *codeenginev2.CodeEngineV2, projectID string, change *Change, configMap ConfigMap, _ codeenginev2.ConfigMap, headers map[string]string) error {
+			replaceConfigMapOptions := codeEngine.NewReplaceConfigMapOptions(projectID, change.Name, change.entityTag)
+			replaceConfigMapOptions.SetData(configMap.Data).SetHeaders(headers)
+			_, _, err := codeEngine.ReplaceConfigMapWithContext(ctx, replaceConfigMapOptions)
+			return err
+		},
+		delete: func(ctx context.Context, codeEngine *codeenginev2.CodeEngineV2, projectID string, configMap codeenginev2.ConfigMap, headers map[string]string) error {
+			_, err := codeEngine.DeleteConfigMapWithContext(ctx, codeEngine.NewDeleteConfigMapOptions(projectID, *configMap.Name).SetHeaders(headers))
+			return err
+		},
+	},
+	&resourceOps[Secret, codeenginev2.Secret]{
+		name:       KindSecret,
+		resources:  func(manifest *Manifest) *[]Secret { return &manifest.Secrets },
+		desiredKey: func(secret Secret) string { return core.StringNilMapper(secret.Name) },
+		liveKey:    func(secret codeenginev2.Secret) string { return core.StringNilMapper(secret.Name) },
+		entityTag:  func(secret codeenginev2.Secret) string { return core.StringNilMapper(secret.EntityTag) },
+		systemManaged: func(secret codeenginev2.Secret) bool {
+			return core.StringNilMapper(secret.GeneratedBy) == codeenginev2.Secret_GeneratedBy_System
+		},
+		immutableFields: []string{"format", "service_access", "service_operator"},
+		exactFields:     []string{"data"},
+		listAll: func(ctx context.Context, codeEngine *codeenginev2.CodeEngineV2, projectID string, headers map[string]string) ([]codeenginev2.Secret, error) {
+			pager, err := codeEngine.NewSecretsPager(codeEngine.NewListSecretsOptions(projectID).SetLimit(listPageLimit).SetHeaders(headers))
+			if err != nil {
+				return nil, err
+			}
+			return pager.GetAllWithContext(ctx)
+		},
+		create: func(ctx context.Context, codeEngine *codeenginev2.CodeEngineV2, projectID string, secret Secret, headers map[string]string) error {
+			createSecretOptions := codeEngine.NewCreateSecretOptions(projectID, core.StringNilMapper(secret.Format), core.StringNilMapper(secret.Name))
+			if secret.Data != nil {
+				data, err := secretData(core.StringNilMapper(secret.Format), secret.Data)
+				if err != nil {
+					return err
+				}
+				createSecretOptions.SetData(data)
+			}
+			createSecretOptions.ServiceAccess = secret.ServiceAccess
+			createSecretOptions.ServiceOperator = secret.ServiceOperator
+			createSecretOptions.SetHeaders(headers)
+			_, _, err := codeEngine.CreateSecretWithContext(ctx, createSecretOptions)
+			return err
+		},
+		update: func(ctx context.Context, codeEngine *codeenginev2.CodeEngineV2, projectID string, change *Change, secret Secret, live codeenginev2.Secret, headers map[string]string) error {
+			replaceSecretOptions := codeEngine.NewReplaceSecretOptions(projectID, change.Name, change.entityTag, core.StringNilMapper(live.Format))
+			data, err := secretData(core.StringNilMapper(live.Format), secret.Data)
+			if err != nil {
+				return err
+			}
+			replaceSecretOptions.SetData(data).SetHeaders(headers)
+			_, _, err = codeEngine.ReplaceSecretWithContext(ctx, replaceSecretOptions)
+			return err
+		},
+		delete: func(ctx context.Context, codeEngine *codeenginev2.CodeEngineV2, projectID string, secret codeenginev2.Secret, headers map[string]string) error {
+			_, err := codeEngine.DeleteSecretWithContext(ctx, codeEngine.NewDeleteSecretOptions(projectID, *secret.Name).SetHeaders(headers))
+			return err
+		},
+	},
+	&resourceOps[PersistentDataStore, codeenginev2.PersistentDataStore]{
+		name:        KindPersistentDataStore,
+		resources:   func(manifest *Manifest) *[]PersistentDataStore { return &manifest.PersistentDataStores },
+		desiredKey:  func(store PersistentDataStore) string { return core.StringNilMapper(store.Name) },
+		liveKey:     func(store codeenginev2.PersistentDataStore) string { return core.StringNilMapper(store.Name) },
+		entityTag:   func(store codeenginev2.PersistentDataStore) string { return core.StringNilMapper(store.EntityTag) },
+		exactFields: []string{"data"},
+		listAll: func(ctx context.Context, codeEngine *codeenginev2.CodeEngineV2, projectID string, headers map[string]string) ([]codeenginev2.PersistentDataStore, error) {
+			pager, err := codeEngine.NewPersistentDataStoresPager(codeEngine.NewListPersistentDataStoresOptions(projectID).SetLimit(listPageLimit).SetHeaders(headers))
+			if err != nil {
+				return nil, err
+			}
+			return pager.GetAllWithContext(ctx)
+		},
+		create: func(ctx context.Context, codeEngine *codeenginev2.CodeEngineV2, projectID string, store PersistentDataStore, headers map[string]string) error {
+			createPersistentDataStoreOptions := codeEngine.NewCreatePersistentDataStoreOptions(projectID, core.StringNilMapper(store.Name), core.StringNilMapper(store.StorageType))
+			if store.Data != nil {
+				data := new(codeenginev2.StorageData)
+				data.SetProperties(stringPtrMap(store.Data))
+				createPersistentDataStoreOptions.SetData(data)
+			}
+			createPersistentDataStoreOptions.SetHeaders(headers)
+			_, _, err := codeEngine.CreatePersistentDataStoreWithContext(ctx, createPersistentDataStoreOptions)
+			return err
+		},
+		delete: func(ctx context.Context, codeEngine *codeenginev2.CodeEngineV2, projectID string, store codeenginev2.PersistentDataStore, headers map[string]string) error {
+			_, err := codeEngine.DeletePersistentDataStoreWithContext(ctx, codeEngine.NewDeletePersistentDataStoreOptions(projectID, *store.Name).SetHeaders(headers))
+			return err
+		},
+	},
+	&resourceOps[Build, codeenginev2.Build]{
+		name:       KindBuild,
+		resources:  func(manifest *Manifest) *[]Build { return &manifest.Builds },
+		desiredKey: func(build Build) string { return core.StringNilMapper(build.Name) },
+		liveKey:    func(build codeenginev2.Build) string { return core.StringNilMapper(build.Name) },
+		entityTag:  func(build codeenginev2.Build) string { return core.StringNilMapper(build.EntityTag) },
+		listAll: func(ctx context.Context, codeEngine *codeenginev2.CodeEngineV2, projectID string, headers map[string]string) ([]codeenginev2.Build, error) {
+			pager, err := codeEngine.NewBuildsPager(codeEngine.NewListBuildsOptions(projectID).SetLimit(listPageLimit).SetHeaders(headers))
+			if err != nil {
+				return nil, err
+			}
+			return pager.GetAllWithContext(ctx)
+		},
+		create: func(ctx context.Context, codeEngine *codeenginev2.CodeEngineV2, projectID string, build Build, headers map[string]string) error {
+			createBuildOptions := &codeenginev2.CreateBuildOptions{ProjectID: &projectID, Headers: headers}
+			if err := convert(build, createBuildOptions); err != nil {
+				return err
+			}
+			_, _, err := codeEngine.CreateBuildWithContext(ctx, createBuildOptions)
+			return err
+		},
+		update: func(ctx context.Context, codeEngine *codeenginev2.CodeEngineV2, projectID string, change *Change, _ Build, _ codeenginev2.Build, headers map[string]string) error {
+			_, _, err := codeEngine.UpdateBuildWithContext(ctx, codeEngine.NewUpdateBuildOptions(projectID, change.Name, change.entityTag, change.patch).SetHeaders(headers))
+			return err
+		},
+		delete: func(ctx context.Context, codeEngine *codeenginev2.CodeEngineV2, projectID string, build codeenginev2.Build, headers map[string]string) error {
+			_, err := codeEngine.DeleteBuildWithContext(ctx, codeEngine.NewDeleteBuildOptions(projectID, *build.Name).SetHeaders(headers))
+			return err
+		},
+	},
+	&resourceOps[App, codeenginev2.App]{
+		name:       KindApp,
+		resources:  func(manifest *Manifest) *[]App { return &manifest.Apps },
+		desiredKey: func(app App) string { return core.StringNilMapper(app.Name) },
+		liveKey:    func(app codeenginev2.App) string { return core.StringNilMapper(app.Name) },
+		entityTag:  func(app codeenginev2.App) string { return core.StringNilMapper(app.EntityTag) },
+		listAll: func(ctx context.Context, codeEngine *codeenginev2.CodeEngineV2, projectID string, headers map[string]string) ([]codeenginev2.App, error) {
+			pager, err := codeEngine.NewAppsPager(codeEngine.NewListAppsOptions(projectID).SetLimit(listPageLimit).SetHeaders(headers))
+			if err != nil {
+				return nil, err
+			}
+			return pager.GetAllWithContext(ctx)
+		},
+		create: func(ctx context.Context, codeEngine *codeenginev2.CodeEngineV2, projectID string, app App, headers map[string]string) error {
+			createAppOptions := &codeenginev2.CreateAppOptions{ProjectID: &projectID, Headers: headers}
+			if err := convert(app, createAppOptions); err != nil {
+				return err
+			}
+			_, _, err := codeEngine.CreateAppWithContext(ctx, createAppOptions)
+			return err
+		},
+		update: func(ctx context.Context, codeEngine *codeenginev2.CodeEngineV2, projectID string, change *Change, _ App, _ codeenginev2.App, headers map[string]string) error {
+			_, _, err := codeEngine.UpdateAppWithContext(ctx, codeEngine.NewUpdateAppOptions(projectID, change.Name, change.entityTag, change.patch).SetHeaders(headers))
+			return err
+		},
+		delete: func(ctx context.Context, codeEngine *codeenginev2.CodeEngineV2, projectID string, app codeenginev2.App, headers map[string]string) error {
+			_, err := codeEngine.DeleteAppWithContext(ctx, codeEngine.NewDeleteAppOptions(projectID, *app.Name).SetHeaders(headers))
+			return err
+		},
+	},
+	&resourceOps[Job, codeenginev2.Job]{
+		name:       KindJob,
+		resources:  func(manifest *Manifest) *[]Job { return &manifest.Jobs },
+		desiredKey: func(job Job) string { return core.StringNilMapper(job.Name) },
+		liveKey:    func(job codeenginev2.Job) string { return core.StringNilMapper(job.Name) },
+		entityTag:  func(job codeenginev2.Job) string { return core.StringNilMapper(job.EntityTag) },
+		listAll: func(ctx context.Context, codeEngine *codeenginev2.CodeEngineV2, projectID string, headers map[string]string) ([]codeenginev2.Job, error) {
+			pager, err := codeEngine.NewJobsPager(codeEngine.NewListJobsOptions(projectID).SetLimit(listPageLimit).SetHeaders(headers))
+			if err != nil {
+				return nil, err
+			}
+			return pager.GetAllWithContext(ctx)
+		},
+		create: func(ctx context.Context, codeEngine *codeenginev2.CodeEngineV2, projectID string, job Job, headers map[string]string) error {
+			createJobOptions := &codeenginev2.CreateJobOptions{ProjectID: &projectID, Headers: headers}
+			if err := convert(job, createJobOptions); err != nil {
+				return err
+			}
+			_, _, err := codeEngine.CreateJobWithContext(ctx, createJobOptions)
+			return err
+		},
+		update: func(ctx context.Context, codeEngine *codeenginev2.CodeEngineV2, projectID string, change *Change, _ Job, _ codeenginev2.Job, headers map[string]string) error {
+			_, _, err := codeEngine.UpdateJobWithContext(ctx, codeEngine.NewUpdateJobOptions(projectID, change.Name, change.entityTag, change.patch).SetHeaders(headers))
+			return err
+		},
+		delete: func(ctx context.Context, codeEngine *codeenginev2.CodeEngineV2, projectID string, job codeenginev2.Job, headers map[string]string) error {
+			_, err := codeEngine.DeleteJobWithContext(ctx, codeEngine.NewDeleteJobOptions(projectID, *job.Name).SetHeaders(headers))
+			return err
+		},
+	},
+	&resourceOps[Function, codeenginev2.Function]{
+		name:       KindFunction,
+		resources:  func(manifest *Manifest) *[]Function { return &manifest.Functions },
+		desiredKey: func(function Function) string { return core.StringNilMapper(function.Name) },
+		liveKey:    func(function codeenginev2.Function) string { return core.StringNilMapper(function.Name) },
+		entityTag:  func(function codeenginev2.Function) string { return core.StringNilMapper(function.EntityTag) },
+		listAll: func(ctx context.Context, codeEngine *codeenginev2.CodeEngineV2, projectID string, headers map[string]string) ([]codeenginev2.Function, error) {
+			pager, err := codeEngine.NewFunctionsPager(codeEngine.NewListFunctionsOptions(projectID).SetLimit(listPageLimit).SetHeaders(headers))
+			if err != nil {
+				return nil, err
+			}
+			return pager.GetAllWithContext(ctx)
+		},
+		create: func(ctx context.Context, codeEngine *codeenginev2.CodeEngineV2, projectID string, function Function, headers map[string]string) error {
+			createFunctionOptions := &codeenginev2.CreateFunctionOptions{ProjectID: &projectID, Headers: headers}
+			if err := convert(function, createFunctionOptions); err != nil {
+				return err
+			}
+			_, _, err := codeEngine.CreateFunctionWithContext(ctx, createFunctionOptions)
+			return err
+		},
+		update: func(ctx context.Context, codeEngine *codeenginev2.CodeEngineV2, projectID string, change *Change, _ Function, _ codeenginev2.Function, headers map[string]string) error {
+			_, _, err := codeEngine.UpdateFunctionWithContext(ctx, codeEngine.NewUpdateFunctionOptions(projectID, change.Name, change.entityTag, change.patch).SetHeaders(headers))
+			return err
+		},
+		delete: func(ctx context.Context, codeEngine *codeenginev2.CodeEngineV2, projectID string, function codeenginev2.Function, headers map[string]string) error {
+			_, err := codeEngine.DeleteFunctionWithContext(ctx, codeEngine.NewDeleteFunctionOptions(projectID, *function.Name).SetHeaders(headers))
+			return err
+		},
+	},
+	&resourceOps[Binding, codeenginev2.Binding]{
+		name:       KindBinding,
+		resources:  func(manifest *Manifest) *[]Binding { return &manifest.Bindings },
+		desiredKey: func(binding Binding) string { return bindingKey(binding.Component, binding.SecretName) },
+		liveKey:    func(binding codeenginev2.Binding) string { return bindingKey(binding.Component, binding.SecretName) },
+		entityTag:  func(codeenginev2.Binding) string { return "" },
+		listAll: func(ctx context.Context, codeEngine *codeenginev2.CodeEngineV2, projectID string, headers map[string]string) ([]codeenginev2.Binding, error) {
+			pager, err := codeEngine.NewBindingsPager(codeEngine.NewListBindingsOptions(projectID).SetLimit(listPageLimit).SetHeaders(headers))
+			if err != nil {
+				return nil, err
+			}
+			return pager.GetAllWithContext(ctx)
+		},
+		create: func(ctx context.Context, codeEngine *codeenginev2.CodeEngineV2, projectID string, binding Binding, headers map[string]string) error {
+			createBindingOptions := &codeenginev2.CreateBindingOptions{ProjectID: &projectID, Headers: headers}
+			if err := convert(binding, createBindingOptions); err != nil {
+				return err
+			}
+			_, _, err := codeEngine.CreateBindingWithContext(ctx, createBindingOptions)
+			return err
+		},
+		delete: func(ctx context.Context, codeEngine *codeenginev2.CodeEngineV2, projectID string, binding codeenginev2.Binding, headers map[string]string) error {
+			_, err := codeEngine.DeleteBindingWithContext(ctx, codeEngine.NewDeleteBindingOptions(projectID, *binding.ID).SetHeaders(headers))
+			return err
+		},
+	},
+	&resourceOps[DomainMapping, codeenginev2.DomainMapping]{
+		name:       KindDomainMapping,
+		resources:  func(manifest *Manifest) *[]DomainMapping { return &manifest.DomainMappings },
+		desiredKey: func(domainMapping DomainMapping) string { return core.StringNilMapper(domainMapping.Name) },
+		liveKey:    func(domainMapping codeenginev2.DomainMapping) string { return core.StringNilMapper(domainMapping.Name) },
+		entityTag: func(domainMapping codeenginev2.DomainMapping) string {
+			return core.StringNilMapper(domainMapping.EntityTag)
+		},
+		systemManaged: func(domainMapping codeenginev2.DomainMapping) bool {
+			return domainMapping.UserManaged != nil && !*domainMapping.UserManaged
+		},
+		listAll: func(ctx context.Context, codeEngine *codeenginev2.CodeEngineV2, projectID string, headers map[string]string) ([]codeenginev2.DomainMapping, error) {
+			pager, err := codeEngine.NewDomainMappingsPager(codeEngine.NewListDomainMappingsOptions(projectID).SetLimit(listPageLimit).SetHeaders(headers))
+			if err != nil {
+				return nil, err
+			}
+			return pager.GetAllWithContext(ctx)
+		},
+		create: func(ctx context.Context, codeEngine *codeenginev2.CodeEngineV2, projectID string, domainMapping DomainMapping, headers map[string]string) error {
+			createDomainMappingOptions := &codeenginev2.CreateDomainMappingOptions{ProjectID: &projectID, Headers: headers}
+			if err := convert(domainMapping, createDomainMappingOptions); err != nil {
+				return err
+			}
+			_, _, err := codeEngine.CreateDomainMappingWithContext(ctx, createDomainMappingOptions)
+			return err
+		},
+		update: func(ctx context.Context, codeEngine *codeenginev2.CodeEngineV2, projectID string, change *Change, _ DomainMapping, _ codeenginev2.DomainMapping, headers map[string]string) error {
+			_, _, err := codeEngine.UpdateDomainMappingWithContext(ctx, codeEngine.NewUpdateDomainMappingOptions(projectID, change.Name, change.entityTag, change.patch).SetHeaders(headers))
+			return err
+		},
+		delete: func(ctx context.Context, codeEngine *codeenginev2.CodeEngineV2, projectID string, domainMapping codeenginev2.DomainMapping, headers map[string]string) error {
+			_, err := codeEngine.DeleteDomainMappingWithContext(ctx, codeEngine.NewDeleteDomainMappingOptions(projectID, *domainMapping.Name).SetHeaders(headers))
+			return err
+		},
+	},
+}
+
+// bindingKey identifies a binding by its component and secret, e.g. `app_v2/my-app/my-secret`.
+func bindingKey(component *codeenginev2.ComponentRef, secretName *string) string {
+	if component == nil || component.ResourceType == nil || component.Name == nil || secretName == nil {
+		return ""
+	}
+	return fmt.Sprintf("%s/%s/%s", *component.ResourceType, *component.Name, *secretName)
+}
+
+// stringField returns the string value of the field with the specified JSON name of a model, which is used for
+// models that are only available through their interface type.
+func stringField(model interface{}, name string) string {
+	object, err := jsondiff.ToObject(model)
+	if err != nil {
+		return ""
+	}
+	value, _ := object[name].(string)
+	return value
+}
+
+// secretData converts the declared data of a secret into the data of a secret request of the specified format. The
+// formats with a fixed set of keys, e.g. `basic_auth`, reject undeclared keys, all other formats accept any keys.
+func secretData(format string, data map[string]string) (codeenginev2.SecretDataIntf, error) {
+	var result codeenginev2.SecretDataIntf
+	switch format {
+	case codeenginev2.Secret_Format_BasicAuth:
+		result = new(codeenginev2.SecretDataBasicAuthSecretData)
+	case codeenginev2.Secret_Format_HmacAuth:
+		result = new(codeenginev2.SecretDataHMACAuthSecretData)
+	case codeenginev2.Secret_Format_Registry:
+		result = new(codeenginev2.SecretDataRegistrySecretData)
+	case codeenginev2.Secret_Format_SshAuth:
+		result = new(codeenginev2.SecretDataSSHSecretData)
+	case codeenginev2.Secret_Format_Tls:
+		result = new(codeenginev2.SecretDataTLSSecretData)
+	default:
+		generic := new(codeenginev2.SecretDataGenericSecretData)
+		generic.SetProperties(stringPtrMap(data))
+		return generic, nil
+	}
+
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(encoded))
+	decoder.DisallowUnknownFields()
+	if err = decoder.Decode(result); err != nil {
+		return nil, fmt.Errorf("the data does not match the secret format '%s': %w", format, err)
+	}
+	return result, nil
+}
+
+func stringPtrMap(m map[string]string) map[string]*string {
+	result := make(map[string]*string, len(m))
+	for key, value := range m {
+		result[key] = core.StringPtr(value)
+	}
+	return result
+}
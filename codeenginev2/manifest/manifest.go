@@ -0,0 +1,333 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package manifest : Declarative management of the resources of a Code Engine project
+//
+// A manifest is a YAML or JSON document that describes the desired state of the apps, jobs, functions, builds,
+// config maps, secrets, bindings, domain mappings, persistent data stores and allowed outbound destinations of a
+// project. CreatePlan compares a manifest with the live state of a project and ApplyPlan reconciles the project with
+// the manifest. Export creates the manifest of an existing project.
+package manifest
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/IBM/code-engine-go-sdk/codeenginev2"
+	common "github.com/IBM/code-engine-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"sigs.k8s.io/yaml"
+)
+
+// Manifest : The desired state of the resources of a project.
+//
+// The fields of each resource correspond to the fields of the matching `Create*Options` of the codeenginev2 package.
+// Fields that are not set are not managed by the manifest, which means that they keep their live value on update and
+// get their default value on create.
+type Manifest struct {
+	Apps []App `json:"apps,omitempty"`
+
+	Jobs []Job `json:"jobs,omitempty"`
+
+	Functions []Function `json:"functions,omitempty"`
+
+	Builds []Build `json:"builds,omitempty"`
+
+	ConfigMaps []ConfigMap `json:"config_maps,omitempty"`
+
+	Secrets []Secret `json:"secrets,omitempty"`
+
+	Bindings []Binding `json:"bindings,omitempty"`
+
+	DomainMappings []DomainMapping `json:"domain_mappings,omitempty"`
+
+	PersistentDataStores []PersistentDataStore `json:"persistent_data_stores,omitempty"`
+
+	AllowedOutboundDestinations []AllowedOutboundDestination `json:"allowed_outbound_destinations,omitempty"`
+}
+
+// App : The desired state of an app.
+type App struct {
+	Name *string `json:"name"`
+
+	ImageReference *string `json:"image_reference,omitempty"`
+
+	ImagePort *int64 `json:"image_port,omitempty"`
+
+	ImageSecret *string `json:"image_secret,omitempty"`
+
+	ManagedDomainMappings *string `json:"managed_domain_mappings,omitempty"`
+
+	ProbeLiveness *codeenginev2.ProbePrototype `json:"probe_liveness,omitempty"`
+
+	ProbeReadiness *codeenginev2.ProbePrototype `json:"probe_readiness,omitempty"`
+
+	RunArguments []string `json:"run_arguments,omitempty"`
+
+	RunAsUser *int64 `json:"run_as_user,omitempty"`
+
+	RunCommands []string `json:"run_commands,omitempty"`
+
+	RunComputeResourceTokenEnabled *bool `json:"run_compute_resource_token_enabled,omitempty"`
+
+	RunEnvVariables []codeenginev2.EnvVarPrototype `json:"run_env_variables,omitempty"`
+
+	RunServiceAccount *string `json:"run_service_account,omitempty"`
+
+	RunVolumeMounts []codeenginev2.VolumeMountPrototype `json:"run_volume_mounts,omitempty"`
+
+	ScaleConcurrency *int64 `json:"scale_concurrency,omitempty"`
+
+	ScaleConcurrencyTarget *int64 `json:"scale_concurrency_target,omitempty"`
+
+	ScaleCpuLimit *string `json:"scale_cpu_limit,omitempty"`
+
+	ScaleDownDelay *int64 `json:"scale_down_delay,omitempty"`
+
+	ScaleEphemeralStorageLimit *string `json:"scale_ephemeral_storage_limit,omitempty"`
+
+	ScaleInitialInstances *int64 `json:"scale_initial_instances,omitempty"`
+
+	ScaleMaxInstances *int64 `json:"scale_max_instances,omitempty"`
+
+	ScaleMemoryLimit *string `json:"scale_memory_limit,omitempty"`
+
+	ScaleMinInstances *int64 `json:"scale_min_instances,omitempty"`
+
+	ScaleRequestTimeout *int64 `json:"scale_request_timeout,omitempty"`
+}
+
+// Job : The desired state of a job.
+type Job struct {
+	Name *string `json:"name"`
+
+	ImageReference *string `json:"image_reference,omitempty"`
+
+	ImageSecret *string `json:"image_secret,omitempty"`
+
+	RunArguments []string `json:"run_arguments,omitempty"`
+
+	RunAsUser *int64 `json:"run_as_user,omitempty"`
+
+	RunCommands []string `json:"run_commands,omitempty"`
+
+	RunComputeResourceTokenEnabled *bool `json:"run_compute_resource_token_enabled,omitempty"`
+
+	RunEnvVariables []codeenginev2.EnvVarPrototype `json:"run_env_variables,omitempty"`
+
+	RunMode *string `json:"run_mode,omitempty"`
+
+	RunServiceAccount *string `json:"run_service_account,omitempty"`
+
+	RunVolumeMounts []codeenginev2.VolumeMountPrototype `json:"run_volume_mounts,omitempty"`
+
+	ScaleArraySpec *string `json:"scale_array_spec,omitempty"`
+
+	ScaleCpuLimit *string `json:"scale_cpu_limit,omitempty"`
+
+	ScaleEphemeralStorageLimit *string `json:"scale_ephemeral_storage_limit,omitempty"`
+
+	ScaleMaxExecutionTime *int64 `json:"scale_max_execution_time,omitempty"`
+
+	ScaleMemoryLimit *string `json:"scale_memory_limit,omitempty"`
+
+	ScaleRetryLimit *int64 `json:"scale_retry_limit,omitempty"`
+}
+
+// Function : The desired state of a function.
+type Function struct {
+	Name *string `json:"name"`
+
+	CodeReference *string `json:"code_reference,omitempty"`
+
+	Runtime *string `json:"runtime,omitempty"`
+
+	CodeBinary *bool `json:"code_binary,omitempty"`
+
+	CodeMain *string `json:"code_main,omitempty"`
+
+	CodeSecret *string `json:"code_secret,omitempty"`
+
+	ManagedDomainMappings *string `json:"managed_domain_mappings,omitempty"`
+
+	RunComputeResourceTokenEnabled *bool `json:"run_compute_resource_token_enabled,omitempty"`
+
+	RunEnvVariables []codeenginev2.EnvVarPrototype `json:"run_env_variables,omitempty"`
+
+	ScaleConcurrency *int64 `json:"scale_concurrency,omitempty"`
+
+	ScaleCpuLimit *string `json:"scale_cpu_limit,omitempty"`
+
+	ScaleDownDelay *int64 `json:"scale_down_delay,omitempty"`
+
+	ScaleMaxExecutionTime *int64 `json:"scale_max_execution_time,omitempty"`
+
+	ScaleMemoryLimit *string `json:"scale_memory_limit,omitempty"`
+}
+
+// Build : The desired state of a build.
+type Build struct {
+	Name *string `json:"name"`
+
+	OutputImage *string `json:"output_image,omitempty"`
+
+	OutputSecret *string `json:"output_secret,omitempty"`
+
+	StrategyType *string `json:"strategy_type,omitempty"`
+
+	RunBuildParams []codeenginev2.BuildParamPrototype `json:"run_build_params,omitempty"`
+
+	SourceContextDir *string `json:"source_context_dir,omitempty"`
+
+	SourceRevision *string `json:"source_revision,omitempty"`
+
+	SourceSecret *string `json:"source_secret,omitempty"`
+
+	SourceType *string `json:"source_type,omitempty"`
+
+	SourceURL *string `json:"source_url,omitempty"`
+
+	StrategySize *string `json:"strategy_size,omitempty"`
+
+	StrategySpecFile *string `json:"strategy_spec_file,omitempty"`
+
+	Timeout *int64 `json:"timeout,omitempty"`
+}
+
+// ConfigMap : The desired state of a config map.
+type ConfigMap struct {
+	Name *string `json:"name"`
+
+	Data map[string]string `json:"data,omitempty"`
+}
+
+// Secret : The desired state of a secret.
+//
+// The format of a secret and its service access or service operator properties cannot be updated in place; changing
+// them recreates the secret.
+type Secret struct {
+	Name *string `json:"name"`
+
+	Format *string `json:"format,omitempty"`
+
+	Data map[string]string `json:"data,omitempty"`
+
+	ServiceAccess *codeenginev2.ServiceAccessSecretPrototypeProps `json:"service_access,omitempty"`
+
+	ServiceOperator *codeenginev2.OperatorSecretPrototypeProps `json:"service_operator,omitempty"`
+}
+
+// Binding : The desired state of a service binding.
+//
+// Bindings have no name; they are identified by their component and secret. Bindings cannot be updated in place;
+// changing the prefix recreates the binding.
+type Binding struct {
+	Component *codeenginev2.ComponentRef `json:"component"`
+
+	SecretName *string `json:"secret_name"`
+
+	Prefix *string `json:"prefix,omitempty"`
+}
+
+// DomainMapping : The desired state of a domain mapping.
+type DomainMapping struct {
+	Name *string `json:"name"`
+
+	Component *codeenginev2.ComponentRef `json:"component,omitempty"`
+
+	TlsSecret *string `json:"tls_secret,omitempty"`
+}
+
+// PersistentDataStore : The desired state of a persistent data store.
+//
+// Persistent data stores cannot be updated in place; changing them recreates the persistent data store.
+type PersistentDataStore struct {
+	Name *string `json:"name"`
+
+	StorageType *string `json:"storage_type,omitempty"`
+
+	Data map[string]string `json:"data,omitempty"`
+}
+
+// AllowedOutboundDestination : The desired state of an allowed outbound destination.
+//
+// The type of an allowed outbound destination cannot be updated in place; changing it recreates the allowed outbound
+// destination.
+type AllowedOutboundDestination struct {
+	Name *string `json:"name"`
+
+	Type *string `json:"type,omitempty"`
+
+	CidrBlock *string `json:"cidr_block,omitempty"`
+
+	IsolationPolicy *string `json:"isolation_policy,omitempty"`
+
+	PrivatePathServiceGatewayCrn *string `json:"private_path_service_gateway_crn,omitempty"`
+}
+
+// Parse parses a manifest from a YAML or JSON document. Unknown fields are rejected.
+func Parse(data []byte) (manifest *Manifest, err error) {
+	manifest = new(Manifest)
+	err = yaml.UnmarshalStrict(data, manifest)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "manifest-parse-error", common.GetComponentInfo())
+		manifest = nil
+		return
+	}
+	err = manifest.Validate()
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "manifest-invalid")
+		manifest = nil
+	}
+	return
+}
+
+// Load reads and parses the manifest in the specified YAML or JSON file.
+func Load(path string) (manifest *Manifest, err error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "manifest-read-error", common.GetComponentInfo())
+		return
+	}
+	return Parse(data)
+}
+
+// Marshal renders the manifest as a YAML document. Object keys are sorted, so that equal manifests always render to
+// the same document.
+func (manifest *Manifest) Marshal() (data []byte, err error) {
+	data, err = yaml.Marshal(manifest)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "manifest-marshal-error", common.GetComponentInfo())
+	}
+	return
+}
+
+// Validate checks that every resource of the manifest is identified and that no resource is declared twice.
+func (manifest *Manifest) Validate() error {
+	for _, kind := range kinds {
+		seen := map[string]bool{}
+		for _, key := range kind.desiredKeys(manifest) {
+			if key == "" {
+				return core.SDKErrorf(nil, fmt.Sprintf("a %s in the manifest is not identified by a name", kind.kind()), "manifest-missing-name", common.GetComponentInfo())
+			}
+			if seen[key] {
+				return core.SDKErrorf(nil, fmt.Sprintf("%s '%s' is declared more than once in the manifest", kind.kind(), key), "manifest-duplicate", common.GetComponentInfo())
+			}
+			seen[key] = true
+		}
+	}
+	return nil
+}
@@ -0,0 +1,377 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package manifest_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+
+	"github.com/IBM/code-engine-go-sdk/codeenginev2"
+	"github.com/IBM/code-engine-go-sdk/codeenginev2/manifest"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// recordedRequest is a modifying request received by the project server.
+type recordedRequest struct {
+	Method  string
+	Path    string
+	IfMatch string
+	Body    map[string]interface{}
+}
+
+// projectServer serves the list operations of a project from an in-memory state and records all other requests.
+// Deletions remove the resource from the state; all other modifications are acknowledged without changing the state.
+type projectServer struct {
+	*httptest.Server
+
+	mutex      sync.Mutex
+	state      map[string][]map[string]interface{}
+	requests   []recordedRequest
+	statusCode map[string]int
+}
+
+func newProjectServer(projectID string, state map[string]string) *projectServer {
+	server := &projectServer{state: map[string][]map[string]interface{}{}, statusCode: map[string]int{}}
+	for collection, items := range state {
+		var resources []map[string]interface{}
+		Expect(json.Unmarshal([]byte(items), &resources)).To(Succeed())
+		server.state[collection] = resources
+	}
+
+	prefix := "/projects/" + projectID + "/"
+	server.Server = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		defer GinkgoRecover()
+
+		server.mutex.Lock()
+		defer server.mutex.Unlock()
+
+		Expect(req.URL.Path).To(HavePrefix(prefix))
+		collection, identifier, _ := strings.Cut(strings.TrimPrefix(req.URL.Path, prefix), "/")
+		res.Header().Set("Content-type", "application/json")
+
+		if req.Method == http.MethodGet {
+			Expect(identifier).To(BeEmpty())
+			body, _ := json.Marshal(map[string]interface{}{collection: server.state[collection], "limit": 100})
+			res.WriteHeader(200)
+			res.Write(body)
+			return
+		}
+
+		request := recordedRequest{Method: req.Method, Path: req.URL.Path, IfMatch: req.Header.Get("If-Match")}
+		if req.ContentLength > 0 {
+			Expect(json.NewDecoder(req.Body).Decode(&request.Body)).To(Succeed())
+		}
+		server.requests = append(server.requests, request)
+
+		if statusCode, found := server.statusCode[req.Method+" "+req.URL.Path]; found {
+			res.WriteHeader(statusCode)
+			res.Write([]byte(`{"errors": [{"code": "precondition_failed", "message": "entity tag mismatch"}], "status_code": 412}`))
+			return
+		}
+		switch req.Method {
+		case http.MethodDelete:
+			resources := server.state[collection][:0]
+			for _, resource := range server.state[collection] {
+				if resource["name"] != identifier && resource["id"] != identifier {
+					resources = append(resources, resource)
+				}
+			}
+			server.state[collection] = resources
+			res.WriteHeader(202)
+		case http.MethodPost:
+			res.WriteHeader(201)
+			res.Write([]byte(`{}`))
+		default:
+			res.WriteHeader(200)
+			res.Write([]byte(`{}`))
+		}
+	}))
+	return server
+}
+
+var _ = Describe(`Manifest`, func() {
+	projectID := "15314cc3-85b4-4338-903f-c28cdee6d005"
+	var server *projectServer
+
+	newService := func() *codeenginev2.CodeEngineV2 {
+		codeEngineService, serviceErr := codeenginev2.NewCodeEngineV2(&codeenginev2.CodeEngineV2Options{
+			URL:           server.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		return codeEngineService
+	}
+
+	liveState := map[string]string{
+		"apps": `[
+			{"name": "my-app", "id": "a1", "entity_tag": "1", "image_reference": "icr.io/ns/app:1", "image_port": 8080,
+			 "scale_min_instances": 0, "run_env_variables": [{"type": "literal", "name": "LEVEL", "value": "info"}],
+			 "status": "ready", "computed_env_variables": [{"type": "literal", "name": "CE_APP", "value": "my-app"}]},
+			{"name": "old-app", "id": "a2", "entity_tag": "4", "image_reference": "icr.io/ns/old:1"}
+		]`,
+		"config_maps": `[
+			{"name": "my-config", "entity_tag": "2", "data": {"key": "value"}},
+			{"name": "other-config", "entity_tag": "3", "data": {"a": "1", "b": "2"}}
+		]`,
+		"secrets": `[
+			{"name": "ce-auto-icr-private-us-south", "entity_tag": "5", "format": "registry", "generated_by": "system"},
+			{"name": "my-secret", "entity_tag": "6", "format": "generic", "generated_by": "user", "data": {"password": "s3cr3t"}}
+		]`,
+		"bindings": `[
+			{"id": "b1", "component": {"name": "my-app", "resource_type": "app_v2"}, "secret_name": "my-service", "prefix": "OLD"}
+		]`,
+		"domain_mappings": `[
+			{"name": "my-app.abc.us-south.codeengine.appdomain.cloud", "entity_tag": "7", "user_managed": false,
+			 "component": {"name": "my-app", "resource_type": "app_v2"}, "tls_secret": "ce-auto-tls"}
+		]`,
+	}
+
+	const document = `
+apps:
+  - name: my-app
+    image_reference: icr.io/ns/app:2
+    image_port: 8080
+    run_env_variables:
+      - type: literal
+        name: LEVEL
+        value: info
+  - name: new-app
+    image_reference: icr.io/ns/new:1
+config_maps:
+  - name: my-config
+    data:
+      key: value
+  - name: other-config
+    data:
+      a: "1"
+secrets:
+  - name: my-secret
+    format: generic
+    data:
+      password: s3cr3t
+bindings:
+  - component:
+      name: my-app
+      resource_type: app_v2
+    secret_name: my-service
+    prefix: NEW
+`
+
+	AfterEach(func() {
+		if server != nil {
+			server.Close()
+		}
+	})
+
+	Describe(`Parse(data)`, func() {
+		It(`Parses YAML documents`, func() {
+			result, err := manifest.Parse([]byte(document))
+			Expect(err).To(BeNil())
+			Expect(result.Apps).To(HaveLen(2))
+			Expect(*result.Apps[0].ImageReference).To(Equal("icr.io/ns/app:2"))
+			Expect(*result.Apps[0].ImagePort).To(Equal(int64(8080)))
+			Expect(*result.Apps[0].RunEnvVariables[0].Value).To(Equal("info"))
+			Expect(result.ConfigMaps[1].Data).To(Equal(map[string]string{"a": "1"}))
+			Expect(*result.Bindings[0].Component.ResourceType).To(Equal("app_v2"))
+		})
+		It(`Parses JSON documents`, func() {
+			result, err := manifest.Parse([]byte(`{"jobs": [{"name": "my-job", "image_reference": "icr.io/ns/job:1", "scale_array_spec": "0-9"}]}`))
+			Expect(err).To(BeNil())
+			Expect(*result.Jobs[0].ScaleArraySpec).To(Equal("0-9"))
+		})
+		It(`Rejects unknown fields`, func() {
+			_, err := manifest.Parse([]byte("apps:\n  - name: my-app\n    image: icr.io/ns/app:1\n"))
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring(`unknown field "image"`))
+		})
+		It(`Rejects resources without a name`, func() {
+			_, err := manifest.Parse([]byte("jobs:\n  - image_reference: icr.io/ns/job:1\n"))
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(Equal("a job in the manifest is not identified by a name"))
+		})
+		It(`Rejects duplicate resources`, func() {
+			_, err := manifest.Parse([]byte("secrets:\n  - name: s\n    format: generic\n  - name: s\n    format: generic\n"))
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(Equal("secret 's' is declared more than once in the manifest"))
+		})
+	})
+
+	Describe(`CreatePlan(ctx, codeEngine, planOptions)`, func() {
+		var desired *manifest.Manifest
+		BeforeEach(func() {
+			var err error
+			desired, err = manifest.Parse([]byte(document))
+			Expect(err).To(BeNil())
+			server = newProjectServer(projectID, liveState)
+		})
+		It(`Plans the creations, updates and recreations of the manifest`, func() {
+			plan, err := manifest.CreatePlan(context.Background(), newService(), manifest.NewPlanOptions(projectID, desired))
+			Expect(err).To(BeNil())
+			Expect(plan.HasChanges()).To(BeTrue())
+			Expect(plan.String()).To(Equal("~ config_map other-config (data)\n" +
+				"~ app my-app (image_reference)\n" +
+				"+ app new-app\n" +
+				"-/+ binding app_v2/my-app/my-service (prefix)\n"))
+			Expect(server.requests).To(BeEmpty())
+		})
+		It(`Plans the deletion of undeclared resources when pruning`, func() {
+			planOptions := manifest.NewPlanOptions(projectID, desired).SetPrune(true)
+			plan, err := manifest.CreatePlan(context.Background(), newService(), planOptions)
+			Expect(err).To(BeNil())
+			Expect(plan.String()).To(HaveSuffix("-/+ binding app_v2/my-app/my-service (prefix)\n- app old-app\n"))
+		})
+		It(`Plans no changes for a project that matches the manifest`, func() {
+			inSync := &manifest.Manifest{
+				ConfigMaps: []manifest.ConfigMap{{Name: core.StringPtr("my-config"), Data: map[string]string{"key": "value"}}},
+				Apps:       []manifest.App{{Name: core.StringPtr("my-app"), ScaleMinInstances: core.Int64Ptr(0)}},
+			}
+			plan, err := manifest.CreatePlan(context.Background(), newService(), manifest.NewPlanOptions(projectID, inSync))
+			Expect(err).To(BeNil())
+			Expect(plan.HasChanges()).To(BeFalse())
+		})
+		It(`Recreates allowed outbound destinations whose type changes`, func() {
+			server.Close()
+			server = newProjectServer(projectID, map[string]string{
+				"allowed_outbound_destinations": `[
+					{"name": "internal", "type": "cidr_block", "cidr_block": "10.0.0.0/8", "entity_tag": "1"},
+					{"name": "gateway", "type": "cidr_block", "cidr_block": "192.168.0.0/16", "entity_tag": "2"}
+				]`,
+			})
+			destinations, err := manifest.Parse([]byte(`
+allowed_outbound_destinations:
+  - name: internal
+    type: cidr_block
+    cidr_block: 10.0.0.0/16
+  - name: gateway
+    type: private_path_service_gateway
+    private_path_service_gateway_crn: crn:v1:bluemix:public:is:us-south:a/123::private-path-service-gateway:r006-1
+`))
+			Expect(err).To(BeNil())
+			plan, err := manifest.CreatePlan(context.Background(), newService(), manifest.NewPlanOptions(projectID, destinations))
+			Expect(err).To(BeNil())
+			Expect(plan.String()).To(Equal("-/+ allowed_outbound_destination gateway (private_path_service_gateway_crn, type)\n" +
+				"~ allowed_outbound_destination internal (cidr_block)\n"))
+		})
+		It(`Returns an error if the options are invalid`, func() {
+			_, err := manifest.CreatePlan(context.Background(), newService(), manifest.NewPlanOptions(projectID, nil))
+			Expect(err).ToNot(BeNil())
+			_, err = manifest.CreatePlan(context.Background(), newService(), nil)
+			Expect(err).ToNot(BeNil())
+		})
+	})
+
+	Describe(`ApplyPlan(ctx, codeEngine, plan)`, func() {
+		var plan *manifest.Plan
+		BeforeEach(func() {
+			desired, err := manifest.Parse([]byte(document))
+			Expect(err).To(BeNil())
+			server = newProjectServer(projectID, liveState)
+			planOptions := manifest.NewPlanOptions(projectID, desired).
+				SetPrune(true).
+				SetHeaders(map[string]string{"x-custom-header": "x-custom-value"})
+			plan, err = manifest.CreatePlan(context.Background(), newService(), planOptions)
+			Expect(err).To(BeNil())
+		})
+		It(`Applies the changes in order with the entity tags of the plan`, func() {
+			applied, err := manifest.ApplyPlan(context.Background(), newService(), plan)
+			Expect(err).To(BeNil())
+			Expect(applied).To(Equal(plan.Changes))
+
+			prefix := "/projects/" + projectID
+			Expect(server.requests).To(Equal([]recordedRequest{
+				{Method: "PUT", Path: prefix + "/config_maps/other-config", IfMatch: "3", Body: map[string]interface{}{"data": map[string]interface{}{"a": "1"}}},
+				{Method: "PATCH", Path: prefix + "/apps/my-app", IfMatch: "1", Body: map[string]interface{}{"image_reference": "icr.io/ns/app:2"}},
+				{Method: "POST", Path: prefix + "/apps", Body: map[string]interface{}{"name": "new-app", "image_reference": "icr.io/ns/new:1"}},
+				{Method: "DELETE", Path: prefix + "/bindings/b1"},
+				{Method: "POST", Path: prefix + "/bindings", Body: map[string]interface{}{
+					"component":   map[string]interface{}{"name": "my-app", "resource_type": "app_v2"},
+					"secret_name": "my-service",
+					"prefix":      "NEW",
+				}},
+				{Method: "DELETE", Path: prefix + "/apps/old-app"},
+			}))
+		})
+		It(`Creates secrets with the data of their format`, func() {
+			server.Close()
+			server = newProjectServer(projectID, map[string]string{})
+			secrets, err := manifest.Parse([]byte(`
+secrets:
+  - name: login
+    format: basic_auth
+    data:
+      username: admin
+      password: s3cr3t
+  - name: pull
+    format: registry
+    data:
+      server: icr.io
+      username: iamapikey
+      password: key
+`))
+			Expect(err).To(BeNil())
+			plan, err = manifest.CreatePlan(context.Background(), newService(), manifest.NewPlanOptions(projectID, secrets))
+			Expect(err).To(BeNil())
+			_, err = manifest.ApplyPlan(context.Background(), newService(), plan)
+			Expect(err).To(BeNil())
+
+			Expect(server.requests).To(HaveLen(2))
+			Expect(server.requests[0].Body).To(Equal(map[string]interface{}{
+				"name":   "login",
+				"format": "basic_auth",
+				"data":   map[string]interface{}{"username": "admin", "password": "s3cr3t"},
+			}))
+			Expect(server.requests[1].Body["data"]).To(Equal(map[string]interface{}{"server": "icr.io", "username": "iamapikey", "password": "key"}))
+		})
+		It(`Rejects secret data that does not match the format of the secret`, func() {
+			server.Close()
+			server = newProjectServer(projectID, map[string]string{})
+			secrets, err := manifest.Parse([]byte(`
+secrets:
+  - name: login
+    format: basic_auth
+    data:
+      user: admin
+`))
+			Expect(err).To(BeNil())
+			plan, err = manifest.CreatePlan(context.Background(), newService(), manifest.NewPlanOptions(projectID, secrets))
+			Expect(err).To(BeNil())
+			_, err = manifest.ApplyPlan(context.Background(), newService(), plan)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("the data does not match the secret format 'basic_auth'"))
+			Expect(server.requests).To(BeEmpty())
+		})
+		It(`Stops at a change whose resource has been modified since the plan was created`, func() {
+			server.statusCode["PATCH /projects/"+projectID+"/apps/my-app"] = 412
+			applied, err := manifest.ApplyPlan(context.Background(), newService(), plan)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(HavePrefix("failed to update app 'my-app': "))
+			Expect(applied).To(HaveLen(1))
+
+			var httpProblem *core.HTTPProblem
+			Expect(err).To(BeAssignableToTypeOf(&core.SDKProblem{}))
+			Expect(errors.As(err, &httpProblem)).To(BeTrue())
+			Expect(httpProblem.Response.GetStatusCode()).To(Equal(412))
+		})
+	})
+})
@@ -0,0 +1,447 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package manifest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/IBM/code-engine-go-sdk/codeenginev2"
+	"github.com/IBM/code-engine-go-sdk/codeenginev2/internal/jsondiff"
+	common "github.com/IBM/code-engine-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Kind identifies the type of a resource of a manifest.
+type Kind string
+
+// Constants associated with the Kind type.
+const (
+	KindAllowedOutboundDestination Kind = "allowed_outbound_destination"
+	KindApp                        Kind = "app"
+	KindBinding                    Kind = "binding"
+	KindBuild                      Kind = "build"
+	KindConfigMap                  Kind = "config_map"
+	KindDomainMapping              Kind = "domain_mapping"
+	KindFunction                   Kind = "function"
+	KindJob                        Kind = "job"
+	KindPersistentDataStore        Kind = "persistent_data_store"
+	KindSecret                     Kind = "secret"
+)
+
+// Action is the operation that a change performs on a resource.
+type Action string
+
+// Constants associated with the Action type.
+const (
+	// ActionCreate creates a resource that is declared in the manifest but does not exist in the project.
+	ActionCreate Action = "create"
+
+	// ActionUpdate updates the fields of an existing resource that differ from the manifest.
+	ActionUpdate Action = "update"
+
+	// ActionRecreate deletes and creates a resource whose differing fields cannot be updated in place.
+	ActionRecreate Action = "recreate"
+
+	// ActionDelete deletes a resource that exists in the project but is not declared in the manifest.
+	ActionDelete Action = "delete"
+)
+
+// Change : A single change of a plan.
+type Change struct {
+	// The type of the resource.
+	Kind Kind
+
+	// The name of the resource. Bindings, which have no name, are named `<resource_type>/<component>/<secret>`.
+	Name string
+
+	// The operation that the change performs.
+	Action Action
+
+	// The fields of the manifest that differ from the live resource, for updates and recreations.
+	Fields []string
+
+	// The resource as declared in the manifest, e.g. an App. Nil for deletions.
+	Desired interface{}
+
+	// The resource as it currently exists in the project, e.g. a codeenginev2.App. Nil for creations.
+	Live interface{}
+
+	// The entity tag of the live resource, which guards updates against concurrent modifications.
+	entityTag string
+
+	// The fields to update, keyed by their JSON name.
+	patch map[string]interface{}
+}
+
+// String renders the change as a single line, e.g. `~ app my-app (image_reference)`.
+func (change *Change) String() string {
+	symbol := map[Action]string{
+		ActionCreate:   "+",
+		ActionUpdate:   "~",
+		ActionRecreate: "-/+",
+		ActionDelete:   "-",
+	}[change.Action]
+	line := fmt.Sprintf("%s %s %s", symbol, change.Kind, change.Name)
+	if len(change.Fields) > 0 {
+		line += fmt.Sprintf(" (%s)", strings.Join(change.Fields, ", "))
+	}
+	return line
+}
+
+// Plan : The changes that reconcile a project with a manifest.
+//
+// Changes are ordered so that resources are created before the resources that reference them and deleted after.
+type Plan struct {
+	// The ID of the project, also if the plan options refer to the project by name.
+	ProjectID string
+
+	// The changes in the order in which they are applied.
+	Changes []*Change
+
+	// The headers that are sent with each request.
+	headers map[string]string
+}
+
+// HasChanges returns true if applying the plan changes the project.
+func (plan *Plan) HasChanges() bool {
+	return len(plan.Changes) > 0
+}
+
+// String renders the plan with one change per line.
+func (plan *Plan) String() string {
+	var builder strings.Builder
+	for _, change := range plan.Changes {
+		builder.WriteString(change.String())
+		builder.WriteString("\n")
+	}
+	return builder.String()
+}
+
+// PlanOptions : The CreatePlan options.
+type PlanOptions struct {
+	// The ID of the project.
+	ProjectID *string `validate:"required,ne="`
+
+	// The desired state of the project.
+	Manifest *Manifest `validate:"required"`
+
+	// Delete resources of the project that are not declared in the manifest. Resources that Code Engine manages
+	// itself, such as system generated secrets and domain mappings, are never deleted.
+	Prune *bool
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewPlanOptions : Instantiate PlanOptions
+func NewPlanOptions(projectID string, manifest *Manifest) *PlanOptions {
+	return &PlanOptions{
+		ProjectID: core.StringPtr(projectID),
+		Manifest:  manifest,
+	}
+}
+
+// SetProjectID : Allow user to set ProjectID
+func (_options *PlanOptions) SetProjectID(projectID string) *PlanOptions {
+	_options.ProjectID = core.StringPtr(projectID)
+	return _options
+}
+
+// SetManifest : Allow user to set Manifest
+func (_options *PlanOptions) SetManifest(manifest *Manifest) *PlanOptions {
+	_options.Manifest = manifest
+	return _options
+}
+
+// SetPrune : Allow user to set Prune
+func (_options *PlanOptions) SetPrune(prune bool) *PlanOptions {
+	_options.Prune = core.BoolPtr(prune)
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *PlanOptions) SetHeaders(param map[string]string) *PlanOptions {
+	options.Headers = param
+	return options
+}
+
+// CreatePlan compares the manifest with the live state of the project, which is read through the `List*` pagers, and
+// returns the changes that reconcile the project with the manifest. The project is not modified.
+func CreatePlan(ctx context.Context, codeEngine *codeenginev2.CodeEngineV2, planOptions *PlanOptions) (plan *Plan, err error) {
+	err = core.ValidateNotNil(planOptions, "planOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(planOptions, "planOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	err = planOptions.Manifest.Validate()
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "manifest-invalid")
+		return
+	}
+	err = checkSecretValues(planOptions.Manifest)
+	if err != nil {
+		return
+	}
+
+	projectID, err := codeEngine.ResolveProjectID(ctx, *planOptions.ProjectID)
+	if err != nil {
+		return
+	}
+
+	prune := planOptions.Prune != nil && *planOptions.Prune
+	plan = &Plan{
+		ProjectID: projectID,
+		headers:   planOptions.Headers,
+	}
+	var deletions []*Change
+	for i := range kinds {
+		var changes []*Change
+		changes, err = kinds[i].plan(ctx, codeEngine, projectID, planOptions.Manifest, prune, planOptions.Headers)
+		if err != nil {
+			err = core.SDKErrorf(err, "", "plan-error", common.GetComponentInfo())
+			plan = nil
+			return
+		}
+		var kindDeletions []*Change
+		for _, change := range changes {
+			if change.Action == ActionDelete {
+				kindDeletions = append(kindDeletions, change)
+			} else {
+				plan.Changes = append(plan.Changes, change)
+			}
+		}
+
+		// Delete resources in reverse dependency order, i.e. domain mappings and bindings before the components they
+		// reference and components before the secrets and config maps they reference.
+		deletions = append(kindDeletions, deletions...)
+	}
+	plan.Changes = append(plan.Changes, deletions...)
+	return
+}
+
+// checkSecretValues rejects manifests that contain secret values which were redacted or encrypted by Export, so that
+// they are never written to a project.
+func checkSecretValues(manifest *Manifest) error {
+	for _, secret := range manifest.Secrets {
+		keys := make([]string, 0, len(secret.Data))
+		for key := range secret.Data {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			if value := secret.Data[key]; value == RedactedValue || strings.HasPrefix(value, encryptedValuePrefix) {
+				return core.SDKErrorf(nil, fmt.Sprintf("key '%s' of secret '%s' is redacted or encrypted", key, core.StringNilMapper(secret.Name)), "secret-value-not-available", common.GetComponentInfo())
+			}
+		}
+	}
+	return nil
+}
+
+// resourceKind plans and applies the changes of one kind of resource.
+type resourceKind interface {
+	kind() Kind
+	desiredKeys(manifest *Manifest) []string
+	plan(ctx context.Context, codeEngine *codeenginev2.CodeEngineV2, projectID string, manifest *Manifest, prune bool, headers map[string]string) ([]*Change, error)
+	list(ctx context.Context, codeEngine *codeenginev2.CodeEngineV2, projectID string, headers map[string]string) (map[string]string, error)
+	export(ctx context.Context, codeEngine *codeenginev2.CodeEngineV2, projectID string, manifest *Manifest, headers map[string]string) error
+	apply(ctx context.Context, codeEngine *codeenginev2.CodeEngineV2, projectID string, change *Change, headers map[string]string) error
+}
+
+// resourceOps implements resourceKind for manifest resources of type D and live resources of type L.
+type resourceOps[D any, L any] struct {
+	name Kind
+
+	resources  func(manifest *Manifest) *[]D
+	desiredKey func(desired D) string
+	liveKey    func(live L) string
+	entityTag  func(live L) string
+
+	// systemManaged reports resources that Code Engine manages itself. They are never pruned or exported.
+	systemManaged func(live L) bool
+
+	// immutableFields lists the fields that cannot be updated in place. Resources without an update function are
+	// recreated on any change.
+	immutableFields []string
+
+	// exactFields lists the fields whose value must match exactly. Other fields match if the live value contains
+	// the declared value, so that defaults filled in by the server are not reported as changes.
+	exactFields []string
+
+	listAll func(ctx context.Context, codeEngine *codeenginev2.CodeEngineV2, projectID string, headers map[string]string) ([]L, error)
+	create  func(ctx context.Context, codeEngine *codeenginev2.CodeEngineV2, projectID string, desired D, headers map[string]string) error
+	update  func(ctx context.Context, codeEngine *codeenginev2.CodeEngineV2, projectID string, change *Change, desired D, live L, headers map[string]string) error
+	delete  func(ctx context.Context, codeEngine *codeenginev2.CodeEngineV2, projectID string, live L, headers map[string]string) error
+}
+
+func (ops *resourceOps[D, L]) kind() Kind {
+	return ops.name
+}
+
+func (ops *resourceOps[D, L]) desiredKeys(manifest *Manifest) (keys []string) {
+	for _, desired := range *ops.resources(manifest) {
+		keys = append(keys, ops.desiredKey(desired))
+	}
+	return
+}
+
+func (ops *resourceOps[D, L]) plan(ctx context.Context, codeEngine *codeenginev2.CodeEngineV2, projectID string, manifest *Manifest, prune bool, headers map[string]string) (changes []*Change, err error) {
+	liveResources, err := ops.listAll(ctx, codeEngine, projectID, headers)
+	if err != nil {
+		return
+	}
+	liveByKey := map[string]L{}
+	for _, live := range liveResources {
+		liveByKey[ops.liveKey(live)] = live
+	}
+
+	desiredKeys := map[string]bool{}
+	for _, desired := range *ops.resources(manifest) {
+		key := ops.desiredKey(desired)
+		desiredKeys[key] = true
+
+		live, found := liveByKey[key]
+		if !found {
+			changes = append(changes, &Change{Kind: ops.name, Name: key, Action: ActionCreate, Desired: desired})
+			continue
+		}
+
+		var fields []string
+		var patch map[string]interface{}
+		fields, patch, err = jsondiff.Diff(desired, live, nil, ops.exactFields)
+		if err != nil {
+			return
+		}
+		if len(fields) == 0 {
+			continue
+		}
+		action := ActionUpdate
+		if ops.update == nil || containsAny(ops.immutableFields, fields) {
+			action = ActionRecreate
+		}
+		changes = append(changes, &Change{
+			Kind:      ops.name,
+			Name:      key,
+			Action:    action,
+			Fields:    fields,
+			Desired:   desired,
+			Live:      live,
+			entityTag: ops.entityTag(live),
+			patch:     patch,
+		})
+	}
+
+	if prune {
+		for key, live := range liveByKey {
+			if desiredKeys[key] || (ops.systemManaged != nil && ops.systemManaged(live)) {
+				continue
+			}
+			changes = append(changes, &Change{Kind: ops.name, Name: key, Action: ActionDelete, Live: live, entityTag: ops.entityTag(live)})
+		}
+	}
+
+	sort.SliceStable(changes, func(i, j int) bool {
+		return changes[i].Name < changes[j].Name
+	})
+	return
+}
+
+// list returns the entity tags of the live resources by their key.
+func (ops *resourceOps[D, L]) list(ctx context.Context, codeEngine *codeenginev2.CodeEngineV2, projectID string, headers map[string]string) (map[string]string, error) {
+	liveResources, err := ops.listAll(ctx, codeEngine, projectID, headers)
+	if err != nil {
+		return nil, err
+	}
+	result := map[string]string{}
+	for _, live := range liveResources {
+		result[ops.liveKey(live)] = ops.entityTag(live)
+	}
+	return result, nil
+}
+
+// export appends the live resources, converted into manifest resources, to the manifest in the order of their keys.
+func (ops *resourceOps[D, L]) export(ctx context.Context, codeEngine *codeenginev2.CodeEngineV2, projectID string, manifest *Manifest, headers map[string]string) error {
+	liveResources, err := ops.listAll(ctx, codeEngine, projectID, headers)
+	if err != nil {
+		return err
+	}
+	resources := ops.resources(manifest)
+	for _, live := range liveResources {
+		if ops.systemManaged != nil && ops.systemManaged(live) {
+			continue
+		}
+		var resource D
+		if err = convert(live, &resource); err != nil {
+			return err
+		}
+		*resources = append(*resources, resource)
+	}
+	sort.SliceStable(*resources, func(i, j int) bool {
+		return ops.desiredKey((*resources)[i]) < ops.desiredKey((*resources)[j])
+	})
+	return nil
+}
+
+func (ops *resourceOps[D, L]) apply(ctx context.Context, codeEngine *codeenginev2.CodeEngineV2, projectID string, change *Change, headers map[string]string) error {
+	desired, _ := change.Desired.(D)
+	live, _ := change.Live.(L)
+	switch change.Action {
+	case ActionCreate:
+		return ops.create(ctx, codeEngine, projectID, desired, headers)
+	case ActionUpdate:
+		return ops.update(ctx, codeEngine, projectID, change, desired, live, headers)
+	case ActionRecreate:
+		if err := ops.delete(ctx, codeEngine, projectID, live, headers); err != nil {
+			return err
+		}
+		if err := waitForDeletion(ctx, codeEngine, ops, projectID, change.Name, headers); err != nil {
+			return err
+		}
+		return ops.create(ctx, codeEngine, projectID, desired, headers)
+	case ActionDelete:
+		return ops.delete(ctx, codeEngine, projectID, live, headers)
+	}
+	return fmt.Errorf("unsupported action '%s'", change.Action)
+}
+
+// convert copies the fields of a manifest resource into the SDK options or model with the same JSON field names.
+func convert(from interface{}, to interface{}) error {
+	data, err := json.Marshal(from)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, to)
+}
+
+func containsAny(values []string, candidates []string) bool {
+	for _, value := range values {
+		for _, candidate := range candidates {
+			if value == candidate {
+				return true
+			}
+		}
+	}
+	return false
+}
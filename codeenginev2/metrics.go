@@ -0,0 +1,143 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codeenginev2
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Status classes of RequestObservation.
+const (
+	StatusClass2xx   = "2xx"
+	StatusClass3xx   = "3xx"
+	StatusClass4xx   = "4xx"
+	StatusClass5xx   = "5xx"
+	StatusClassError = "error"
+)
+
+// RequestObservation : An attempt of a request of a service, observed by a MetricsRecorder.
+type RequestObservation struct {
+	// The operation ID of the request, e.g. `ListApps`, which is the ID that the operation passes to
+	// common.GetSdkHeaders. Empty if the request does not belong to an operation.
+	Operation string
+
+	// The HTTP method of the request.
+	Method string
+
+	// The HTTP status code of the response, or 0 if no response has been received.
+	StatusCode int
+
+	// The class of the status code, e.g. StatusClass4xx, or StatusClassError if no response has been received.
+	StatusClass string
+
+	// The time until the response headers have been received.
+	Duration time.Duration
+
+	// The number of times that the request has been sent before, i.e. 0 for the first attempt and greater than 0 for
+	// the retries of the retryable client of the core.
+	ResendCount int64
+
+	// The error of the attempt if no response has been received.
+	Err error
+}
+
+// MetricsRecorder : A sink for the metrics of the requests of a service, e.g. the Prometheus adapter of the
+// codeenginev2prometheus package. The methods are called concurrently by the requests of the service and its clones.
+type MetricsRecorder interface {
+	// ObserveRequest is called for each attempt of a request once its response headers have been received or it has
+	// failed.
+	ObserveRequest(observation RequestObservation)
+
+	// ObservePage is called for each page of resources that a paginated List operation, e.g. `ListApps`, has
+	// returned, whether it has been fetched by a pager or by a direct call of the operation.
+	ObservePage(operation string)
+}
+
+// SetMetricsRecorder : Record the metrics of the requests of the service
+// The recorder observes each attempt of the requests of the service and all its clones, and each page that a List
+// operation returns. The time that a request waits for the rate limiter is not part of its duration. A nil recorder
+// stops the recording.
+func (codeEngine *CodeEngineV2) SetMetricsRecorder(recorder MetricsRecorder) {
+	if recorder == nil {
+		codeEngine.setTransportLayer(rankMetrics, nil)
+		return
+	}
+	codeEngine.setTransportLayer(rankMetrics, &meteredTransport{recorder: recorder})
+}
+
+// GetMetricsRecorder returns the metrics recorder of the service, or nil if no metrics are recorded.
+func (codeEngine *CodeEngineV2) GetMetricsRecorder() MetricsRecorder {
+	if layer, ok := codeEngine.getTransportLayer(rankMetrics).(*meteredTransport); ok {
+		return layer.recorder
+	}
+	return nil
+}
+
+// StatusClass returns the class of an HTTP status code, e.g. StatusClass4xx for 404, or StatusClassError for 0.
+func StatusClass(statusCode int) string {
+	if statusCode < 100 || statusCode > 599 {
+		return StatusClassError
+	}
+	return fmt.Sprintf("%dxx", statusCode/100)
+}
+
+// meteredTransport observes the requests of an HTTP client with a MetricsRecorder.
+type meteredTransport struct {
+	recorder MetricsRecorder
+	next     http.RoundTripper
+}
+
+func (transport *meteredTransport) rank() int {
+	return rankMetrics
+}
+
+func (transport *meteredTransport) wrap(next http.RoundTripper) transportLayer {
+	wrapped := *transport
+	wrapped.next = next
+	return &wrapped
+}
+
+func (transport *meteredTransport) unwrap() http.RoundTripper {
+	return transport.next
+}
+
+func (transport *meteredTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	call := operationOf(req)
+	resendCount := resendCount(req)
+	start := time.Now()
+	resp, err := transport.next.RoundTrip(req)
+
+	observation := RequestObservation{
+		Operation:   call.operation,
+		Method:      req.Method,
+		Duration:    time.Since(start),
+		ResendCount: resendCount,
+		Err:         err,
+	}
+	if err == nil && resp != nil {
+		observation.StatusCode = resp.StatusCode
+	}
+	observation.StatusClass = StatusClass(observation.StatusCode)
+	transport.recorder.ObserveRequest(observation)
+
+	if call.paginated && observation.StatusClass == StatusClass2xx {
+		transport.recorder.ObservePage(call.operation)
+	}
+	return resp, err
+}
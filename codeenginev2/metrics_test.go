@@ -0,0 +1,125 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codeenginev2_test
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/IBM/code-engine-go-sdk/codeenginev2"
+	"github.com/IBM/code-engine-go-sdk/codeenginev2/codeenginev2test"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// metricsRecorder records the observations of a service.
+type metricsRecorder struct {
+	mutex        sync.Mutex
+	observations []codeenginev2.RequestObservation
+	pages        []string
+}
+
+func (recorder *metricsRecorder) ObserveRequest(observation codeenginev2.RequestObservation) {
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+	recorder.observations = append(recorder.observations, observation)
+}
+
+func (recorder *metricsRecorder) ObservePage(operation string) {
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+	recorder.pages = append(recorder.pages, operation)
+}
+
+var _ = Describe(`CodeEngineV2 metrics`, func() {
+	var server *codeenginev2test.Server
+	var codeEngineService *codeenginev2.CodeEngineV2
+	var projectID string
+	var recorder *metricsRecorder
+
+	BeforeEach(func() {
+		server = codeenginev2test.NewServer()
+		var err error
+		codeEngineService, err = server.NewCodeEngineV2()
+		Expect(err).To(BeNil())
+		projectID = server.AddProject("my-project")
+		recorder = &metricsRecorder{}
+		codeEngineService.SetMetricsRecorder(recorder)
+	})
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It(`Observes each request with its operation, status class and duration`, func() {
+		_, _, err := codeEngineService.CreateConfigMap(codeEngineService.NewCreateConfigMapOptions(projectID, "settings"))
+		Expect(err).To(BeNil())
+		_, _, err = codeEngineService.Clone().GetConfigMap(codeEngineService.NewGetConfigMapOptions(projectID, "missing"))
+		Expect(codeenginev2.IsNotFound(err)).To(BeTrue())
+
+		Expect(recorder.observations).To(HaveLen(2))
+		created := recorder.observations[0]
+		Expect(created.Operation).To(Equal("CreateConfigMap"))
+		Expect(created.Method).To(Equal(http.MethodPost))
+		Expect(created.StatusCode).To(Equal(http.StatusCreated))
+		Expect(created.StatusClass).To(Equal(codeenginev2.StatusClass2xx))
+		Expect(created.Duration).To(BeNumerically(">", 0))
+		Expect(created.ResendCount).To(Equal(int64(0)))
+		Expect(recorder.observations[1].Operation).To(Equal("GetConfigMap"))
+		Expect(recorder.observations[1].StatusClass).To(Equal(codeenginev2.StatusClass4xx))
+		Expect(recorder.pages).To(BeEmpty())
+		Expect(codeEngineService.GetMetricsRecorder()).To(BeIdenticalTo(recorder))
+	})
+	It(`Observes the pages of the List operations`, func() {
+		for _, name := range []string{"a", "b", "c"} {
+			_, _, err := codeEngineService.CreateConfigMap(codeEngineService.NewCreateConfigMapOptions(projectID, name))
+			Expect(err).To(BeNil())
+		}
+		pager, err := codeEngineService.NewConfigMapsPager(codeEngineService.NewListConfigMapsOptions(projectID).SetLimit(2))
+		Expect(err).To(BeNil())
+		configMaps, err := pager.GetAll()
+		Expect(err).To(BeNil())
+		Expect(configMaps).To(HaveLen(3))
+
+		Expect(recorder.pages).To(Equal([]string{"ListConfigMaps", "ListConfigMaps"}))
+	})
+	It(`Observes the retries of a request`, func() {
+		codeEngineService.EnableRetries(2, 10*time.Millisecond)
+		server.FailNext(http.MethodGet, "/projects/"+projectID+"/config_maps", http.StatusServiceUnavailable, http.Header{"Retry-After": {"0"}})
+
+		_, _, err := codeEngineService.ListConfigMaps(codeEngineService.NewListConfigMapsOptions(projectID))
+		Expect(err).To(BeNil())
+		Expect(recorder.observations).To(HaveLen(2))
+		Expect(recorder.observations[0].StatusClass).To(Equal(codeenginev2.StatusClass5xx))
+		Expect(recorder.observations[1].ResendCount).To(Equal(int64(1)))
+		Expect(recorder.pages).To(HaveLen(1))
+	})
+	It(`Stops observing when the recorder is removed`, func() {
+		codeEngineService.SetMetricsRecorder(nil)
+		_, _, err := codeEngineService.ListConfigMaps(codeEngineService.NewListConfigMapsOptions(projectID))
+		Expect(err).To(BeNil())
+		Expect(recorder.observations).To(BeEmpty())
+		Expect(codeEngineService.GetMetricsRecorder()).To(BeNil())
+	})
+	It(`Classifies status codes`, func() {
+		Expect(codeenginev2.StatusClass(200)).To(Equal(codeenginev2.StatusClass2xx))
+		Expect(codeenginev2.StatusClass(304)).To(Equal(codeenginev2.StatusClass3xx))
+		Expect(codeenginev2.StatusClass(429)).To(Equal(codeenginev2.StatusClass4xx))
+		Expect(codeenginev2.StatusClass(503)).To(Equal(codeenginev2.StatusClass5xx))
+		Expect(codeenginev2.StatusClass(0)).To(Equal(codeenginev2.StatusClassError))
+	})
+})
@@ -0,0 +1,147 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codeenginev2
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"iter"
+
+	"github.com/IBM/code-engine-go-sdk/codeenginev2/internal/jsondiff"
+	common "github.com/IBM/code-engine-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Pager : The common interface of the pagers of the List operations, e.g. AppsPager, whose pages contain items of
+// type T.
+type Pager[T any] interface {
+	// HasNext returns true if there are potentially more results to be retrieved.
+	HasNext() bool
+
+	// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
+	GetNext() (page []T, err error)
+
+	// GetNextWithContext returns the next page of results using the specified Context.
+	GetNextWithContext(ctx context.Context) (page []T, err error)
+
+	// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
+	GetAll() (allItems []T, err error)
+
+	// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly until all pages of results
+	// have been retrieved.
+	GetAllWithContext(ctx context.Context) (allItems []T, err error)
+
+	// All returns an iterator over the items of the remaining pages, which are retrieved one page at a time while the
+	// iteration proceeds. An error ends the iteration after it has been yielded.
+	All(ctx context.Context) iter.Seq2[T, error]
+
+	// Checkpoint returns an opaque checkpoint of the pager, which covers its options, including the page size, and the
+	// start token of the next page.
+	Checkpoint() (checkpoint string, err error)
+}
+
+// allItems returns an iterator over the items of the remaining pages of the pager. The next page is only retrieved
+// when the items of the previous page have been consumed, so breaking the loop stops the retrieval.
+func allItems[T any](ctx context.Context, pager interface {
+	HasNext() bool
+	GetNextWithContext(ctx context.Context) ([]T, error)
+}) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for pager.HasNext() {
+			page, err := pager.GetNextWithContext(ctx)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			for _, item := range page {
+				if !yield(item, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// pagerCheckpoint is the content of the checkpoint of a pager.
+type pagerCheckpoint struct {
+	// The type of the pager, e.g. `JobRunsPager`.
+	Pager string `json:"pager"`
+
+	// The options of the pager without the start token and the headers.
+	Options json.RawMessage `json:"options"`
+
+	// The start token of the next page, or nil for the first page.
+	Next *string `json:"next,omitempty"`
+
+	// Whether all pages have been retrieved.
+	Done bool `json:"done,omitempty"`
+}
+
+// encodePagerCheckpoint returns the checkpoint of a pager as base64 encoded JSON. The headers of the options are left
+// out, since they may contain credentials and checkpoints are meant to be stored.
+func encodePagerCheckpoint(pager string, options interface{}, next *string, hasNext bool) (string, error) {
+	fields, err := jsondiff.ToObject(options)
+	if err != nil {
+		return "", core.SDKErrorf(err, "", "checkpoint-encode-error", common.GetComponentInfo())
+	}
+	delete(fields, "start")
+	delete(fields, "Headers")
+	encodedOptions, err := json.Marshal(fields)
+	if err != nil {
+		return "", core.SDKErrorf(err, "", "checkpoint-encode-error", common.GetComponentInfo())
+	}
+	data, err := json.Marshal(&pagerCheckpoint{Pager: pager, Options: encodedOptions, Next: next, Done: !hasNext})
+	if err != nil {
+		return "", core.SDKErrorf(err, "", "checkpoint-encode-error", common.GetComponentInfo())
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// decodePagerCheckpoint decodes the checkpoint of a pager into the options and returns the start token of the next
+// page and whether there are more pages. Headers are never taken from a checkpoint.
+func decodePagerCheckpoint(pager string, checkpoint string, options interface{}) (next *string, hasNext bool, err error) {
+	data, err := base64.RawURLEncoding.DecodeString(checkpoint)
+	if err != nil {
+		err = core.SDKErrorf(err, "the checkpoint is not valid", "invalid-checkpoint", common.GetComponentInfo())
+		return
+	}
+	var decoded pagerCheckpoint
+	var fields map[string]json.RawMessage
+	err = json.Unmarshal(data, &decoded)
+	if err == nil {
+		err = json.Unmarshal(decoded.Options, &fields)
+	}
+	if err == nil {
+		delete(fields, "Headers")
+		decoded.Options, err = json.Marshal(fields)
+	}
+	if err == nil {
+		err = json.Unmarshal(decoded.Options, options)
+	}
+	if err != nil {
+		err = core.SDKErrorf(err, "the checkpoint is not valid", "invalid-checkpoint", common.GetComponentInfo())
+		return
+	}
+	if decoded.Pager != pager {
+		err = core.SDKErrorf(nil, fmt.Sprintf("the checkpoint belongs to a %s, not a %s", decoded.Pager, pager), "checkpoint-mismatch", common.GetComponentInfo())
+		return
+	}
+	return decoded.Next, !decoded.Done, nil
+}
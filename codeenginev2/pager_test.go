@@ -0,0 +1,168 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codeenginev2_test
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+
+	"github.com/IBM/code-engine-go-sdk/codeenginev2"
+	"github.com/IBM/code-engine-go-sdk/codeenginev2/codeenginev2test"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// names collects the names of the items of any pager.
+func names[T any](ctx context.Context, pager codeenginev2.Pager[T], name func(T) string) (result []string, err error) {
+	for item, err := range pager.All(ctx) {
+		if err != nil {
+			return result, err
+		}
+		result = append(result, name(item))
+	}
+	return result, nil
+}
+
+var _ = Describe(`CodeEngineV2 pagers`, func() {
+	var server *codeenginev2test.Server
+	var codeEngineService *codeenginev2.CodeEngineV2
+	var projectID string
+	ctx := context.Background()
+
+	BeforeEach(func() {
+		server = codeenginev2test.NewServer()
+		var err error
+		codeEngineService, err = server.NewCodeEngineV2()
+		Expect(err).To(BeNil())
+		projectID = server.AddProject("my-project")
+		for i := 1; i <= 5; i++ {
+			_, _, err := codeEngineService.CreateConfigMap(codeEngineService.NewCreateConfigMapOptions(projectID, fmt.Sprintf("config-%d", i)))
+			Expect(err).To(BeNil())
+		}
+	})
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It(`Iterates over the items of all pages`, func() {
+		pager, err := codeEngineService.NewConfigMapsPager(codeEngineService.NewListConfigMapsOptions(projectID).SetLimit(2))
+		Expect(err).To(BeNil())
+
+		result, err := names(ctx, pager, func(configMap codeenginev2.ConfigMap) string { return *configMap.Name })
+		Expect(err).To(BeNil())
+		Expect(result).To(Equal([]string{"config-1", "config-2", "config-3", "config-4", "config-5"}))
+		Expect(pager.HasNext()).To(BeFalse())
+	})
+	It(`Stops retrieving pages when the loop breaks`, func() {
+		pager, err := codeEngineService.NewConfigMapsPager(codeEngineService.NewListConfigMapsOptions(projectID).SetLimit(2))
+		Expect(err).To(BeNil())
+
+		var result []string
+		for configMap, err := range pager.All(ctx) {
+			Expect(err).To(BeNil())
+			result = append(result, *configMap.Name)
+			if len(result) == 3 {
+				break
+			}
+		}
+		Expect(result).To(Equal([]string{"config-1", "config-2", "config-3"}))
+		Expect(pager.HasNext()).To(BeTrue())
+
+		page, err := pager.GetNext()
+		Expect(err).To(BeNil())
+		Expect(page).To(HaveLen(1))
+		Expect(*page[0].Name).To(Equal("config-5"))
+	})
+	It(`Yields the error of a page and ends the iteration`, func() {
+		pager, err := codeEngineService.NewConfigMapsPager(codeEngineService.NewListConfigMapsOptions(projectID).SetLimit(2))
+		Expect(err).To(BeNil())
+		_, err = pager.GetNext()
+		Expect(err).To(BeNil())
+		server.FailNext(http.MethodGet, "/projects/"+projectID+"/config_maps", http.StatusInternalServerError, nil)
+		codeEngineService.DisableRetries()
+
+		count := 0
+		var iterationErr error
+		for _, err := range pager.All(ctx) {
+			if err != nil {
+				iterationErr = err
+				continue
+			}
+			count++
+		}
+		Expect(iterationErr).ToNot(BeNil())
+		Expect(count).To(Equal(0))
+	})
+	It(`Resumes the pagination from a checkpoint`, func() {
+		pager, err := codeEngineService.NewConfigMapsPager(codeEngineService.NewListConfigMapsOptions(projectID).SetLimit(2))
+		Expect(err).To(BeNil())
+		page, err := pager.GetNext()
+		Expect(err).To(BeNil())
+		Expect(page).To(HaveLen(2))
+		checkpoint, err := pager.Checkpoint()
+		Expect(err).To(BeNil())
+
+		resumed, err := codeEngineService.NewConfigMapsPagerFromCheckpoint(checkpoint)
+		Expect(err).To(BeNil())
+		page, err = resumed.GetNext()
+		Expect(err).To(BeNil())
+		Expect(page).To(HaveLen(2))
+		Expect(*page[0].Name).To(Equal("config-3"))
+
+		result, err := names(ctx, resumed, func(configMap codeenginev2.ConfigMap) string { return *configMap.Name })
+		Expect(err).To(BeNil())
+		Expect(result).To(Equal([]string{"config-5"}))
+
+		checkpoint, err = resumed.Checkpoint()
+		Expect(err).To(BeNil())
+		finished, err := codeEngineService.NewConfigMapsPagerFromCheckpoint(checkpoint)
+		Expect(err).To(BeNil())
+		Expect(finished.HasNext()).To(BeFalse())
+	})
+	It(`Leaves the headers out of the checkpoint`, func() {
+		listConfigMapsOptions := codeEngineService.NewListConfigMapsOptions(projectID).
+			SetLimit(2).
+			SetHeaders(map[string]string{"Authorization": "Bearer s3cr3t"})
+		pager, err := codeEngineService.NewConfigMapsPager(listConfigMapsOptions)
+		Expect(err).To(BeNil())
+		_, err = pager.GetNext()
+		Expect(err).To(BeNil())
+		checkpoint, err := pager.Checkpoint()
+		Expect(err).To(BeNil())
+
+		data, err := base64.RawURLEncoding.DecodeString(checkpoint)
+		Expect(err).To(BeNil())
+		Expect(string(data)).To(ContainSubstring(`"limit":2`))
+		Expect(string(data)).ToNot(ContainSubstring("Headers"))
+		Expect(string(data)).ToNot(ContainSubstring("s3cr3t"))
+	})
+	It(`Rejects invalid checkpoints and checkpoints of other pagers`, func() {
+		pager, err := codeEngineService.NewConfigMapsPager(codeEngineService.NewListConfigMapsOptions(projectID))
+		Expect(err).To(BeNil())
+		checkpoint, err := pager.Checkpoint()
+		Expect(err).To(BeNil())
+
+		_, err = codeEngineService.NewSecretsPagerFromCheckpoint(checkpoint)
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(Equal("the checkpoint belongs to a ConfigMapsPager, not a SecretsPager"))
+
+		_, err = codeEngineService.NewConfigMapsPagerFromCheckpoint("not a checkpoint")
+		Expect(err).ToNot(BeNil())
+	})
+})
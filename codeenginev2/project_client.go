@@ -0,0 +1,71 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codeenginev2
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// ProjectClient : The operations of a project
+// A client of the operations of a project, with the ID of the project bound, e.g. `Apps().Create` creates an app in
+// the project. The operations of the resource groups, e.g. Apps and Secrets, take a context and the options of the
+// corresponding operation of CodeEngineV2, whose project ID is set by the client; per-call headers are set on the
+// options as usual. Project clients are cheap and can be created for each use.
+type ProjectClient struct {
+	codeEngine *CodeEngineV2
+	projectID  string
+}
+
+// Project returns the client of the operations of the project with the ID.
+func (codeEngine *CodeEngineV2) Project(projectID string) *ProjectClient {
+	return &ProjectClient{codeEngine: codeEngine, projectID: projectID}
+}
+
+// ResolveProject returns the client of the operations of a project, which is given by its ID or its name, see
+// ResolveProjectID.
+func (codeEngine *CodeEngineV2) ResolveProject(ctx context.Context, project string) (*ProjectClient, error) {
+	id, err := codeEngine.ResolveProjectID(ctx, project)
+	if err != nil {
+		return nil, err
+	}
+	return codeEngine.Project(id), nil
+}
+
+// ID returns the ID of the project.
+func (project *ProjectClient) ID() string {
+	return project.projectID
+}
+
+// Service returns the service of the client.
+func (project *ProjectClient) Service() *CodeEngineV2 {
+	return project.codeEngine
+}
+
+// Run : Run a job
+// Create a job run of the job with the name in the project. The JobName of the options is set to the name; nil options
+// are treated as empty options, which run the job with its configuration.
+func (jobs *ProjectJobs) Run(ctx context.Context, jobName string, createJobRunOptions *CreateJobRunOptions) (result *JobRun, response *core.DetailedResponse, err error) {
+	options := CreateJobRunOptions{}
+	if createJobRunOptions != nil {
+		options = *createJobRunOptions
+	}
+	options.ProjectID = core.StringPtr(jobs.projectID)
+	options.JobName = core.StringPtr(jobName)
+	return jobs.codeEngine.CreateJobRunWithContext(ctx, &options)
+}
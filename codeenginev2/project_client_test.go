@@ -0,0 +1,137 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codeenginev2_test
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/IBM/code-engine-go-sdk/codeenginev2"
+	"github.com/IBM/code-engine-go-sdk/codeenginev2/codeenginev2test"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`CodeEngineV2 project client`, func() {
+	var server *codeenginev2test.Server
+	var codeEngineService *codeenginev2.CodeEngineV2
+	var projectID string
+	var project *codeenginev2.ProjectClient
+	ctx := context.Background()
+
+	BeforeEach(func() {
+		server = codeenginev2test.NewServer()
+		var err error
+		codeEngineService, err = server.NewCodeEngineV2()
+		Expect(err).To(BeNil())
+		projectID = server.AddProject("my-project")
+		project = codeEngineService.Project(projectID)
+	})
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It(`Binds the project ID to the operations of the resource groups`, func() {
+		Expect(project.ID()).To(Equal(projectID))
+		Expect(project.Service()).To(BeIdenticalTo(codeEngineService))
+
+		apps := project.Apps()
+		app, _, err := apps.Create(ctx, apps.NewCreateOptions("icr.io/codeengine/helloworld", "frontend"))
+		Expect(err).To(BeNil())
+		Expect(*app.ProjectID).To(Equal(projectID))
+		app, _, err = apps.Get(ctx, apps.NewGetOptions("frontend"))
+		Expect(err).To(BeNil())
+		Expect(*app.Name).To(Equal("frontend"))
+		appList, _, err := apps.List(ctx, nil)
+		Expect(err).To(BeNil())
+		Expect(appList.Apps).To(HaveLen(1))
+
+		// The project ID of the options is replaced, so options of another project cannot escape the client.
+		_, _, err = codeEngineService.Project(server.AddProject("other-project")).Apps().Get(ctx, apps.NewGetOptions("frontend"))
+		Expect(codeenginev2.IsNotFound(err)).To(BeTrue())
+
+		secrets := project.Secrets()
+		secret, _, err := secrets.Create(ctx, secrets.NewCreateOptions(codeenginev2.CreateSecretOptions_Format_Generic, "credentials").
+			SetData(&codeenginev2.SecretDataGenericSecretData{}))
+		Expect(err).To(BeNil())
+		_, _, err = secrets.Replace(ctx, secrets.NewReplaceOptions("credentials", *secret.EntityTag, codeenginev2.ReplaceSecretOptions_Format_Generic))
+		Expect(err).To(BeNil())
+		_, err = secrets.Delete(ctx, secrets.NewDeleteOptions("credentials"))
+		Expect(err).To(BeNil())
+
+		result, _, err := project.Get(ctx, nil)
+		Expect(err).To(BeNil())
+		Expect(*result.Name).To(Equal("my-project"))
+	})
+	It(`Runs a job of the project`, func() {
+		jobs := project.Jobs()
+		_, _, err := jobs.Create(ctx, jobs.NewCreateOptions("icr.io/codeengine/import", "import"))
+		Expect(err).To(BeNil())
+
+		jobRun, _, err := jobs.Run(ctx, "import", project.JobRuns().NewCreateOptions().SetName("import-1"))
+		Expect(err).To(BeNil())
+		Expect(*jobRun.JobName).To(Equal("import"))
+		Expect(*jobRun.ProjectID).To(Equal(projectID))
+		_, _, err = jobs.Run(ctx, "import", nil)
+		Expect(err).To(BeNil())
+
+		pager, err := project.JobRuns().NewPager(project.JobRuns().NewListOptions().SetLimit(1))
+		Expect(err).To(BeNil())
+		jobRuns, err := pager.GetAll()
+		Expect(err).To(BeNil())
+		Expect(jobRuns).To(HaveLen(2))
+	})
+	It(`Supports contexts and per-call headers`, func() {
+		client := codeEngineService.Service.GetHTTPClient()
+		recorder := &headerRecorder{next: client.Transport}
+		client.Transport = recorder
+		server.FailNext(http.MethodGet, "/projects/"+projectID+"/config_maps", http.StatusInternalServerError, nil)
+		configMaps := project.ConfigMaps()
+		_, response, err := configMaps.List(ctx, configMaps.NewListOptions().SetHeaders(map[string]string{"X-Request-Id": "my-request"}))
+		Expect(err).ToNot(BeNil())
+		Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+		Expect(recorder.header.Get("X-Request-Id")).To(Equal("my-request"))
+
+		cancelled, cancel := context.WithCancel(ctx)
+		cancel()
+		_, _, err = configMaps.Create(cancelled, configMaps.NewCreateOptions("settings"))
+		Expect(err).To(MatchError(ContainSubstring("context canceled")))
+	})
+	It(`Resolves a project name`, func() {
+		resolved, err := codeEngineService.ResolveProject(ctx, "my-project")
+		Expect(err).To(BeNil())
+		Expect(resolved.ID()).To(Equal(projectID))
+
+		_, err = codeEngineService.ResolveProject(ctx, "unknown")
+		Expect(codeenginev2.IsNotFound(err)).To(BeTrue())
+	})
+})
+
+// headerRecorder records the header of the last request.
+type headerRecorder struct {
+	next   http.RoundTripper
+	header http.Header
+}
+
+func (recorder *headerRecorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	recorder.header = req.Header.Clone()
+	next := recorder.next
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return next.RoundTrip(req)
+}
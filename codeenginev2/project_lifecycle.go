@@ -0,0 +1,413 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codeenginev2
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	common "github.com/IBM/code-engine-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// DefaultResourceControllerURL is the URL of the IBM Cloud Resource Controller API, which keeps the reclamations of
+// deleted projects.
+const DefaultResourceControllerURL = "https://resource-controller.cloud.ibm.com"
+
+// WaitForProjectActive polls the specified project until its status is Project_Status_Active or
+// Project_Status_CreationFailed. A project that is not found or reported as not yet active is polled again, since a
+// newly created project may not be readable yet. If the creation fails, the returned error wraps a
+// *ResourceFailedError. The last observed project is returned in all cases where it could be retrieved.
+func (codeEngine *CodeEngineV2) WaitForProjectActive(ctx context.Context, projectID string, waitOptions *WaitOptions) (result *Project, err error) {
+	projectID, err = codeEngine.ResolveProjectID(ctx, projectID)
+	if err != nil {
+		return
+	}
+	getProjectOptions := codeEngine.NewGetProjectOptions(projectID)
+	getProjectOptions.Headers = waitOptions.headers()
+
+	err = waitOptions.poll(ctx, func(ctx context.Context) (bool, error) {
+		project, _, err := codeEngine.GetProjectWithContext(ctx, getProjectOptions)
+		if IsNotFound(err) || isProjectNotYetActive(err) {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		result = project
+
+		switch core.StringNilMapper(project.Status) {
+		case Project_Status_Active:
+			return true, nil
+		case Project_Status_CreationFailed:
+			return true, &ResourceFailedError{
+				ResourceType: "project",
+				ProjectID:    projectID,
+				Name:         core.StringNilMapper(project.Name),
+				Status:       Project_Status_CreationFailed,
+			}
+		}
+		return false, nil
+	})
+	if err != nil {
+		err = core.SDKErrorf(err, "", "wait-project-active-error", common.GetComponentInfo())
+	}
+	return
+}
+
+// isProjectNotYetActive returns true if the error reports that the project is not yet active, which the API reports
+// for a project that is still being created.
+func isProjectNotYetActive(err error) bool {
+	apiErr, ok := AsAPIError(err)
+	return ok && strings.Contains(strings.ToLower(apiErr.Message), "not yet active")
+}
+
+// CreateProjectAndWait : Create a project and wait until it is active
+// Create a project with CreateProject and poll it with WaitForProjectActive. The created project is returned if the
+// wait fails.
+func (codeEngine *CodeEngineV2) CreateProjectAndWait(ctx context.Context, createProjectOptions *CreateProjectOptions, waitOptions *WaitOptions) (result *Project, err error) {
+	result, _, err = codeEngine.CreateProjectWithContext(ctx, createProjectOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "create-project-error")
+		return
+	}
+	project, err := codeEngine.WaitForProjectActive(ctx, core.StringNilMapper(result.ID), waitOptions)
+	if project != nil {
+		result = project
+	}
+	return
+}
+
+// ProjectReclaimer : Purges the reclamations of deleted projects
+// A deleted project is soft-deleted: IBM Cloud keeps a reclamation of it for a retention period, during which the
+// project can be restored and its name cannot be reused. Reclaiming the project deletes it permanently. The
+// ResourceControllerReclaimer reclaims projects through the Resource Controller API; tests can replace it with a local
+// stand-in, e.g. the reclaimer of the codeenginev2test server.
+type ProjectReclaimer interface {
+	// ReclaimProject permanently deletes the soft-deleted project.
+	ReclaimProject(ctx context.Context, project *Project) error
+}
+
+// ProjectReclaimerFunc : A function that implements ProjectReclaimer
+type ProjectReclaimerFunc func(ctx context.Context, project *Project) error
+
+// ReclaimProject calls the function.
+func (reclaimerFunc ProjectReclaimerFunc) ReclaimProject(ctx context.Context, project *Project) error {
+	return reclaimerFunc(ctx, project)
+}
+
+// DeleteProjectAndWaitOptions : The DeleteProjectAndWait options.
+type DeleteProjectAndWaitOptions struct {
+	// The ID or name of the project.
+	ID *string `validate:"required,ne="`
+
+	// Whether to purge the reclamation of the project once it is soft-deleted, which deletes the project permanently
+	// and frees its name.
+	Purge bool
+
+	// The reclaimer that purges the reclamation. Defaults to a ResourceControllerReclaimer with the authenticator of
+	// the service.
+	Reclaimer ProjectReclaimer
+
+	// The options of the polling of the project, including the headers of the polling requests. The timeout bounds
+	// the whole deletion.
+	WaitOptions *WaitOptions
+
+	// Allows users to set headers on the delete request.
+	Headers map[string]string
+}
+
+// NewDeleteProjectAndWaitOptions : Instantiate DeleteProjectAndWaitOptions
+func (*CodeEngineV2) NewDeleteProjectAndWaitOptions(id string) *DeleteProjectAndWaitOptions {
+	return &DeleteProjectAndWaitOptions{
+		ID: core.StringPtr(id),
+	}
+}
+
+// SetID : Allow user to set ID
+func (_options *DeleteProjectAndWaitOptions) SetID(id string) *DeleteProjectAndWaitOptions {
+	_options.ID = core.StringPtr(id)
+	return _options
+}
+
+// SetPurge : Allow user to set Purge
+func (_options *DeleteProjectAndWaitOptions) SetPurge(purge bool) *DeleteProjectAndWaitOptions {
+	_options.Purge = purge
+	return _options
+}
+
+// SetReclaimer : Allow user to set Reclaimer
+func (_options *DeleteProjectAndWaitOptions) SetReclaimer(reclaimer ProjectReclaimer) *DeleteProjectAndWaitOptions {
+	_options.Reclaimer = reclaimer
+	return _options
+}
+
+// SetWaitOptions : Allow user to set WaitOptions
+func (_options *DeleteProjectAndWaitOptions) SetWaitOptions(waitOptions *WaitOptions) *DeleteProjectAndWaitOptions {
+	_options.WaitOptions = waitOptions
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *DeleteProjectAndWaitOptions) SetHeaders(param map[string]string) *DeleteProjectAndWaitOptions {
+	options.Headers = param
+	return options
+}
+
+// DeleteProjectAndWait : Delete a project and wait until it is deleted
+// Delete a project with DeleteProject and poll it until it is soft-deleted. If Purge is set, the reclamation of the
+// soft-deleted project is purged with the reclaimer and the project is polled until it no longer exists. A project
+// that no longer exists is deleted, so the deletion can be repeated. Once the project is deleted, the project
+// resolver of the service forgets its names, so that a project that is recreated with the same name is resolved
+// again. If the deletion fails, the returned error wraps a *ResourceFailedError. The last observed project is
+// returned, or nil if the project no longer exists.
+func (codeEngine *CodeEngineV2) DeleteProjectAndWait(ctx context.Context, deleteProjectAndWaitOptions *DeleteProjectAndWaitOptions) (result *Project, err error) {
+	err = core.ValidateNotNil(deleteProjectAndWaitOptions, "deleteProjectAndWaitOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(deleteProjectAndWaitOptions, "deleteProjectAndWaitOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	projectID, err := codeEngine.ResolveProjectID(ctx, *deleteProjectAndWaitOptions.ID)
+	if err != nil {
+		return
+	}
+	defer func() {
+		if err == nil && codeEngine.projectResolver != nil {
+			codeEngine.projectResolver.forgetID(projectID)
+		}
+	}()
+
+	// The timeout bounds the whole deletion rather than each poll.
+	var waitOptions WaitOptions
+	if deleteProjectAndWaitOptions.WaitOptions != nil {
+		waitOptions = *deleteProjectAndWaitOptions.WaitOptions
+	}
+	if waitOptions.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, waitOptions.Timeout)
+		defer cancel()
+		waitOptions.Timeout = 0
+	}
+
+	deleteProjectOptions := codeEngine.NewDeleteProjectOptions(projectID)
+	deleteProjectOptions.Headers = deleteProjectAndWaitOptions.Headers
+	_, err = codeEngine.DeleteProjectWithContext(ctx, deleteProjectOptions)
+	if err != nil && !IsNotFound(err) {
+		err = core.RepurposeSDKProblem(err, "delete-project-error")
+		return
+	}
+
+	result, err = codeEngine.waitForProjectDeletion(ctx, projectID, &waitOptions, Project_Status_SoftDeleted, Project_Status_DeletionFailed)
+	if err != nil || result == nil || !deleteProjectAndWaitOptions.Purge || core.StringNilMapper(result.Status) == Project_Status_HardDeleted {
+		return
+	}
+
+	reclaimer := deleteProjectAndWaitOptions.Reclaimer
+	if reclaimer == nil {
+		reclaimer, err = NewResourceControllerReclaimer(NewResourceControllerReclaimerOptions(codeEngine.Service.Options.Authenticator))
+		if err != nil {
+			return
+		}
+	}
+	err = reclaimer.ReclaimProject(ctx, result)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "reclaim-project-error", common.GetComponentInfo())
+		return
+	}
+	project, err := codeEngine.waitForProjectDeletion(ctx, projectID, &waitOptions, Project_Status_HardDeleted, Project_Status_HardDeletionFailed)
+	if err == nil || project != nil {
+		result = project
+	}
+	return
+}
+
+// waitForProjectDeletion polls the project until it no longer exists or its status is the deleted status, which
+// includes Project_Status_HardDeleted, or the failed status. Nil is returned if the project no longer exists.
+func (codeEngine *CodeEngineV2) waitForProjectDeletion(ctx context.Context, projectID string, waitOptions *WaitOptions, deletedStatus string, failedStatus string) (result *Project, err error) {
+	getProjectOptions := codeEngine.NewGetProjectOptions(projectID)
+	getProjectOptions.Headers = waitOptions.headers()
+
+	err = waitOptions.poll(ctx, func(ctx context.Context) (bool, error) {
+		project, _, err := codeEngine.GetProjectWithContext(ctx, getProjectOptions)
+		if IsNotFound(err) {
+			result = nil
+			return true, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		result = project
+
+		switch status := core.StringNilMapper(project.Status); status {
+		case deletedStatus, Project_Status_HardDeleted:
+			return true, nil
+		case failedStatus:
+			return true, &ResourceFailedError{
+				ResourceType: "project",
+				ProjectID:    projectID,
+				Name:         core.StringNilMapper(project.Name),
+				Status:       status,
+			}
+		}
+		return false, nil
+	})
+	if err != nil {
+		err = core.SDKErrorf(err, "", "wait-project-deleted-error", common.GetComponentInfo())
+	}
+	return
+}
+
+// ResourceControllerReclaimerOptions : The options of a ResourceControllerReclaimer.
+type ResourceControllerReclaimerOptions struct {
+	// The authenticator of the requests.
+	Authenticator core.Authenticator `validate:"required"`
+
+	// The URL of the Resource Controller API. Defaults to DefaultResourceControllerURL.
+	URL string
+}
+
+// NewResourceControllerReclaimerOptions : Instantiate ResourceControllerReclaimerOptions
+func NewResourceControllerReclaimerOptions(authenticator core.Authenticator) *ResourceControllerReclaimerOptions {
+	return &ResourceControllerReclaimerOptions{
+		Authenticator: authenticator,
+	}
+}
+
+// SetAuthenticator : Allow user to set Authenticator
+func (_options *ResourceControllerReclaimerOptions) SetAuthenticator(authenticator core.Authenticator) *ResourceControllerReclaimerOptions {
+	_options.Authenticator = authenticator
+	return _options
+}
+
+// SetURL : Allow user to set URL
+func (_options *ResourceControllerReclaimerOptions) SetURL(url string) *ResourceControllerReclaimerOptions {
+	_options.URL = url
+	return _options
+}
+
+// ResourceControllerReclaimer : A ProjectReclaimer that uses the IBM Cloud Resource Controller API
+// The reclaimer looks up the scheduled reclamation of the resource instance of the project, whose ID is the ID of the
+// project, and runs its `reclaim` action.
+type ResourceControllerReclaimer struct {
+	Service *core.BaseService
+}
+
+// NewResourceControllerReclaimer : constructs a ResourceControllerReclaimer with the options.
+func NewResourceControllerReclaimer(options *ResourceControllerReclaimerOptions) (reclaimer *ResourceControllerReclaimer, err error) {
+	err = core.ValidateNotNil(options, "options cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(options, "options")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	url := options.URL
+	if url == "" {
+		url = DefaultResourceControllerURL
+	}
+	service, err := core.NewBaseService(&core.ServiceOptions{URL: url, Authenticator: options.Authenticator})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "new-base-error")
+		return
+	}
+	reclaimer = &ResourceControllerReclaimer{Service: service}
+	return
+}
+
+// reclamation is a reclamation of the Resource Controller API.
+type reclamation struct {
+	ID                 string `json:"id"`
+	EntityCRN          string `json:"entity_crn"`
+	ResourceInstanceID string `json:"resource_instance_id"`
+	State              string `json:"state"`
+}
+
+// ReclaimProject runs the `reclaim` action of the scheduled reclamation of the project. The error matches ErrNotFound
+// if the project has no scheduled reclamation.
+func (reclaimer *ResourceControllerReclaimer) ReclaimProject(ctx context.Context, project *Project) error {
+	err := core.ValidateNotNil(project, "project cannot be nil")
+	if err != nil {
+		return core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+	}
+	projectID := core.StringNilMapper(project.ID)
+
+	builder := core.NewRequestBuilder(core.GET).WithContext(ctx)
+	_, err = builder.ResolveRequestURL(reclaimer.Service.Options.URL, `/v2/reclamations`, nil)
+	if err != nil {
+		return core.SDKErrorf(err, "", "url-resolve-error", common.GetComponentInfo())
+	}
+	builder.AddHeader("Accept", "application/json")
+	builder.AddQuery("resource_instance_id", projectID)
+	if project.AccountID != nil {
+		builder.AddQuery("account_id", *project.AccountID)
+	}
+	request, err := builder.Build()
+	if err != nil {
+		return core.SDKErrorf(err, "", "build-error", common.GetComponentInfo())
+	}
+	var reclamations struct {
+		Resources []reclamation `json:"resources"`
+	}
+	_, err = reclaimer.Service.Request(request, &reclamations)
+	if err != nil {
+		return core.SDKErrorf(err, "", "list-reclamations-error", common.GetComponentInfo())
+	}
+
+	var scheduled *reclamation
+	for i, candidate := range reclamations.Resources {
+		if candidate.ResourceInstanceID == projectID && strings.EqualFold(candidate.State, "SCHEDULED") {
+			scheduled = &reclamations.Resources[i]
+			break
+		}
+	}
+	if scheduled == nil {
+		err = fmt.Errorf("project '%s' has no scheduled reclamation: %w", projectID, ErrNotFound)
+		return core.SDKErrorf(err, "", "reclamation-not-found", common.GetComponentInfo())
+	}
+
+	builder = core.NewRequestBuilder(core.POST).WithContext(ctx)
+	_, err = builder.ResolveRequestURL(reclaimer.Service.Options.URL, `/v2/reclamations/{id}/{action_name}`, map[string]string{
+		"id":          scheduled.ID,
+		"action_name": "reclaim",
+	})
+	if err != nil {
+		return core.SDKErrorf(err, "", "url-resolve-error", common.GetComponentInfo())
+	}
+	builder.AddHeader("Accept", "application/json")
+	_, err = builder.SetBodyContentJSON(map[string]interface{}{})
+	if err != nil {
+		return core.SDKErrorf(err, "", "set-json-body-error", common.GetComponentInfo())
+	}
+	request, err = builder.Build()
+	if err != nil {
+		return core.SDKErrorf(err, "", "build-error", common.GetComponentInfo())
+	}
+	_, err = reclaimer.Service.Request(request, nil)
+	if err != nil {
+		return core.SDKErrorf(err, "", "reclaim-error", common.GetComponentInfo())
+	}
+	return nil
+}
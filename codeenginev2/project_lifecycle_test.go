@@ -0,0 +1,220 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codeenginev2_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/IBM/code-engine-go-sdk/codeenginev2"
+	"github.com/IBM/code-engine-go-sdk/codeenginev2/codeenginev2test"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`CodeEngineV2 project lifecycle`, func() {
+	var server *codeenginev2test.Server
+	var codeEngineService *codeenginev2.CodeEngineV2
+	var waitOptions *codeenginev2.WaitOptions
+	ctx := context.Background()
+
+	BeforeEach(func() {
+		server = codeenginev2test.NewServer()
+		var err error
+		codeEngineService, err = server.NewCodeEngineV2()
+		Expect(err).To(BeNil())
+		waitOptions = codeenginev2.NewWaitOptions().SetInitialInterval(time.Millisecond).SetTimeout(5 * time.Second)
+	})
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It(`Creates a project and waits until it is active`, func() {
+		server.ScriptStatus("projects",
+			codeenginev2test.StatusStep{Status: codeenginev2.Project_Status_Creating},
+			codeenginev2test.StatusStep{Status: codeenginev2.Project_Status_Preparing},
+			codeenginev2test.StatusStep{Status: codeenginev2.Project_Status_Active},
+		)
+		project, err := codeEngineService.CreateProjectAndWait(ctx, codeEngineService.NewCreateProjectOptions("my-project"), waitOptions)
+		Expect(err).To(BeNil())
+		Expect(*project.Name).To(Equal("my-project"))
+		Expect(*project.Status).To(Equal(codeenginev2.Project_Status_Active))
+	})
+	It(`Reports a failed project creation`, func() {
+		server.ScriptStatus("projects",
+			codeenginev2test.StatusStep{Status: codeenginev2.Project_Status_Creating},
+			codeenginev2test.StatusStep{Status: codeenginev2.Project_Status_CreationFailed},
+		)
+		project, err := codeEngineService.CreateProjectAndWait(ctx, codeEngineService.NewCreateProjectOptions("my-project"), waitOptions)
+		Expect(err).ToNot(BeNil())
+		var failedErr *codeenginev2.ResourceFailedError
+		Expect(errors.As(err, &failedErr)).To(BeTrue())
+		Expect(failedErr.ResourceType).To(Equal("project"))
+		Expect(failedErr.Name).To(Equal("my-project"))
+		Expect(*project.Status).To(Equal(codeenginev2.Project_Status_CreationFailed))
+
+		_, err = codeEngineService.CreateProjectAndWait(ctx, codeEngineService.NewCreateProjectOptions("my-project"), waitOptions)
+		Expect(codeenginev2.IsConflict(err)).To(BeTrue())
+	})
+	It(`Keeps polling a project that is not found or not yet active`, func() {
+		projectID := "15314cc3-85b4-4338-903f-c28cdee6d005"
+		var polls int
+		codeEngineServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			Expect(req.URL.Path).To(Equal("/projects/" + projectID))
+			res.Header().Set("Content-Type", "application/json")
+			polls++
+			switch polls {
+			case 1:
+				res.WriteHeader(http.StatusNotFound)
+				res.Write([]byte(`{"errors": [{"code": "project_not_found", "message": "Project not found"}], "status_code": 404}`))
+			case 2:
+				res.WriteHeader(http.StatusBadRequest)
+				res.Write([]byte(`{"errors": [{"code": "project_not_active", "message": "Project is not yet active"}], "status_code": 400}`))
+			default:
+				res.Write([]byte(`{"id": "` + projectID + `", "name": "my-project", "status": "active"}`))
+			}
+		}))
+		defer codeEngineServer.Close()
+		service, err := codeenginev2.NewCodeEngineV2(&codeenginev2.CodeEngineV2Options{
+			URL:           codeEngineServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+
+		project, err := service.WaitForProjectActive(ctx, projectID, waitOptions)
+		Expect(err).To(BeNil())
+		Expect(*project.Status).To(Equal(codeenginev2.Project_Status_Active))
+		Expect(polls).To(Equal(3))
+	})
+	It(`Deletes a project and waits until it is soft-deleted`, func() {
+		projectID := server.AddProject("my-project")
+		project, err := codeEngineService.DeleteProjectAndWait(ctx, codeEngineService.NewDeleteProjectAndWaitOptions("my-project").
+			SetWaitOptions(waitOptions))
+		Expect(err).To(BeNil())
+		Expect(*project.ID).To(Equal(projectID))
+		Expect(*project.Status).To(Equal(codeenginev2.Project_Status_SoftDeleted))
+
+		// The name of a soft-deleted project cannot be reused.
+		_, _, err = codeEngineService.CreateProject(codeEngineService.NewCreateProjectOptions("my-project"))
+		Expect(codeenginev2.IsConflict(err)).To(BeTrue())
+	})
+	It(`Deletes a project and purges its reclamation`, func() {
+		projectID := server.AddProject("my-project")
+		project, err := codeEngineService.DeleteProjectAndWait(ctx, codeEngineService.NewDeleteProjectAndWaitOptions(projectID).
+			SetPurge(true).
+			SetReclaimer(server.ProjectReclaimer()).
+			SetWaitOptions(waitOptions))
+		Expect(err).To(BeNil())
+		Expect(project).To(BeNil())
+		Expect(server.Resource("", "projects", projectID)).To(BeNil())
+
+		_, err = codeEngineService.CreateProjectAndWait(ctx, codeEngineService.NewCreateProjectOptions("my-project"), waitOptions)
+		Expect(err).To(BeNil())
+
+		// A project that no longer exists is deleted.
+		project, err = codeEngineService.DeleteProjectAndWait(ctx, codeEngineService.NewDeleteProjectAndWaitOptions(projectID).
+			SetPurge(true).
+			SetReclaimer(server.ProjectReclaimer()).
+			SetWaitOptions(waitOptions))
+		Expect(err).To(BeNil())
+		Expect(project).To(BeNil())
+	})
+	It(`Resolves the name of a project that is deleted and recreated`, func() {
+		projectID := server.AddProject("my-project")
+		id, err := codeEngineService.ResolveProjectID(ctx, "my-project")
+		Expect(err).To(BeNil())
+		Expect(id).To(Equal(projectID))
+
+		_, err = codeEngineService.DeleteProjectAndWait(ctx, codeEngineService.NewDeleteProjectAndWaitOptions("my-project").
+			SetPurge(true).
+			SetReclaimer(server.ProjectReclaimer()).
+			SetWaitOptions(waitOptions))
+		Expect(err).To(BeNil())
+		project, err := codeEngineService.CreateProjectAndWait(ctx, codeEngineService.NewCreateProjectOptions("my-project"), waitOptions)
+		Expect(err).To(BeNil())
+		Expect(*project.ID).ToNot(Equal(projectID))
+
+		id, err = codeEngineService.ResolveProjectID(ctx, "my-project")
+		Expect(err).To(BeNil())
+		Expect(id).To(Equal(*project.ID))
+		_, _, err = codeEngineService.GetProject(codeEngineService.NewGetProjectOptions(id))
+		Expect(err).To(BeNil())
+	})
+	It(`Returns the errors of the reclaimer`, func() {
+		projectID := server.AddProject("my-project")
+		reclaimErr := errors.New("reclamation is locked")
+		project, err := codeEngineService.DeleteProjectAndWait(ctx, codeEngineService.NewDeleteProjectAndWaitOptions(projectID).
+			SetPurge(true).
+			SetReclaimer(codeenginev2.ProjectReclaimerFunc(func(ctx context.Context, project *codeenginev2.Project) error {
+				return reclaimErr
+			})).
+			SetWaitOptions(waitOptions))
+		Expect(errors.Is(err, reclaimErr)).To(BeTrue())
+		Expect(*project.Status).To(Equal(codeenginev2.Project_Status_SoftDeleted))
+	})
+	It(`Returns an error if the project ID is missing`, func() {
+		_, err := codeEngineService.DeleteProjectAndWait(ctx, &codeenginev2.DeleteProjectAndWaitOptions{})
+		Expect(err).ToNot(BeNil())
+		_, err = codeEngineService.DeleteProjectAndWait(ctx, nil)
+		Expect(err).ToNot(BeNil())
+	})
+	It(`Reclaims projects through the Resource Controller API`, func() {
+		projectID := "15314cc3-85b4-4338-903f-c28cdee6d005"
+		var reclaimed []string
+		resourceController := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			res.Header().Set("Content-Type", "application/json")
+			switch {
+			case req.Method == http.MethodGet && req.URL.Path == "/v2/reclamations":
+				Expect(req.URL.Query().Get("account_id")).To(Equal("my-account"))
+				resources := []map[string]interface{}{}
+				if instanceID := req.URL.Query().Get("resource_instance_id"); instanceID == projectID {
+					resources = append(resources,
+						map[string]interface{}{"id": "reclaimed", "resource_instance_id": instanceID, "state": "RECLAIMING"},
+						map[string]interface{}{"id": "scheduled", "resource_instance_id": instanceID, "state": "SCHEDULED"},
+					)
+				}
+				_ = json.NewEncoder(res).Encode(map[string]interface{}{"resources": resources})
+			case req.Method == http.MethodPost:
+				reclaimed = append(reclaimed, req.URL.Path)
+				_, _ = res.Write([]byte(`{}`))
+			default:
+				res.WriteHeader(http.StatusNotFound)
+			}
+		}))
+		defer resourceController.Close()
+
+		reclaimer, err := codeenginev2.NewResourceControllerReclaimer(codeenginev2.NewResourceControllerReclaimerOptions(&core.NoAuthAuthenticator{}).
+			SetURL(resourceController.URL))
+		Expect(err).To(BeNil())
+		project := &codeenginev2.Project{ID: core.StringPtr(projectID), AccountID: core.StringPtr("my-account")}
+		Expect(reclaimer.ReclaimProject(ctx, project)).To(Succeed())
+		Expect(reclaimed).To(Equal([]string{"/v2/reclamations/scheduled/reclaim"}))
+
+		project.ID = core.StringPtr("4bd1b2a6-7d4d-4b9e-8c2f-3a0e5f6b7c8d")
+		err = reclaimer.ReclaimProject(ctx, project)
+		Expect(codeenginev2.IsNotFound(err)).To(BeTrue())
+		Expect(reclaimed).To(HaveLen(1))
+
+		_, err = codeenginev2.NewResourceControllerReclaimer(&codeenginev2.ResourceControllerReclaimerOptions{})
+		Expect(err).ToNot(BeNil())
+	})
+})
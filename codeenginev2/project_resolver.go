@@ -0,0 +1,238 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codeenginev2
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"regexp"
+	"strings"
+	"sync"
+
+	common "github.com/IBM/code-engine-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// projectIDPattern matches the IDs of projects, which are UUIDs.
+var projectIDPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// IsProjectID returns true if the project is a project ID, i.e. a UUID, rather than a project name.
+func IsProjectID(project string) bool {
+	return projectIDPattern.MatchString(project)
+}
+
+// AmbiguousProjectError is returned when a project name matches several projects, e.g. projects with the same name
+// in different regions or resource groups. Use errors.As to retrieve it from the returned error.
+type AmbiguousProjectError struct {
+	// The name of the projects.
+	Name string
+
+	// The projects with the name.
+	Projects []Project
+}
+
+func (ambiguousErr *AmbiguousProjectError) Error() string {
+	candidates := make([]string, 0, len(ambiguousErr.Projects))
+	for _, project := range ambiguousErr.Projects {
+		candidates = append(candidates, fmt.Sprintf("%s (region %s, resource group %s)",
+			core.StringNilMapper(project.ID), core.StringNilMapper(project.Region), core.StringNilMapper(project.ResourceGroupID)))
+	}
+	return fmt.Sprintf("project name '%s' is ambiguous, it matches %d projects: %s; use a project ID or a region instead",
+		ambiguousErr.Name, len(ambiguousErr.Projects), strings.Join(candidates, ", "))
+}
+
+// FindProjectByName : Find the project with a name
+// List the projects of the service and return the project with the name. If the region is set, e.g. `eu-de`, only the
+// projects of the region are considered. If no project has the name, the error matches ErrNotFound; if several
+// projects have the name, the error wraps an *AmbiguousProjectError.
+func (codeEngine *CodeEngineV2) FindProjectByName(ctx context.Context, name string, region string) (result *Project, err error) {
+	pager, err := codeEngine.NewProjectsPager(codeEngine.NewListProjectsOptions().SetLimit(watchPageLimit))
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "find-project-error")
+		return
+	}
+	projects, err := pager.GetAllWithContext(ctx)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "find-project-error")
+		return
+	}
+	return selectProject(projects, name, region)
+}
+
+// selectProject returns the project with the name, and in the region if it is set.
+func selectProject(projects []Project, name string, region string) (*Project, error) {
+	var matches []Project
+	for _, project := range projects {
+		if core.StringNilMapper(project.Name) == name && (region == "" || core.StringNilMapper(project.Region) == region) {
+			matches = append(matches, project)
+		}
+	}
+
+	var err error
+	switch {
+	case len(matches) == 1:
+		return &matches[0], nil
+	case len(matches) > 1:
+		err = &AmbiguousProjectError{Name: name, Projects: matches}
+	case region != "":
+		err = fmt.Errorf("project '%s' not found in region '%s': %w", name, region, ErrNotFound)
+	default:
+		err = fmt.Errorf("project '%s' not found: %w", name, ErrNotFound)
+	}
+	return nil, core.SDKErrorf(err, "", "find-project-error", common.GetComponentInfo())
+}
+
+// ProjectResolver : A cache of the IDs of the projects that are referenced by name
+// The helpers of a service, e.g. the Wait*, Watch* and Apply* helpers, accept a project name wherever they accept a
+// project ID, and resolve the name with ResolveProjectID. Each service has a resolver for all regions, which is shared
+// with the clones that are created afterwards and can be replaced with SetProjectResolver, e.g. with a resolver of a
+// region. The IDs are cached until they are forgotten, so a project that is deleted other than with
+// DeleteProjectAndWait and recreated with the same name must be forgotten.
+type ProjectResolver struct {
+	region string
+
+	mutex sync.RWMutex
+	ids   map[string]string
+}
+
+// NewProjectResolver : Instantiate ProjectResolver
+// The resolver only resolves the names of projects in the region, e.g. `eu-de`, or of all projects of the service if
+// the region is empty.
+func NewProjectResolver(region string) *ProjectResolver {
+	return &ProjectResolver{region: region, ids: map[string]string{}}
+}
+
+// Forget removes the cached ID of the project name.
+func (resolver *ProjectResolver) Forget(name string) {
+	resolver.mutex.Lock()
+	defer resolver.mutex.Unlock()
+	delete(resolver.ids, name)
+}
+
+// forgetID removes the cached names of the project with the ID.
+func (resolver *ProjectResolver) forgetID(id string) {
+	resolver.mutex.Lock()
+	defer resolver.mutex.Unlock()
+	maps.DeleteFunc(resolver.ids, func(name string, cached string) bool {
+		return cached == id
+	})
+}
+
+// Reset removes the cached IDs of all project names.
+func (resolver *ProjectResolver) Reset() {
+	resolver.mutex.Lock()
+	defer resolver.mutex.Unlock()
+	clear(resolver.ids)
+}
+
+// SetProjectResolver sets the resolver of the project names of the service, which is shared with the clones that are
+// created afterwards; existing clones keep their resolver. A nil resolver resolves each project name with
+// FindProjectByName, without caching.
+func (codeEngine *CodeEngineV2) SetProjectResolver(resolver *ProjectResolver) {
+	codeEngine.projectResolver = resolver
+}
+
+// GetProjectResolver returns the resolver of the project names of the service.
+func (codeEngine *CodeEngineV2) GetProjectResolver() *ProjectResolver {
+	return codeEngine.projectResolver
+}
+
+// ResolveProjectID : Resolve a project name or ID to a project ID
+// Return the project if it is a project ID, see IsProjectID. Otherwise, return the ID of the project with the name,
+// which is looked up with FindProjectByName in the region of the project resolver of the service and cached. An empty
+// project is rejected without a request.
+func (codeEngine *CodeEngineV2) ResolveProjectID(ctx context.Context, project string) (string, error) {
+	if project == "" {
+		return "", core.SDKErrorf(nil, "the project must be a project name or ID", "invalid-project", common.GetComponentInfo())
+	}
+	if IsProjectID(project) {
+		return project, nil
+	}
+	resolver := codeEngine.projectResolver
+	if resolver == nil {
+		resolver = NewProjectResolver("")
+	}
+
+	resolver.mutex.RLock()
+	id, found := resolver.ids[project]
+	resolver.mutex.RUnlock()
+	if found {
+		return id, nil
+	}
+
+	result, err := codeEngine.FindProjectByName(ctx, project, resolver.region)
+	if err != nil {
+		return "", core.RepurposeSDKProblem(err, "resolve-project-error")
+	}
+	id = core.StringNilMapper(result.ID)
+	resolver.mutex.Lock()
+	resolver.ids[project] = id
+	resolver.mutex.Unlock()
+	return id, nil
+}
+
+// resolveProjectIDPtr resolves the project of options, see ResolveProjectID. A nil project is rejected like an empty
+// one.
+func (codeEngine *CodeEngineV2) resolveProjectIDPtr(ctx context.Context, project *string) (*string, error) {
+	id, err := codeEngine.ResolveProjectID(ctx, core.StringNilMapper(project))
+	if err != nil {
+		return nil, err
+	}
+	return core.StringPtr(id), nil
+}
+
+// FindProjectByName : Find the project with a name in the regions of the client
+// List the projects of the region, e.g. `eu-de`, or of all regions of the client if the region is empty, and return
+// the project with the name and its region. If no project has the name, the error matches ErrNotFound; if several
+// projects have the name, e.g. in different regions, the error wraps an *AmbiguousProjectError.
+func (client *MultiRegionClient) FindProjectByName(ctx context.Context, name string, region string) (*Regional[Project], error) {
+	if region != "" {
+		service, found := client.Service(region)
+		if !found {
+			return nil, core.SDKErrorf(nil, fmt.Sprintf("the client does not include the region '%s'", region), "invalid-region", common.GetComponentInfo())
+		}
+		project, err := service.FindProjectByName(ctx, name, "")
+		if err != nil {
+			return nil, err
+		}
+		return &Regional[Project]{Region: region, Resource: *project}, nil
+	}
+
+	// A name is only unique if the projects of all regions are known, so a failed region fails the search.
+	projects, err := client.ListAllProjects(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var matches []Regional[Project]
+	for _, project := range projects {
+		if core.StringNilMapper(project.Resource.Name) == name {
+			matches = append(matches, project)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return nil, core.SDKErrorf(fmt.Errorf("project '%s' not found: %w", name, ErrNotFound), "", "find-project-error", common.GetComponentInfo())
+	case 1:
+		return &matches[0], nil
+	}
+	ambiguousErr := &AmbiguousProjectError{Name: name}
+	for _, match := range matches {
+		ambiguousErr.Projects = append(ambiguousErr.Projects, match.Resource)
+	}
+	return nil, core.SDKErrorf(ambiguousErr, "", "find-project-error", common.GetComponentInfo())
+}
@@ -0,0 +1,197 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codeenginev2_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/IBM/code-engine-go-sdk/codeenginev2"
+	"github.com/IBM/code-engine-go-sdk/codeenginev2/codeenginev2test"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`CodeEngineV2 project names`, func() {
+	var server *codeenginev2test.Server
+	var codeEngineService *codeenginev2.CodeEngineV2
+	var projectID string
+	ctx := context.Background()
+
+	BeforeEach(func() {
+		server = codeenginev2test.NewServer()
+		var err error
+		codeEngineService, err = server.NewCodeEngineV2()
+		Expect(err).To(BeNil())
+		projectID = server.AddProject("my-project")
+	})
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It(`Finds projects by name`, func() {
+		project, err := codeEngineService.FindProjectByName(ctx, "my-project", "")
+		Expect(err).To(BeNil())
+		Expect(*project.ID).To(Equal(projectID))
+
+		_, err = codeEngineService.FindProjectByName(ctx, "my-project", "eu-de")
+		Expect(codeenginev2.IsNotFound(err)).To(BeTrue())
+		_, err = codeEngineService.FindProjectByName(ctx, "other-project", "")
+		Expect(codeenginev2.IsNotFound(err)).To(BeTrue())
+
+		frankfurtID := server.AddProject("shared")
+		Expect(server.ModifyResource("", "projects", frankfurtID, map[string]interface{}{"region": "eu-de"})).To(BeTrue())
+		dallasID := server.AddProject("shared")
+		_, err = codeEngineService.FindProjectByName(ctx, "shared", "")
+		var ambiguousErr *codeenginev2.AmbiguousProjectError
+		Expect(errors.As(err, &ambiguousErr)).To(BeTrue())
+		Expect(ambiguousErr.Projects).To(HaveLen(2))
+		Expect(err.Error()).To(ContainSubstring(frankfurtID + " (region eu-de"))
+		Expect(err.Error()).To(ContainSubstring(dallasID + " (region us-south"))
+
+		project, err = codeEngineService.FindProjectByName(ctx, "shared", "eu-de")
+		Expect(err).To(BeNil())
+		Expect(*project.ID).To(Equal(frankfurtID))
+	})
+	It(`Resolves and caches project names`, func() {
+		Expect(codeenginev2.IsProjectID(projectID)).To(BeTrue())
+		Expect(codeenginev2.IsProjectID("my-project")).To(BeFalse())
+
+		id, err := codeEngineService.ResolveProjectID(ctx, "my-project")
+		Expect(err).To(BeNil())
+		Expect(id).To(Equal(projectID))
+		id, err = codeEngineService.ResolveProjectID(ctx, projectID)
+		Expect(err).To(BeNil())
+		Expect(id).To(Equal(projectID))
+
+		Expect(server.ModifyResource("", "projects", projectID, map[string]interface{}{"name": "renamed"})).To(BeTrue())
+		id, err = codeEngineService.Clone().ResolveProjectID(ctx, "my-project")
+		Expect(err).To(BeNil())
+		Expect(id).To(Equal(projectID))
+
+		codeEngineService.GetProjectResolver().Forget("my-project")
+		_, err = codeEngineService.ResolveProjectID(ctx, "my-project")
+		Expect(codeenginev2.IsNotFound(err)).To(BeTrue())
+
+		codeEngineService.SetProjectResolver(codeenginev2.NewProjectResolver("eu-de"))
+		_, err = codeEngineService.ResolveProjectID(ctx, "renamed")
+		Expect(codeenginev2.IsNotFound(err)).To(BeTrue())
+		codeEngineService.SetProjectResolver(nil)
+		id, err = codeEngineService.ResolveProjectID(ctx, "renamed")
+		Expect(err).To(BeNil())
+		Expect(id).To(Equal(projectID))
+	})
+	It(`Rejects empty projects without a request`, func() {
+		other := codeenginev2test.NewServer()
+		Expect(codeEngineService.SetServiceURL(other.URL)).To(Succeed())
+		other.Close()
+
+		_, err := codeEngineService.ResolveProjectID(ctx, "")
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("the project must be a project name or ID"))
+		_, err = codeEngineService.WaitForAppReady(ctx, "", "frontend", nil)
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("the project must be a project name or ID"))
+	})
+	It(`Resets the project resolver when the service URL changes`, func() {
+		id, err := codeEngineService.ResolveProjectID(ctx, "my-project")
+		Expect(err).To(BeNil())
+		Expect(id).To(Equal(projectID))
+		resolver := codeEngineService.GetProjectResolver()
+		Expect(codeEngineService.SetServiceURL(server.URL)).To(Succeed())
+		Expect(codeEngineService.GetProjectResolver()).To(BeIdenticalTo(resolver))
+
+		other := codeenginev2test.NewServer()
+		defer other.Close()
+		otherID := other.AddProject("my-project")
+		clone := codeEngineService.Clone()
+		Expect(codeEngineService.SetServiceURL(other.URL)).To(Succeed())
+		Expect(codeEngineService.GetProjectResolver()).ToNot(BeIdenticalTo(resolver))
+		id, err = codeEngineService.ResolveProjectID(ctx, "my-project")
+		Expect(err).To(BeNil())
+		Expect(id).To(Equal(otherID))
+
+		Expect(clone.GetProjectResolver()).To(BeIdenticalTo(resolver))
+		id, err = clone.ResolveProjectID(ctx, "my-project")
+		Expect(err).To(BeNil())
+		Expect(id).To(Equal(projectID))
+	})
+	It(`Accepts project names in the helpers`, func() {
+		configMap, changed, err := codeEngineService.ApplyConfigMap(ctx, codeEngineService.NewCreateConfigMapOptions("my-project", "settings").
+			SetData(map[string]string{"color": "blue"}))
+		Expect(err).To(BeNil())
+		Expect(changed).To(BeTrue())
+		Expect(*configMap.ProjectID).To(Equal(projectID))
+
+		configMap, _, err = codeEngineService.PatchConfigMapKeys(ctx, codeEngineService.NewPatchConfigMapKeysOptions("my-project", "settings").SetKey("size", "L"))
+		Expect(err).To(BeNil())
+		Expect(configMap.Data).To(HaveKeyWithValue("size", "L"))
+
+		_, _, err = codeEngineService.CreateApp(codeEngineService.NewCreateAppOptions(projectID, "icr.io/codeengine/helloworld", "frontend"))
+		Expect(err).To(BeNil())
+		app, err := codeEngineService.WaitForAppReady(ctx, "my-project", "frontend", codeenginev2.NewWaitOptions().SetInitialInterval(time.Millisecond))
+		Expect(err).To(BeNil())
+		Expect(*app.Name).To(Equal("frontend"))
+
+		watchCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		event := <-codeEngineService.WatchApps(watchCtx, "my-project", nil)
+		Expect(event.Type).To(Equal(codeenginev2.EventAdded))
+		Expect(*event.Object.Name).To(Equal("frontend"))
+
+		cache, err := codeEngineService.NewCache(codeEngineService.NewCacheOptions("my-project"))
+		Expect(err).To(BeNil())
+		Expect(cache.Resync(ctx)).To(Succeed())
+		_, found := cache.GetConfigMap("settings")
+		Expect(found).To(BeTrue())
+
+		_, err = codeEngineService.WaitForAppReady(ctx, "other-project", "frontend", nil)
+		Expect(codeenginev2.IsNotFound(err)).To(BeTrue())
+	})
+	It(`Finds projects by name across regions`, func() {
+		america := codeenginev2test.NewServer()
+		defer america.Close()
+		america.AddProject("my-project")
+		america.AddProject("dallas")
+
+		client, err := codeenginev2.NewMultiRegionClient(codeenginev2.NewMultiRegionClientOptions(&core.NoAuthAuthenticator{}).
+			SetRegions([]string{"eu-de", "us-south"}))
+		Expect(err).To(BeNil())
+		service, _ := client.Service("eu-de")
+		Expect(service.SetServiceURL(server.URL)).To(Succeed())
+		service, _ = client.Service("us-south")
+		Expect(service.SetServiceURL(america.URL)).To(Succeed())
+
+		project, err := client.FindProjectByName(ctx, "dallas", "")
+		Expect(err).To(BeNil())
+		Expect(project.Region).To(Equal("us-south"))
+
+		_, err = client.FindProjectByName(ctx, "my-project", "")
+		var ambiguousErr *codeenginev2.AmbiguousProjectError
+		Expect(errors.As(err, &ambiguousErr)).To(BeTrue())
+		project, err = client.FindProjectByName(ctx, "my-project", "eu-de")
+		Expect(err).To(BeNil())
+		Expect(*project.Resource.ID).To(Equal(projectID))
+
+		_, err = client.FindProjectByName(ctx, "dallas", "eu-de")
+		Expect(codeenginev2.IsNotFound(err)).To(BeTrue())
+		_, err = client.FindProjectByName(ctx, "dallas", "jp-tok")
+		Expect(err).ToNot(BeNil())
+	})
+})
@@ -0,0 +1,287 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codeenginev2
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	common "github.com/IBM/code-engine-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// RateLimiterOptions : The options of a RateLimiter.
+type RateLimiterOptions struct {
+	// The sustained number of requests per second. Zero disables the rate limit.
+	RequestsPerSecond float64
+
+	// The number of requests that can be sent at once after a period of inactivity. Defaults to 1.
+	Burst int
+
+	// The maximum number of requests in flight. A request is in flight until its response body has been closed. Zero
+	// disables the concurrency limit.
+	MaxConcurrentRequests int
+}
+
+// RateLimiterStats : The statistics of a RateLimiter.
+type RateLimiterStats struct {
+	// The number of requests that have been admitted.
+	Requests int64
+
+	// The number of admitted requests that had to wait for the rate or concurrency limit.
+	DelayedRequests int64
+
+	// The total time that the admitted requests have waited.
+	TotalWait time.Duration
+
+	// The longest time that an admitted request has waited.
+	MaxWait time.Duration
+
+	// The number of requests that are currently in flight.
+	InFlight int
+
+	// The number of times that the limiter has been paused, e.g. by a `Retry-After` header.
+	RetryAfterPauses int64
+}
+
+// AverageWait returns the average time that the admitted requests have waited.
+func (stats RateLimiterStats) AverageWait() time.Duration {
+	if stats.Requests == 0 {
+		return 0
+	}
+	return stats.TotalWait / time.Duration(stats.Requests)
+}
+
+// RateLimiter : A client-side limit of the rate and concurrency of API requests.
+//
+// The limiter combines a token bucket, which admits RequestsPerSecond requests per second with bursts of up to Burst
+// requests, with a maximum number of requests in flight. Requests wait until they are admitted or their context is
+// done. When a response is rate limited with a `Retry-After` header, the limiter admits no requests until the delay
+// has passed, so the delay applies to all requests and not only to the retry of the rate limited one.
+//
+// A limiter is set on a CodeEngineV2 with CodeEngineV2Options.RateLimiter or SetRateLimiter, and is shared with all
+// clones of the service. The same limiter may be set on several services to limit their requests together.
+type RateLimiter struct {
+	rate  float64
+	burst float64
+	slots chan struct{}
+
+	mutex       sync.Mutex
+	tokens      float64
+	last        time.Time
+	pausedUntil time.Time
+	stats       RateLimiterStats
+}
+
+// NewRateLimiter : Instantiate RateLimiter
+func NewRateLimiter(options *RateLimiterOptions) (*RateLimiter, error) {
+	err := core.ValidateNotNil(options, "options cannot be nil")
+	if err != nil {
+		return nil, core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+	}
+	if options.RequestsPerSecond < 0 || options.Burst < 0 || options.MaxConcurrentRequests < 0 {
+		return nil, core.SDKErrorf(nil, "the rate limiter options must not be negative", "invalid-rate-limit", common.GetComponentInfo())
+	}
+
+	limiter := &RateLimiter{
+		rate:  options.RequestsPerSecond,
+		burst: float64(max(options.Burst, 1)),
+		last:  time.Now(),
+	}
+	limiter.tokens = limiter.burst
+	if options.MaxConcurrentRequests > 0 {
+		limiter.slots = make(chan struct{}, options.MaxConcurrentRequests)
+	}
+	return limiter, nil
+}
+
+// Wait blocks until a request is admitted by the limiter, or until the context is done. The returned function must be
+// called once the request is complete to free its concurrency slot.
+func (limiter *RateLimiter) Wait(ctx context.Context) (release func(), err error) {
+	release, err = limiter.wait(ctx)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "rate-limiter-wait-error", common.GetComponentInfo())
+	}
+	return
+}
+
+// Pause stops admitting requests for the delay, e.g. the delay of a `Retry-After` header. A shorter pause than the
+// current one has no effect.
+func (limiter *RateLimiter) Pause(delay time.Duration) {
+	limiter.mutex.Lock()
+	defer limiter.mutex.Unlock()
+
+	until := time.Now().Add(delay)
+	if until.After(limiter.pausedUntil) {
+		limiter.pausedUntil = until
+		// The bucket refills from the end of the pause, so the requests that waited are not sent in one burst.
+		limiter.tokens = 0
+		limiter.last = until
+	}
+	limiter.stats.RetryAfterPauses++
+}
+
+// Stats returns the statistics of the limiter.
+func (limiter *RateLimiter) Stats() RateLimiterStats {
+	limiter.mutex.Lock()
+	defer limiter.mutex.Unlock()
+	return limiter.stats
+}
+
+func (limiter *RateLimiter) wait(ctx context.Context) (func(), error) {
+	start := time.Now()
+	if limiter.slots != nil {
+		select {
+		case limiter.slots <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	for {
+		delay := limiter.reserve()
+		if delay <= 0 {
+			break
+		}
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			if limiter.slots != nil {
+				<-limiter.slots
+			}
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+
+	waited := time.Since(start)
+	limiter.mutex.Lock()
+	limiter.stats.Requests++
+	limiter.stats.InFlight++
+	limiter.stats.TotalWait += waited
+	limiter.stats.MaxWait = max(limiter.stats.MaxWait, waited)
+	if waited > time.Millisecond {
+		limiter.stats.DelayedRequests++
+	}
+	limiter.mutex.Unlock()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			limiter.mutex.Lock()
+			limiter.stats.InFlight--
+			limiter.mutex.Unlock()
+			if limiter.slots != nil {
+				<-limiter.slots
+			}
+		})
+	}, nil
+}
+
+// reserve takes a token from the bucket and returns 0, or returns the delay until a token is available.
+func (limiter *RateLimiter) reserve() time.Duration {
+	limiter.mutex.Lock()
+	defer limiter.mutex.Unlock()
+
+	now := time.Now()
+	if now.Before(limiter.pausedUntil) {
+		return limiter.pausedUntil.Sub(now)
+	}
+	if limiter.rate == 0 {
+		return 0
+	}
+	limiter.tokens = min(limiter.burst, limiter.tokens+now.Sub(limiter.last).Seconds()*limiter.rate)
+	limiter.last = now
+	if limiter.tokens >= 1 {
+		limiter.tokens--
+		return 0
+	}
+	return time.Duration((1 - limiter.tokens) / limiter.rate * float64(time.Second))
+}
+
+// SetRateLimiter sets the limiter of the requests of the service, or removes the limiter if it is nil. The limiter wraps
+// the transport of the HTTP client of the service, which its clones share, so it applies to the service and all its
+// clones. It is kept by DisableSSLVerification and SetHTTPClient of the service, but not by those of the embedded
+// core.BaseService.
+func (codeEngine *CodeEngineV2) SetRateLimiter(limiter *RateLimiter) {
+	if limiter == nil {
+		codeEngine.setTransportLayer(rankRateLimit, nil)
+		return
+	}
+	codeEngine.setTransportLayer(rankRateLimit, &rateLimitedTransport{limiter: limiter})
+}
+
+// GetRateLimiter returns the limiter of the requests of the service, or nil if the requests are not limited.
+func (codeEngine *CodeEngineV2) GetRateLimiter() *RateLimiter {
+	if layer, ok := codeEngine.getTransportLayer(rankRateLimit).(*rateLimitedTransport); ok {
+		return layer.limiter
+	}
+	return nil
+}
+
+// rateLimitedTransport admits the requests of an HTTP client through a RateLimiter. Each attempt of a retried request
+// is admitted separately.
+type rateLimitedTransport struct {
+	limiter *RateLimiter
+	next    http.RoundTripper
+}
+
+func (transport *rateLimitedTransport) rank() int {
+	return rankRateLimit
+}
+
+func (transport *rateLimitedTransport) wrap(next http.RoundTripper) transportLayer {
+	return &rateLimitedTransport{limiter: transport.limiter, next: next}
+}
+
+func (transport *rateLimitedTransport) unwrap() http.RoundTripper {
+	return transport.next
+}
+
+func (transport *rateLimitedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	release, err := transport.limiter.wait(req.Context())
+	if err != nil {
+		return nil, err
+	}
+	resp, err := transport.next.RoundTrip(req)
+	if err != nil || resp == nil || resp.Body == nil {
+		release()
+		return resp, err
+	}
+	if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable {
+		if delay := parseRetryAfter(resp.Header.Get("Retry-After")); delay > 0 {
+			transport.limiter.Pause(delay)
+		}
+	}
+	resp.Body = &releasingBody{ReadCloser: resp.Body, release: release}
+	return resp, nil
+}
+
+// releasingBody frees the concurrency slot of a request when its response body is closed.
+type releasingBody struct {
+	io.ReadCloser
+	release func()
+}
+
+func (body *releasingBody) Close() error {
+	err := body.ReadCloser.Close()
+	body.release()
+	return err
+}
@@ -0,0 +1,164 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codeenginev2_test
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/IBM/code-engine-go-sdk/codeenginev2"
+	"github.com/IBM/code-engine-go-sdk/codeenginev2/codeenginev2test"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`CodeEngineV2 rate limiter`, func() {
+	var server *codeenginev2test.Server
+	var codeEngineService *codeenginev2.CodeEngineV2
+	ctx := context.Background()
+
+	BeforeEach(func() {
+		server = codeenginev2test.NewServer()
+		var err error
+		codeEngineService, err = server.NewCodeEngineV2()
+		Expect(err).To(BeNil())
+		server.AddProject("my-project")
+	})
+	AfterEach(func() {
+		server.Close()
+	})
+
+	listProjects := func(ctx context.Context, service *codeenginev2.CodeEngineV2) error {
+		_, _, err := service.ListProjectsWithContext(ctx, service.NewListProjectsOptions())
+		return err
+	}
+
+	It(`Limits the rate of the requests of the service and its clones`, func() {
+		limiter, err := codeenginev2.NewRateLimiter(&codeenginev2.RateLimiterOptions{RequestsPerSecond: 50, Burst: 2})
+		Expect(err).To(BeNil())
+		codeEngineService.SetRateLimiter(limiter)
+		clone := codeEngineService.Clone()
+		Expect(clone.GetRateLimiter()).To(BeIdenticalTo(limiter))
+
+		start := time.Now()
+		for i := 0; i < 3; i++ {
+			Expect(listProjects(ctx, codeEngineService)).To(Succeed())
+			Expect(listProjects(ctx, clone)).To(Succeed())
+		}
+		// Two requests of the burst, then four requests at 50 per second.
+		Expect(time.Since(start)).To(BeNumerically(">=", 70*time.Millisecond))
+
+		stats := limiter.Stats()
+		Expect(stats.Requests).To(Equal(int64(6)))
+		Expect(stats.DelayedRequests).To(BeNumerically(">=", 3))
+		Expect(stats.MaxWait).To(BeNumerically(">", 0))
+		Expect(stats.AverageWait()).To(BeNumerically("<=", stats.MaxWait))
+		Expect(stats.InFlight).To(Equal(0))
+	})
+	It(`Limits the concurrent requests and stops waiting when the context is done`, func() {
+		limiter, err := codeenginev2.NewRateLimiter(&codeenginev2.RateLimiterOptions{MaxConcurrentRequests: 1})
+		Expect(err).To(BeNil())
+		codeEngineService.SetRateLimiter(limiter)
+
+		release, err := limiter.Wait(ctx)
+		Expect(err).To(BeNil())
+		Expect(limiter.Stats().InFlight).To(Equal(1))
+
+		timeoutCtx, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+		defer cancel()
+		err = listProjects(timeoutCtx, codeEngineService)
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring(context.DeadlineExceeded.Error()))
+
+		var wg sync.WaitGroup
+		wg.Add(1)
+		go func() {
+			defer GinkgoRecover()
+			defer wg.Done()
+			Expect(listProjects(ctx, codeEngineService)).To(Succeed())
+		}()
+		time.Sleep(20 * time.Millisecond)
+		release()
+		release()
+		wg.Wait()
+		Expect(limiter.Stats().Requests).To(Equal(int64(2)))
+		Expect(limiter.Stats().InFlight).To(Equal(0))
+	})
+	It(`Pauses all requests for the delay of a Retry-After header`, func() {
+		limiter, err := codeenginev2.NewRateLimiter(&codeenginev2.RateLimiterOptions{})
+		Expect(err).To(BeNil())
+		codeEngineService.SetRateLimiter(limiter)
+
+		server.FailNext(http.MethodGet, "/projects", http.StatusTooManyRequests, http.Header{"Retry-After": {"1"}})
+		err = listProjects(ctx, codeEngineService)
+		Expect(codeenginev2.IsRateLimited(err)).To(BeTrue())
+
+		start := time.Now()
+		Expect(listProjects(ctx, codeEngineService.Clone())).To(Succeed())
+		Expect(time.Since(start)).To(BeNumerically(">=", 900*time.Millisecond))
+		Expect(limiter.Stats().RetryAfterPauses).To(Equal(int64(1)))
+	})
+	It(`Keeps the limiter when the HTTP client is reconfigured`, func() {
+		limiter, err := codeenginev2.NewRateLimiter(&codeenginev2.RateLimiterOptions{RequestsPerSecond: 10})
+		Expect(err).To(BeNil())
+		service, err := codeenginev2.NewCodeEngineV2(&codeenginev2.CodeEngineV2Options{
+			URL:           "https://codeengine.example.com/v2",
+			Authenticator: &core.NoAuthAuthenticator{},
+			RateLimiter:   limiter,
+		})
+		Expect(err).To(BeNil())
+		Expect(service.GetRateLimiter()).To(BeIdenticalTo(limiter))
+
+		service.DisableSSLVerification()
+		Expect(service.GetRateLimiter()).To(BeIdenticalTo(limiter))
+		service.SetRateLimiter(nil)
+		transport, ok := service.Service.GetHTTPClient().Transport.(*http.Transport)
+		Expect(ok).To(BeTrue())
+		Expect(transport.TLSClientConfig.InsecureSkipVerify).To(BeTrue())
+
+		service.SetRateLimiter(limiter)
+		client := &http.Client{}
+		service.SetHTTPClient(client)
+		Expect(service.GetRateLimiter()).To(BeIdenticalTo(limiter))
+		Expect(client.Transport).To(BeNil())
+		service.SetRateLimiter(nil)
+		Expect(service.GetRateLimiter()).To(BeNil())
+	})
+	It(`Does not modify the HTTP client that is passed to SetHTTPClient`, func() {
+		service, err := codeenginev2.NewCodeEngineV2(&codeenginev2.CodeEngineV2Options{
+			URL:           "https://codeengine.example.com/v2",
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+		service.SetHTTPClient(http.DefaultClient)
+
+		limiter, err := codeenginev2.NewRateLimiter(&codeenginev2.RateLimiterOptions{RequestsPerSecond: 10})
+		Expect(err).To(BeNil())
+		service.SetRateLimiter(limiter)
+		Expect(service.GetRateLimiter()).To(BeIdenticalTo(limiter))
+		Expect(http.DefaultClient.Transport).To(BeNil())
+		Expect(service.Service.GetHTTPClient()).ToNot(BeIdenticalTo(http.DefaultClient))
+	})
+	It(`Returns an error for negative options`, func() {
+		limiter, err := codeenginev2.NewRateLimiter(&codeenginev2.RateLimiterOptions{RequestsPerSecond: -1})
+		Expect(err).ToNot(BeNil())
+		Expect(limiter).To(BeNil())
+	})
+})
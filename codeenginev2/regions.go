@@ -0,0 +1,214 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codeenginev2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	common "github.com/IBM/code-engine-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// regions are the regions of IBM Cloud Code Engine, in the order of GetServiceURLForRegion.
+var regions = []string{"au-syd", "br-sao", "ca-tor", "eu-de", "eu-es", "eu-gb", "jp-osa", "jp-tok", "us-east", "us-south"}
+
+// Regions returns the regions of IBM Cloud Code Engine, whose service URLs are returned by GetServiceURLForRegion and
+// GetPrivateServiceURLForRegion.
+func Regions() []string {
+	return slices.Clone(regions)
+}
+
+// GetPrivateServiceURLForRegion returns the service URL of the private endpoint of the specified region, which is
+// reachable from the IBM Cloud private network, e.g. `https://api.private.eu-de.codeengine.cloud.ibm.com/v2`.
+func GetPrivateServiceURLForRegion(region string) (string, error) {
+	serviceURL, err := GetServiceURLForRegion(region)
+	if err != nil {
+		return "", err
+	}
+	return strings.Replace(serviceURL, "https://api.", "https://api.private.", 1), nil
+}
+
+// MultiRegionClientOptions : The options of a MultiRegionClient.
+type MultiRegionClientOptions struct {
+	// The authenticator of the requests, which is shared by the services of all regions.
+	Authenticator core.Authenticator `validate:"required"`
+
+	// The regions of the client. Defaults to all regions, see Regions.
+	Regions []string
+
+	// Whether to use the private endpoints of the regions.
+	PrivateEndpoints bool
+}
+
+// NewMultiRegionClientOptions : Instantiate MultiRegionClientOptions
+func NewMultiRegionClientOptions(authenticator core.Authenticator) *MultiRegionClientOptions {
+	return &MultiRegionClientOptions{
+		Authenticator: authenticator,
+	}
+}
+
+// SetAuthenticator : Allow user to set Authenticator
+func (_options *MultiRegionClientOptions) SetAuthenticator(authenticator core.Authenticator) *MultiRegionClientOptions {
+	_options.Authenticator = authenticator
+	return _options
+}
+
+// SetRegions : Allow user to set Regions
+func (_options *MultiRegionClientOptions) SetRegions(regions []string) *MultiRegionClientOptions {
+	_options.Regions = regions
+	return _options
+}
+
+// SetPrivateEndpoints : Allow user to set PrivateEndpoints
+func (_options *MultiRegionClientOptions) SetPrivateEndpoints(privateEndpoints bool) *MultiRegionClientOptions {
+	_options.PrivateEndpoints = privateEndpoints
+	return _options
+}
+
+// MultiRegionClient : A client of several regions of IBM Cloud Code Engine
+// The client holds a CodeEngineV2 service for each region, which share the authenticator, and fans out reads across
+// the regions with ListInAllRegions, e.g. ListAllProjects. The services can be configured individually, e.g. with
+// SetServiceURL or EnableRetries.
+type MultiRegionClient struct {
+	regions  []string
+	services map[string]*CodeEngineV2
+}
+
+// NewMultiRegionClient : constructs a MultiRegionClient with a service for each region of the options.
+func NewMultiRegionClient(options *MultiRegionClientOptions) (client *MultiRegionClient, err error) {
+	err = core.ValidateNotNil(options, "options cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(options, "options")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	clientRegions := options.Regions
+	if len(clientRegions) == 0 {
+		clientRegions = regions
+	}
+	client = &MultiRegionClient{services: make(map[string]*CodeEngineV2, len(clientRegions))}
+	for _, region := range clientRegions {
+		if _, found := client.services[region]; found {
+			continue
+		}
+		var serviceURL string
+		if options.PrivateEndpoints {
+			serviceURL, err = GetPrivateServiceURLForRegion(region)
+		} else {
+			serviceURL, err = GetServiceURLForRegion(region)
+		}
+		if err != nil {
+			return nil, core.RepurposeSDKProblem(err, "invalid-region")
+		}
+		client.services[region], err = NewCodeEngineV2(&CodeEngineV2Options{
+			URL:           serviceURL,
+			Authenticator: options.Authenticator,
+		})
+		if err != nil {
+			return nil, core.RepurposeSDKProblem(err, "new-service-error")
+		}
+		client.regions = append(client.regions, region)
+	}
+	return
+}
+
+// Regions returns the regions of the client.
+func (client *MultiRegionClient) Regions() []string {
+	return slices.Clone(client.regions)
+}
+
+// Service returns the service of the region, or false if the client does not include the region.
+func (client *MultiRegionClient) Service(region string) (*CodeEngineV2, bool) {
+	service, found := client.services[region]
+	return service, found
+}
+
+// Regional : A resource and the region it has been read from.
+type Regional[T any] struct {
+	// The region of the resource, e.g. `eu-de`.
+	Region string
+
+	// The resource.
+	Resource T
+}
+
+// RegionError : The error of a region of a MultiRegionClient.
+type RegionError struct {
+	// The region that failed.
+	Region string
+
+	// The error of the region.
+	Err error
+}
+
+func (regionErr *RegionError) Error() string {
+	return fmt.Sprintf("region %s: %s", regionErr.Region, regionErr.Err.Error())
+}
+
+func (regionErr *RegionError) Unwrap() error {
+	return regionErr.Err
+}
+
+// ListInAllRegions invokes the list function concurrently with the service of each region of the client, and merges
+// the resources of the regions, in the order of the regions of the client. If regions fail, it returns the resources
+// of the other regions and an error that joins a *RegionError for each failed region.
+func ListInAllRegions[T any](ctx context.Context, client *MultiRegionClient, list func(ctx context.Context, codeEngine *CodeEngineV2) ([]T, error)) ([]Regional[T], error) {
+	resources := make([][]T, len(client.regions))
+	errs := make([]error, len(client.regions))
+	var wg sync.WaitGroup
+	for i, region := range client.regions {
+		wg.Go(func() {
+			resources[i], errs[i] = list(ctx, client.services[region])
+			if errs[i] != nil {
+				errs[i] = &RegionError{Region: region, Err: errs[i]}
+			}
+		})
+	}
+	wg.Wait()
+
+	var result []Regional[T]
+	for i, region := range client.regions {
+		for _, resource := range resources[i] {
+			result = append(result, Regional[T]{Region: region, Resource: resource})
+		}
+	}
+	// The errors of the regions are already SDK problems; wrapping them in another problem would flatten the regions.
+	return result, errors.Join(errs...)
+}
+
+// ListAllProjects : List all projects of the account in all regions of the client
+// The projects of each region are listed concurrently. If regions fail, it returns the projects of the other regions
+// and an error that joins a *RegionError for each failed region.
+func (client *MultiRegionClient) ListAllProjects(ctx context.Context) ([]Regional[Project], error) {
+	return ListInAllRegions(ctx, client, func(ctx context.Context, codeEngine *CodeEngineV2) ([]Project, error) {
+		pager, err := codeEngine.NewProjectsPager(codeEngine.NewListProjectsOptions().SetLimit(100))
+		if err != nil {
+			return nil, err
+		}
+		return pager.GetAllWithContext(ctx)
+	})
+}
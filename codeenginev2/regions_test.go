@@ -0,0 +1,103 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codeenginev2_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/IBM/code-engine-go-sdk/codeenginev2"
+	"github.com/IBM/code-engine-go-sdk/codeenginev2/codeenginev2test"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`CodeEngineV2 regions`, func() {
+	ctx := context.Background()
+
+	It(`Resolves the public and private endpoints of all regions`, func() {
+		Expect(codeenginev2.Regions()).To(ContainElements("au-syd", "eu-de", "us-south"))
+		for _, region := range codeenginev2.Regions() {
+			url, err := codeenginev2.GetServiceURLForRegion(region)
+			Expect(err).To(BeNil())
+			Expect(url).To(Equal("https://api." + region + ".codeengine.cloud.ibm.com/v2"))
+			url, err = codeenginev2.GetPrivateServiceURLForRegion(region)
+			Expect(err).To(BeNil())
+			Expect(url).To(Equal("https://api.private." + region + ".codeengine.cloud.ibm.com/v2"))
+		}
+		Expect(codeenginev2.DefaultServiceURL).To(Equal("https://api.au-syd.codeengine.cloud.ibm.com/v2"))
+
+		_, err := codeenginev2.GetPrivateServiceURLForRegion("mars-north")
+		Expect(err).ToNot(BeNil())
+	})
+	It(`Creates a service for each region`, func() {
+		client, err := codeenginev2.NewMultiRegionClient(codeenginev2.NewMultiRegionClientOptions(&core.NoAuthAuthenticator{}).
+			SetRegions([]string{"eu-de", "us-south", "eu-de"}).SetPrivateEndpoints(true))
+		Expect(err).To(BeNil())
+		Expect(client.Regions()).To(Equal([]string{"eu-de", "us-south"}))
+		service, found := client.Service("eu-de")
+		Expect(found).To(BeTrue())
+		Expect(service.GetServiceURL()).To(Equal("https://api.private.eu-de.codeengine.cloud.ibm.com/v2"))
+		_, found = client.Service("jp-tok")
+		Expect(found).To(BeFalse())
+
+		client, err = codeenginev2.NewMultiRegionClient(codeenginev2.NewMultiRegionClientOptions(&core.NoAuthAuthenticator{}))
+		Expect(err).To(BeNil())
+		Expect(client.Regions()).To(Equal(codeenginev2.Regions()))
+
+		_, err = codeenginev2.NewMultiRegionClient(codeenginev2.NewMultiRegionClientOptions(&core.NoAuthAuthenticator{}).SetRegions([]string{"mars-north"}))
+		Expect(err).ToNot(BeNil())
+		_, err = codeenginev2.NewMultiRegionClient(codeenginev2.NewMultiRegionClientOptions(nil))
+		Expect(err).ToNot(BeNil())
+	})
+	It(`Lists the projects of all regions`, func() {
+		europe := codeenginev2test.NewServer()
+		defer europe.Close()
+		america := codeenginev2test.NewServer()
+		defer america.Close()
+		europe.AddProject("frankfurt")
+		america.AddProject("dallas-1")
+		america.AddProject("dallas-2")
+
+		client, err := codeenginev2.NewMultiRegionClient(codeenginev2.NewMultiRegionClientOptions(&core.NoAuthAuthenticator{}).
+			SetRegions([]string{"eu-de", "us-south"}))
+		Expect(err).To(BeNil())
+		service, _ := client.Service("eu-de")
+		Expect(service.SetServiceURL(europe.URL)).To(Succeed())
+		service, _ = client.Service("us-south")
+		Expect(service.SetServiceURL(america.URL)).To(Succeed())
+
+		projects, err := client.ListAllProjects(ctx)
+		Expect(err).To(BeNil())
+		var names []string
+		for _, project := range projects {
+			names = append(names, project.Region+"/"+*project.Resource.Name)
+		}
+		Expect(names).To(ConsistOf("eu-de/frankfurt", "us-south/dallas-1", "us-south/dallas-2"))
+		Expect(names[0]).To(Equal("eu-de/frankfurt"))
+
+		america.FailNext(http.MethodGet, "/projects", http.StatusInternalServerError, nil)
+		projects, err = client.ListAllProjects(ctx)
+		Expect(projects).To(HaveLen(1))
+		Expect(projects[0].Region).To(Equal("eu-de"))
+		var regionErr *codeenginev2.RegionError
+		Expect(errors.As(err, &regionErr)).To(BeTrue())
+		Expect(regionErr.Region).To(Equal("us-south"))
+	})
+})
@@ -0,0 +1,370 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codeenginev2
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Media types of the source bundle image, which is a single-layer OCI image.
+const (
+	ociManifestMediaType = "application/vnd.oci.image.manifest.v1+json"
+	ociConfigMediaType   = "application/vnd.oci.image.config.v1+json"
+	ociLayerMediaType    = "application/vnd.oci.image.layer.v1.tar+gzip"
+)
+
+// imageReference is a parsed container image reference such as `icr.io/namespace/image:tag`.
+type imageReference struct {
+	registry   string
+	repository string
+	tag        string
+}
+
+// parseImageReference parses an image reference. References without a registry host default to Docker Hub and
+// references without a tag default to `latest`. Digest references are rejected, since a push requires a tag.
+func parseImageReference(image string) (reference imageReference, err error) {
+	if image == "" {
+		err = fmt.Errorf("the image reference must not be empty")
+		return
+	}
+	if strings.Contains(image, "@") {
+		err = fmt.Errorf("the image reference '%s' must not contain a digest", image)
+		return
+	}
+
+	remainder := image
+	if first, rest, found := strings.Cut(image, "/"); found && (strings.ContainsAny(first, ".:") || first == "localhost") {
+		reference.registry = first
+		remainder = rest
+	} else {
+		reference.registry = "registry-1.docker.io"
+		if !found {
+			remainder = "library/" + image
+		}
+	}
+
+	reference.repository = remainder
+	reference.tag = "latest"
+	if i := strings.LastIndex(remainder, ":"); i > strings.LastIndex(remainder, "/") {
+		reference.repository = remainder[:i]
+		reference.tag = remainder[i+1:]
+	}
+	if reference.repository == "" || reference.tag == "" {
+		err = fmt.Errorf("the image reference '%s' is invalid", image)
+	}
+	return
+}
+
+// registryClient pushes images to a registry that implements the OCI distribution API.
+type registryClient struct {
+	httpClient *http.Client
+	reference  imageReference
+	plainHTTP  bool
+	username   string
+	password   string
+
+	// Whether the credentials may only be sent to the registry host itself, which is the case for credentials that
+	// are derived from the IAM API key of the service.
+	hostBoundCredentials bool
+
+	// The value of the Authorization header, once a challenge has been answered.
+	authorization string
+}
+
+// pushSourceBundle pushes the gzip-compressed tar archive as the single layer of an image to the reference of the
+// client and returns the digest of the pushed manifest.
+func (registry *registryClient) pushSourceBundle(ctx context.Context, archive io.ReadSeeker) (digest string, err error) {
+	if _, err = archive.Seek(0, io.SeekStart); err != nil {
+		return
+	}
+	layerDigest, layerSize, err := sha256Digest(archive)
+	if err != nil {
+		return
+	}
+	if _, err = archive.Seek(0, io.SeekStart); err != nil {
+		return
+	}
+	uncompressed, err := gzip.NewReader(archive)
+	if err != nil {
+		return
+	}
+	diffID, _, err := sha256Digest(uncompressed)
+	if err != nil {
+		return
+	}
+
+	config, err := json.Marshal(map[string]interface{}{
+		"architecture": "amd64",
+		"os":           "linux",
+		"rootfs": map[string]interface{}{
+			"type":     "layers",
+			"diff_ids": []string{diffID},
+		},
+	})
+	if err != nil {
+		return
+	}
+	configDigest, configSize, err := sha256Digest(bytes.NewReader(config))
+	if err != nil {
+		return
+	}
+
+	if err = registry.pushBlob(ctx, layerDigest, layerSize, archive); err != nil {
+		return
+	}
+	if err = registry.pushBlob(ctx, configDigest, configSize, bytes.NewReader(config)); err != nil {
+		return
+	}
+
+	manifest, err := json.Marshal(map[string]interface{}{
+		"schemaVersion": 2,
+		"mediaType":     ociManifestMediaType,
+		"config": map[string]interface{}{
+			"mediaType": ociConfigMediaType,
+			"digest":    configDigest,
+			"size":      configSize,
+		},
+		"layers": []map[string]interface{}{
+			{
+				"mediaType": ociLayerMediaType,
+				"digest":    layerDigest,
+				"size":      layerSize,
+			},
+		},
+	})
+	if err != nil {
+		return
+	}
+
+	response, err := registry.do(ctx, http.MethodPut, registry.url("manifests/"+registry.reference.tag), bytes.NewReader(manifest), int64(len(manifest)), ociManifestMediaType)
+	if err != nil {
+		return
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusCreated && response.StatusCode != http.StatusOK {
+		err = registryError("push manifest", response)
+		return
+	}
+
+	digest = response.Header.Get("Docker-Content-Digest")
+	if digest == "" {
+		digest, _, err = sha256Digest(bytes.NewReader(manifest))
+	}
+	return
+}
+
+// pushBlob uploads the blob with the specified digest, unless the registry already has it.
+func (registry *registryClient) pushBlob(ctx context.Context, digest string, size int64, content io.ReadSeeker) error {
+	response, err := registry.do(ctx, http.MethodHead, registry.url("blobs/"+digest), nil, 0, "")
+	if err != nil {
+		return err
+	}
+	response.Body.Close()
+	if response.StatusCode == http.StatusOK {
+		return nil
+	}
+
+	response, err = registry.do(ctx, http.MethodPost, registry.url("blobs/uploads/"), nil, 0, "")
+	if err != nil {
+		return err
+	}
+	response.Body.Close()
+	if response.StatusCode != http.StatusAccepted {
+		return registryError("start blob upload", response)
+	}
+
+	location, err := response.Request.URL.Parse(response.Header.Get("Location"))
+	if err != nil {
+		return fmt.Errorf("invalid blob upload location: %w", err)
+	}
+	query := location.Query()
+	query.Set("digest", digest)
+	location.RawQuery = query.Encode()
+
+	response, err = registry.do(ctx, http.MethodPut, location.String(), content, size, "application/octet-stream")
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusCreated {
+		return registryError("upload blob", response)
+	}
+	return nil
+}
+
+// url returns the URL of the specified path below the repository of the client.
+func (registry *registryClient) url(path string) string {
+	scheme := "https"
+	if registry.plainHTTP {
+		scheme = "http"
+	}
+	return fmt.Sprintf("%s://%s/v2/%s/%s", scheme, registry.reference.registry, registry.reference.repository, path)
+}
+
+// hostname returns the host name of the registry without the port.
+func (registry *registryClient) hostname() string {
+	return (&url.URL{Host: registry.reference.registry}).Hostname()
+}
+
+// do sends a request to the registry. If the registry answers with an authentication challenge, the challenge is
+// answered with the credentials of the client and the request is sent once more.
+func (registry *registryClient) do(ctx context.Context, method string, requestURL string, body io.ReadSeeker, contentLength int64, contentType string) (*http.Response, error) {
+	send := func() (*http.Response, error) {
+		var requestBody io.Reader
+		if body != nil {
+			if _, err := body.Seek(0, io.SeekStart); err != nil {
+				return nil, err
+			}
+			requestBody = io.NopCloser(body)
+		}
+		request, err := http.NewRequestWithContext(ctx, method, requestURL, requestBody)
+		if err != nil {
+			return nil, err
+		}
+		request.ContentLength = contentLength
+		if contentType != "" {
+			request.Header.Set("Content-Type", contentType)
+		}
+		if registry.authorization != "" {
+			request.Header.Set("Authorization", registry.authorization)
+		}
+		return registry.httpClient.Do(request)
+	}
+
+	response, err := send()
+	if err != nil || response.StatusCode != http.StatusUnauthorized || registry.authorization != "" {
+		return response, err
+	}
+	response.Body.Close()
+
+	if err = registry.authorize(ctx, response.Header.Get("WWW-Authenticate")); err != nil {
+		return nil, err
+	}
+	return send()
+}
+
+// authorize answers the specified `WWW-Authenticate` challenge, which is either a Basic challenge or a Bearer
+// challenge that requires a token to be fetched from the token service of the registry.
+func (registry *registryClient) authorize(ctx context.Context, challenge string) error {
+	scheme, params, _ := strings.Cut(challenge, " ")
+	switch strings.ToLower(scheme) {
+	case "basic":
+		if registry.username == "" && registry.password == "" {
+			return fmt.Errorf("registry '%s' requires credentials", registry.reference.registry)
+		}
+		credentials := registry.username + ":" + registry.password
+		registry.authorization = "Basic " + base64.StdEncoding.EncodeToString([]byte(credentials))
+		return nil
+	case "bearer":
+		return registry.fetchToken(ctx, parseChallengeParams(params))
+	}
+	return fmt.Errorf("registry '%s' sent an unsupported authentication challenge '%s'", registry.reference.registry, challenge)
+}
+
+// fetchToken fetches a bearer token for pushing to the repository of the client from the token service.
+func (registry *registryClient) fetchToken(ctx context.Context, params map[string]string) error {
+	realm, err := url.Parse(params["realm"])
+	if err != nil || params["realm"] == "" {
+		return fmt.Errorf("registry '%s' sent an invalid token realm '%s'", registry.reference.registry, params["realm"])
+	}
+	if registry.hostBoundCredentials && (realm.Scheme != "https" || realm.Hostname() != registry.hostname()) {
+		return fmt.Errorf("registry '%s' sent the token realm '%s', which is not on the registry host", registry.reference.registry, params["realm"])
+	}
+	query := realm.Query()
+	if params["service"] != "" {
+		query.Set("service", params["service"])
+	}
+	query.Set("scope", fmt.Sprintf("repository:%s:pull,push", registry.reference.repository))
+	realm.RawQuery = query.Encode()
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, realm.String(), nil)
+	if err != nil {
+		return err
+	}
+	if registry.username != "" || registry.password != "" {
+		request.SetBasicAuth(registry.username, registry.password)
+	}
+	response, err := registry.httpClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return registryError("fetch token", response)
+	}
+
+	var token struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+	if err = json.NewDecoder(response.Body).Decode(&token); err != nil {
+		return fmt.Errorf("invalid token response: %w", err)
+	}
+	if token.Token == "" {
+		token.Token = token.AccessToken
+	}
+	if token.Token == "" {
+		return fmt.Errorf("the token response of registry '%s' did not contain a token", registry.reference.registry)
+	}
+	registry.authorization = "Bearer " + token.Token
+	return nil
+}
+
+// parseChallengeParams parses the comma-separated `key="value"` parameters of an authentication challenge.
+func parseChallengeParams(params string) map[string]string {
+	result := map[string]string{}
+	for params != "" {
+		var key, value string
+		key, params, _ = strings.Cut(params, "=")
+		key = strings.ToLower(strings.TrimSpace(key))
+		if strings.HasPrefix(params, `"`) {
+			value, params, _ = strings.Cut(params[1:], `"`)
+			_, params, _ = strings.Cut(params, ",")
+		} else {
+			value, params, _ = strings.Cut(params, ",")
+		}
+		result[key] = strings.TrimSpace(value)
+	}
+	return result
+}
+
+// registryError builds an error from an unexpected registry response.
+func registryError(action string, response *http.Response) error {
+	body, _ := io.ReadAll(io.LimitReader(response.Body, 1024))
+	return fmt.Errorf("failed to %s: registry responded with status %d: %s", action, response.StatusCode, strings.TrimSpace(string(body)))
+}
+
+// sha256Digest returns the `sha256:`-prefixed digest and the size of the content of "r".
+func sha256Digest(r io.Reader) (string, int64, error) {
+	hash := sha256.New()
+	size, err := io.Copy(hash, r)
+	if err != nil {
+		return "", 0, err
+	}
+	return "sha256:" + hex.EncodeToString(hash.Sum(nil)), size, nil
+}
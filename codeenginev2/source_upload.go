@@ -0,0 +1,522 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codeenginev2
+
+import (
+	"archive/tar"
+	"bufio"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	common "github.com/IBM/code-engine-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// The ignore files that are honored when packaging a source directory, in order of precedence.
+// Only the first one that exists in the source directory is used.
+var sourceIgnoreFiles = []string{".ceignore", ".dockerignore"}
+
+// The user name that IBM Cloud Container Registry expects along with an IAM API key.
+const iamAPIKeyRegistryUsername = "iamapikey"
+
+// The suffix of the tag of the output image that forms the tag of the default source image.
+const sourceImageTagSuffix = "-source"
+
+// SubmitLocalBuildRunOptions : The SubmitLocalBuildRun options.
+type SubmitLocalBuildRunOptions struct {
+	// The ID of the project.
+	ProjectID *string `json:"project_id" validate:"required,ne="`
+
+	// The name of the build to run. The build must have the source type `local`.
+	BuildName *string `json:"build_name" validate:"required,ne="`
+
+	// The local directory that contains the source code. Files matched by the rules of a `.ceignore` file, or if
+	// there is none a `.dockerignore` file, in that directory are not uploaded.
+	SourceDir *string `json:"source_dir" validate:"required,ne="`
+
+	// Name of the build run. If not set, the name is generated from the build name.
+	Name *string `json:"name,omitempty"`
+
+	// The image that the source bundle is pushed to, e.g. `us.icr.io/ns/app:latest-source`. If not set, the source
+	// bundle is pushed to the repository of the output image of the build, with the tag of the output image followed
+	// by `-source`, so that the output of the build run does not overwrite it.
+	SourceImage *string `json:"source_image,omitempty"`
+
+	// The user name used to push the source bundle to the registry. If neither user name nor password are set, the
+	// source image is on an IBM Cloud Container Registry host (`icr.io` or `*.icr.io`) that is reached over HTTPS and
+	// the service uses an IamAuthenticator, its API key is used. Other registries require explicit credentials.
+	RegistryUsername *string `json:"registry_username,omitempty"`
+
+	// The password used to push the source bundle to the registry.
+	RegistryPassword *string `json:"registry_password,omitempty"`
+
+	// The HTTP client used to talk to the registry. Defaults to a plain HTTP client, which does not
+	// share the transport layers installed on the HTTP client of the service.
+	RegistryHTTPClient *http.Client `json:"-"`
+
+	// Talk to the registry using plain HTTP instead of HTTPS. Only meant to be used with local test registries.
+	RegistryPlainHTTP *bool `json:"registry_plain_http,omitempty"`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewSubmitLocalBuildRunOptions : Instantiate SubmitLocalBuildRunOptions
+func (*CodeEngineV2) NewSubmitLocalBuildRunOptions(projectID string, buildName string, sourceDir string) *SubmitLocalBuildRunOptions {
+	return &SubmitLocalBuildRunOptions{
+		ProjectID: core.StringPtr(projectID),
+		BuildName: core.StringPtr(buildName),
+		SourceDir: core.StringPtr(sourceDir),
+	}
+}
+
+// SetProjectID : Allow user to set ProjectID
+func (_options *SubmitLocalBuildRunOptions) SetProjectID(projectID string) *SubmitLocalBuildRunOptions {
+	_options.ProjectID = core.StringPtr(projectID)
+	return _options
+}
+
+// SetBuildName : Allow user to set BuildName
+func (_options *SubmitLocalBuildRunOptions) SetBuildName(buildName string) *SubmitLocalBuildRunOptions {
+	_options.BuildName = core.StringPtr(buildName)
+	return _options
+}
+
+// SetSourceDir : Allow user to set SourceDir
+func (_options *SubmitLocalBuildRunOptions) SetSourceDir(sourceDir string) *SubmitLocalBuildRunOptions {
+	_options.SourceDir = core.StringPtr(sourceDir)
+	return _options
+}
+
+// SetName : Allow user to set Name
+func (_options *SubmitLocalBuildRunOptions) SetName(name string) *SubmitLocalBuildRunOptions {
+	_options.Name = core.StringPtr(name)
+	return _options
+}
+
+// SetSourceImage : Allow user to set SourceImage
+func (_options *SubmitLocalBuildRunOptions) SetSourceImage(sourceImage string) *SubmitLocalBuildRunOptions {
+	_options.SourceImage = core.StringPtr(sourceImage)
+	return _options
+}
+
+// SetRegistryCredentials : Allow user to set RegistryUsername and RegistryPassword
+func (_options *SubmitLocalBuildRunOptions) SetRegistryCredentials(username string, password string) *SubmitLocalBuildRunOptions {
+	_options.RegistryUsername = core.StringPtr(username)
+	_options.RegistryPassword = core.StringPtr(password)
+	return _options
+}
+
+// SetRegistryHTTPClient : Allow user to set RegistryHTTPClient
+func (_options *SubmitLocalBuildRunOptions) SetRegistryHTTPClient(registryHTTPClient *http.Client) *SubmitLocalBuildRunOptions {
+	_options.RegistryHTTPClient = registryHTTPClient
+	return _options
+}
+
+// SetRegistryPlainHTTP : Allow user to set RegistryPlainHTTP
+func (_options *SubmitLocalBuildRunOptions) SetRegistryPlainHTTP(registryPlainHTTP bool) *SubmitLocalBuildRunOptions {
+	_options.RegistryPlainHTTP = core.BoolPtr(registryPlainHTTP)
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *SubmitLocalBuildRunOptions) SetHeaders(param map[string]string) *SubmitLocalBuildRunOptions {
+	options.Headers = param
+	return options
+}
+
+// LocalBuildRun : A build run submitted from local source code, as returned by SubmitLocalBuildRun.
+type LocalBuildRun struct {
+	// The created build run.
+	BuildRun *BuildRun
+
+	// The image that the source bundle has been pushed to, e.g. `us.icr.io/ns/app:latest-source`.
+	SourceImage string
+
+	// The digest of the pushed source bundle, e.g. `sha256:...`.
+	SourceDigest string
+}
+
+// SourceImageReference returns the digest-pinned reference of the source bundle, e.g. `us.icr.io/ns/app@sha256:...`,
+// which identifies the exact source of the build run even if the tag of the source image is pushed again.
+func (localBuildRun *LocalBuildRun) SourceImageReference() string {
+	return (&BuildRunResult{OutputImage: localBuildRun.SourceImage, OutputDigest: localBuildRun.SourceDigest}).ImageReference()
+}
+
+// SubmitLocalBuildRun : Submit a build run from local source code
+// Package the source directory into a source bundle image, push it to the source image, see SourceImage, and create
+// a build run with the source type `local` for the referenced build. The result records the source image and the
+// digest of the pushed bundle. This is the SDK equivalent of `ibmcloud ce buildrun submit --build <build> --source
+// <dir>`.
+func (codeEngine *CodeEngineV2) SubmitLocalBuildRun(ctx context.Context, submitLocalBuildRunOptions *SubmitLocalBuildRunOptions) (result *LocalBuildRun, response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(submitLocalBuildRunOptions, "submitLocalBuildRunOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(submitLocalBuildRunOptions, "submitLocalBuildRunOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	projectID, err := codeEngine.ResolveProjectID(ctx, *submitLocalBuildRunOptions.ProjectID)
+	if err != nil {
+		return
+	}
+
+	getBuildOptions := codeEngine.NewGetBuildOptions(projectID, *submitLocalBuildRunOptions.BuildName)
+	getBuildOptions.Headers = submitLocalBuildRunOptions.Headers
+	build, response, err := codeEngine.GetBuildWithContext(ctx, getBuildOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "get-build-error")
+		return
+	}
+	if core.StringNilMapper(build.SourceType) != Build_SourceType_Local {
+		err = core.SDKErrorf(nil, fmt.Sprintf("build '%s' has source type '%s', but source type '%s' is required to submit local source", *submitLocalBuildRunOptions.BuildName, core.StringNilMapper(build.SourceType), Build_SourceType_Local), "build-not-local", common.GetComponentInfo())
+		return
+	}
+
+	archive, err := os.CreateTemp("", "ce-source-*.tar.gz")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "source-archive-error", common.GetComponentInfo())
+		return
+	}
+	defer os.Remove(archive.Name())
+	defer archive.Close()
+
+	err = WriteSourceArchive(*submitLocalBuildRunOptions.SourceDir, archive)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "source-archive-error")
+		return
+	}
+
+	sourceImage := core.StringNilMapper(submitLocalBuildRunOptions.SourceImage)
+	if sourceImage == "" {
+		sourceImage, err = defaultSourceImage(core.StringNilMapper(build.OutputImage))
+		if err != nil {
+			err = core.SDKErrorf(err, "", "invalid-output-image", common.GetComponentInfo())
+			return
+		}
+	}
+	registry, err := codeEngine.newSourceRegistryClient(sourceImage, submitLocalBuildRunOptions)
+	if err != nil {
+		return
+	}
+	sourceDigest, err := registry.pushSourceBundle(ctx, archive)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "source-push-error", common.GetComponentInfo())
+		return
+	}
+
+	createBuildRunOptions := codeEngine.NewCreateBuildRunOptions(projectID)
+	createBuildRunOptions.BuildName = submitLocalBuildRunOptions.BuildName
+	createBuildRunOptions.Name = submitLocalBuildRunOptions.Name
+	createBuildRunOptions.SourceType = core.StringPtr(Build_SourceType_Local)
+	createBuildRunOptions.Headers = submitLocalBuildRunOptions.Headers
+	buildRun, response, err := codeEngine.CreateBuildRunWithContext(ctx, createBuildRunOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "create-build-run-error")
+		return
+	}
+	result = &LocalBuildRun{
+		BuildRun:     buildRun,
+		SourceImage:  sourceImage,
+		SourceDigest: sourceDigest,
+	}
+	return
+}
+
+// defaultSourceImage returns the source image of the output image: the repository of the output image with its tag
+// followed by `-source`, e.g. `us.icr.io/ns/app:latest-source` for `us.icr.io/ns/app:latest`. Tags are limited to
+// 128 characters, so a long tag is shortened.
+func defaultSourceImage(outputImage string) (string, error) {
+	reference, err := parseImageReference(outputImage)
+	if err != nil {
+		return "", err
+	}
+	tag := reference.tag
+	if len(tag) > 128-len(sourceImageTagSuffix) {
+		tag = tag[:128-len(sourceImageTagSuffix)]
+	}
+	return reference.registry + "/" + reference.repository + ":" + tag + sourceImageTagSuffix, nil
+}
+
+// newSourceRegistryClient returns a client for pushing the source bundle to the specified image.
+func (codeEngine *CodeEngineV2) newSourceRegistryClient(image string, options *SubmitLocalBuildRunOptions) (*registryClient, error) {
+	reference, err := parseImageReference(image)
+	if err != nil {
+		return nil, core.SDKErrorf(err, "", "invalid-source-image", common.GetComponentInfo())
+	}
+
+	registry := &registryClient{
+		httpClient: options.RegistryHTTPClient,
+		reference:  reference,
+		plainHTTP:  options.RegistryPlainHTTP != nil && *options.RegistryPlainHTTP,
+		username:   core.StringNilMapper(options.RegistryUsername),
+		password:   core.StringNilMapper(options.RegistryPassword),
+	}
+	if registry.httpClient == nil {
+		registry.httpClient = &http.Client{}
+	}
+	if registry.username == "" && registry.password == "" && !registry.plainHTTP && isIBMContainerRegistry(registry.hostname()) {
+		if iamAuthenticator, ok := codeEngine.Service.Options.Authenticator.(*core.IamAuthenticator); ok && iamAuthenticator.ApiKey != "" {
+			registry.username = iamAPIKeyRegistryUsername
+			registry.password = iamAuthenticator.ApiKey
+			registry.hostBoundCredentials = true
+		}
+	}
+	return registry, nil
+}
+
+// isIBMContainerRegistry returns whether the specified host name belongs to IBM Cloud Container Registry.
+func isIBMContainerRegistry(hostname string) bool {
+	hostname = strings.ToLower(hostname)
+	return hostname == "icr.io" || strings.HasSuffix(hostname, ".icr.io")
+}
+
+// WriteSourceArchive writes a gzip-compressed tar archive of the files in "sourceDir" to "w".
+// Files matched by the rules of a `.ceignore` file, or if there is none a `.dockerignore` file, in the source
+// directory are left out. The rules follow the `.dockerignore` syntax, including `**` and `!` exceptions.
+func WriteSourceArchive(sourceDir string, w io.Writer) (err error) {
+	ignore, err := loadSourceIgnore(sourceDir)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "read-ignore-file-error", common.GetComponentInfo())
+		return
+	}
+
+	gzipWriter := gzip.NewWriter(w)
+	tarWriter := tar.NewWriter(gzipWriter)
+
+	err = filepath.WalkDir(sourceDir, func(filePath string, entry fs.DirEntry, walkErr error) error {
+		if walkErr != nil {
+			return walkErr
+		}
+		relPath, err := filepath.Rel(sourceDir, filePath)
+		if err != nil {
+			return err
+		}
+		if relPath == "." {
+			return nil
+		}
+		relPath = filepath.ToSlash(relPath)
+
+		if ignore.matches(relPath) {
+			if entry.IsDir() && !ignore.hasExceptions() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		return writeArchiveEntry(tarWriter, filePath, relPath, entry)
+	})
+	if err != nil {
+		err = core.SDKErrorf(err, "", "write-archive-error", common.GetComponentInfo())
+		return
+	}
+
+	if err = tarWriter.Close(); err == nil {
+		err = gzipWriter.Close()
+	}
+	if err != nil {
+		err = core.SDKErrorf(err, "", "write-archive-error", common.GetComponentInfo())
+	}
+	return
+}
+
+// writeArchiveEntry adds the specified directory entry to the archive.
+func writeArchiveEntry(tarWriter *tar.Writer, filePath string, relPath string, entry fs.DirEntry) error {
+	info, err := entry.Info()
+	if err != nil {
+		return err
+	}
+
+	var linkTarget string
+	if info.Mode()&fs.ModeSymlink != 0 {
+		linkTarget, err = os.Readlink(filePath)
+		if err != nil {
+			return err
+		}
+	} else if !info.Mode().IsRegular() && !info.IsDir() {
+		// Sockets, devices and named pipes cannot be part of a source bundle.
+		return nil
+	}
+
+	header, err := tar.FileInfoHeader(info, linkTarget)
+	if err != nil {
+		return err
+	}
+	header.Name = relPath
+	if info.IsDir() {
+		header.Name += "/"
+	}
+	header.Uid, header.Gid = 0, 0
+	header.Uname, header.Gname = "", ""
+
+	if err = tarWriter.WriteHeader(header); err != nil {
+		return err
+	}
+	if !info.Mode().IsRegular() {
+		return nil
+	}
+
+	file, err := os.Open(filePath) // #nosec G304
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	_, err = io.Copy(tarWriter, file)
+	return err
+}
+
+// sourceIgnore holds the rules of an ignore file.
+type sourceIgnore struct {
+	rules []sourceIgnoreRule
+}
+
+// sourceIgnoreRule is a single line of an ignore file.
+type sourceIgnoreRule struct {
+	regexp    *regexp.Regexp
+	exception bool
+}
+
+// loadSourceIgnore reads the first ignore file that exists in "sourceDir".
+func loadSourceIgnore(sourceDir string) (*sourceIgnore, error) {
+	for _, name := range sourceIgnoreFiles {
+		file, err := os.Open(filepath.Join(sourceDir, name)) // #nosec G304
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		defer file.Close()
+		return parseSourceIgnore(file)
+	}
+	return &sourceIgnore{}, nil
+}
+
+// parseSourceIgnore parses ignore rules in `.dockerignore` syntax.
+func parseSourceIgnore(r io.Reader) (*sourceIgnore, error) {
+	ignore := &sourceIgnore{}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		rule := sourceIgnoreRule{}
+		if strings.HasPrefix(line, "!") {
+			rule.exception = true
+			line = strings.TrimSpace(line[1:])
+		}
+		pattern := strings.TrimPrefix(path.Clean(filepath.ToSlash(line)), "/")
+		if pattern == "" || pattern == "." {
+			continue
+		}
+
+		var err error
+		rule.regexp, err = compileIgnorePattern(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ignore pattern '%s': %w", line, err)
+		}
+		ignore.rules = append(ignore.rules, rule)
+	}
+	return ignore, scanner.Err()
+}
+
+// compileIgnorePattern converts an ignore pattern into a regular expression.
+// `**` matches any number of directories, `*` and `?` do not match the path separator.
+func compileIgnorePattern(pattern string) (*regexp.Regexp, error) {
+	var expr strings.Builder
+	expr.WriteString("^")
+	for i := 0; i < len(pattern); i++ {
+		ch := pattern[i]
+		switch {
+		case ch == '*' && i+1 < len(pattern) && pattern[i+1] == '*':
+			i++
+			if i+1 < len(pattern) && pattern[i+1] == '/' {
+				i++
+			}
+			if i+1 == len(pattern) {
+				expr.WriteString(".*")
+			} else {
+				expr.WriteString("(.*/)?")
+			}
+		case ch == '*':
+			expr.WriteString("[^/]*")
+		case ch == '?':
+			expr.WriteString("[^/]")
+		case ch == '[':
+			end := strings.IndexByte(pattern[i:], ']')
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated character class")
+			}
+			class := pattern[i+1 : i+end]
+			if strings.HasPrefix(class, "!") {
+				class = "^" + class[1:]
+			}
+			expr.WriteString("[" + class + "]")
+			i += end
+		case ch == '\\' && i+1 < len(pattern):
+			i++
+			expr.WriteString(regexp.QuoteMeta(string(pattern[i])))
+		default:
+			expr.WriteString(regexp.QuoteMeta(string(ch)))
+		}
+	}
+	expr.WriteString("$")
+	return regexp.Compile(expr.String())
+}
+
+// matches returns true if "relPath" is excluded. A path is excluded if the last rule that matches the path,
+// or one of its parent directories, is not an exception.
+func (ignore *sourceIgnore) matches(relPath string) bool {
+	candidates := []string{relPath}
+	for dir := path.Dir(relPath); dir != "."; dir = path.Dir(dir) {
+		candidates = append(candidates, dir)
+	}
+
+	excluded := false
+	for _, rule := range ignore.rules {
+		for _, candidate := range candidates {
+			if rule.regexp.MatchString(candidate) {
+				excluded = !rule.exception
+				break
+			}
+		}
+	}
+	return excluded
+}
+
+// hasExceptions returns true if any rule re-includes previously excluded paths.
+func (ignore *sourceIgnore) hasExceptions() bool {
+	for _, rule := range ignore.rules {
+		if rule.exception {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,408 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codeenginev2_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/code-engine-go-sdk/codeenginev2"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// fakeRegistry is a minimal stand-in for an OCI registry, which requires a bearer token for all requests.
+type fakeRegistry struct {
+	*httptest.Server
+
+	mutex     sync.Mutex
+	blobs     map[string][]byte
+	manifests map[string][]byte
+	tokenAuth []string
+
+	// The token realm sent in challenges. Defaults to the `/token` path on the host of the request.
+	realm string
+}
+
+func newFakeRegistry() *fakeRegistry {
+	registry := &fakeRegistry{
+		blobs:     map[string][]byte{},
+		manifests: map[string][]byte{},
+	}
+	registry.Server = httptest.NewServer(http.HandlerFunc(registry.serveHTTP))
+	return registry
+}
+
+func newFakeTLSRegistry() *fakeRegistry {
+	registry := &fakeRegistry{
+		blobs:     map[string][]byte{},
+		manifests: map[string][]byte{},
+	}
+	registry.Server = httptest.NewTLSServer(http.HandlerFunc(registry.serveHTTP))
+	return registry
+}
+
+// routingClient returns an HTTP client that sends the requests for all hosts to the registry.
+func (registry *fakeRegistry) routingClient() *http.Client {
+	transport := registry.Client().Transport.(*http.Transport).Clone()
+	transport.TLSClientConfig.InsecureSkipVerify = true
+	transport.DialContext = func(ctx context.Context, network string, _ string) (net.Conn, error) {
+		return (&net.Dialer{}).DialContext(ctx, network, registry.Listener.Addr().String())
+	}
+	return &http.Client{Transport: transport}
+}
+
+func (registry *fakeRegistry) serveHTTP(res http.ResponseWriter, req *http.Request) {
+	defer GinkgoRecover()
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+
+	if req.URL.Path == "/token" {
+		Expect(req.URL.Query().Get("scope")).To(Equal("repository:ns/app:pull,push"))
+		Expect(req.URL.Query().Get("service")).To(Equal("fake-registry"))
+		username, password, _ := req.BasicAuth()
+		registry.tokenAuth = append(registry.tokenAuth, username+":"+password)
+		fmt.Fprint(res, `{"token": "fake-token"}`)
+		return
+	}
+	if req.Header.Get("Authorization") != "Bearer fake-token" {
+		realm := registry.realm
+		if realm == "" {
+			scheme := "http"
+			if req.TLS != nil {
+				scheme = "https"
+			}
+			realm = scheme + "://" + req.Host + "/token"
+		}
+		res.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="%s",service="fake-registry",scope="repository:ns/app:push"`, realm))
+		res.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	path := strings.TrimPrefix(req.URL.Path, "/v2/ns/app/")
+	switch {
+	case req.Method == http.MethodHead && strings.HasPrefix(path, "blobs/"):
+		if _, ok := registry.blobs[strings.TrimPrefix(path, "blobs/")]; ok {
+			res.WriteHeader(http.StatusOK)
+		} else {
+			res.WriteHeader(http.StatusNotFound)
+		}
+	case req.Method == http.MethodPost && path == "blobs/uploads/":
+		res.Header().Set("Location", "/v2/ns/app/blobs/uploads/upload-1?state=abc")
+		res.WriteHeader(http.StatusAccepted)
+	case req.Method == http.MethodPut && path == "blobs/uploads/upload-1":
+		Expect(req.URL.Query().Get("state")).To(Equal("abc"))
+		body, _ := io.ReadAll(req.Body)
+		digest := sha256.Sum256(body)
+		Expect(req.URL.Query().Get("digest")).To(Equal("sha256:" + hex.EncodeToString(digest[:])))
+		registry.blobs[req.URL.Query().Get("digest")] = body
+		res.WriteHeader(http.StatusCreated)
+	case req.Method == http.MethodPut && strings.HasPrefix(path, "manifests/"):
+		Expect(req.Header.Get("Content-Type")).To(Equal("application/vnd.oci.image.manifest.v1+json"))
+		body, _ := io.ReadAll(req.Body)
+		digest := sha256.Sum256(body)
+		registry.manifests[strings.TrimPrefix(path, "manifests/")] = body
+		registry.manifests["sha256:"+hex.EncodeToString(digest[:])] = body
+		res.Header().Set("Docker-Content-Digest", "sha256:"+hex.EncodeToString(digest[:]))
+		res.WriteHeader(http.StatusCreated)
+	default:
+		res.WriteHeader(http.StatusNotFound)
+	}
+}
+
+// layerFiles returns the names of the files in the single layer of the manifest pushed with the given tag or digest.
+func (registry *fakeRegistry) layerFiles(reference string) []string {
+	var manifest struct {
+		Config struct {
+			Digest string `json:"digest"`
+		} `json:"config"`
+		Layers []struct {
+			MediaType string `json:"mediaType"`
+			Digest    string `json:"digest"`
+		} `json:"layers"`
+	}
+	Expect(json.Unmarshal(registry.manifests[reference], &manifest)).To(Succeed())
+	Expect(registry.blobs).To(HaveKey(manifest.Config.Digest))
+	Expect(manifest.Layers).To(HaveLen(1))
+	Expect(manifest.Layers[0].MediaType).To(Equal("application/vnd.oci.image.layer.v1.tar+gzip"))
+	return archiveFiles(registry.blobs[manifest.Layers[0].Digest])
+}
+
+// archiveFiles returns the sorted entry names of a gzip-compressed tar archive.
+func archiveFiles(archive []byte) []string {
+	gzipReader, err := gzip.NewReader(bytes.NewReader(archive))
+	Expect(err).To(BeNil())
+	tarReader := tar.NewReader(gzipReader)
+	var names []string
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		Expect(err).To(BeNil())
+		names = append(names, header.Name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// writeSourceTree creates the given files, with their names as content, below a new temporary directory.
+func writeSourceTree(files ...string) string {
+	dir, err := os.MkdirTemp("", "ce-source-test-")
+	Expect(err).To(BeNil())
+	for _, file := range files {
+		path := filepath.Join(dir, filepath.FromSlash(file))
+		Expect(os.MkdirAll(filepath.Dir(path), 0o755)).To(Succeed())
+		Expect(os.WriteFile(path, []byte(file), 0o644)).To(Succeed())
+	}
+	return dir
+}
+
+var _ = Describe(`CodeEngineV2 source upload`, func() {
+	var sourceDir string
+	AfterEach(func() {
+		os.RemoveAll(sourceDir)
+	})
+
+	Describe(`WriteSourceArchive(sourceDir, w)`, func() {
+		It(`Archives all files if there is no ignore file`, func() {
+			sourceDir = writeSourceTree("main.go", "pkg/util.go")
+
+			var archive bytes.Buffer
+			Expect(codeenginev2.WriteSourceArchive(sourceDir, &archive)).To(Succeed())
+			Expect(archiveFiles(archive.Bytes())).To(Equal([]string{"main.go", "pkg/", "pkg/util.go"}))
+		})
+		It(`Honors the .dockerignore rules`, func() {
+			sourceDir = writeSourceTree("main.go", "main_test.go", "node_modules/a/index.js", "docs/a.md", "docs/keep.md", "src/deep/x.log", "build.log")
+			Expect(os.WriteFile(filepath.Join(sourceDir, ".dockerignore"), []byte("# comment\n*_test.go\n/node_modules\n**/*.log\ndocs\n!docs/keep.md\n"), 0o644)).To(Succeed())
+
+			var archive bytes.Buffer
+			Expect(codeenginev2.WriteSourceArchive(sourceDir, &archive)).To(Succeed())
+			Expect(archiveFiles(archive.Bytes())).To(Equal([]string{".dockerignore", "docs/keep.md", "main.go", "src/", "src/deep/"}))
+		})
+		It(`Prefers .ceignore over .dockerignore`, func() {
+			sourceDir = writeSourceTree("main.go", "secret.env", "vendor/lib.go")
+			Expect(os.WriteFile(filepath.Join(sourceDir, ".dockerignore"), []byte("main.go\n"), 0o644)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(sourceDir, ".ceignore"), []byte(".*\n*.env\nvendor/\n"), 0o644)).To(Succeed())
+
+			var archive bytes.Buffer
+			Expect(codeenginev2.WriteSourceArchive(sourceDir, &archive)).To(Succeed())
+			Expect(archiveFiles(archive.Bytes())).To(Equal([]string{"main.go"}))
+		})
+		It(`Fails for a missing source directory`, func() {
+			var archive bytes.Buffer
+			Expect(codeenginev2.WriteSourceArchive("/does/not/exist", &archive)).ToNot(Succeed())
+		})
+	})
+
+	Describe(`SubmitLocalBuildRun(ctx, submitLocalBuildRunOptions)`, func() {
+		var registry *fakeRegistry
+		var apiServer *httptest.Server
+		var outputRegistry string
+		var iamServers []*httptest.Server
+		var createdBuildRun map[string]interface{}
+		projectID := "15314cc3-85b4-4338-903f-c28cdee6d005"
+
+		startAPIServer := func(sourceType string) {
+			apiServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+				res.Header().Set("Content-type", "application/json")
+				switch {
+				case req.Method == http.MethodGet && req.URL.Path == "/projects/"+projectID+"/builds/my-build":
+					outputImage := outputRegistry + "/ns/app:latest"
+					fmt.Fprintf(res, `{"name": "my-build", "source_type": "%s", "output_image": "%s", "output_secret": "ce-auto-icr-private-us-south"}`, sourceType, outputImage)
+				case req.Method == http.MethodPost && req.URL.Path == "/projects/"+projectID+"/build_runs":
+					Expect(registry.manifests).ToNot(BeEmpty())
+					Expect(json.NewDecoder(req.Body).Decode(&createdBuildRun)).To(Succeed())
+					res.WriteHeader(201)
+					fmt.Fprint(res, `{"name": "my-build-run", "build_name": "my-build", "source_type": "local", "status": "pending"}`)
+				default:
+					res.WriteHeader(404)
+				}
+			}))
+		}
+		newService := func(authenticator core.Authenticator) *codeenginev2.CodeEngineV2 {
+			codeEngineService, serviceErr := codeenginev2.NewCodeEngineV2(&codeenginev2.CodeEngineV2Options{
+				URL:           apiServer.URL,
+				Authenticator: authenticator,
+			})
+			Expect(serviceErr).To(BeNil())
+			return codeEngineService
+		}
+		newIAMAuthenticator := func(apiKey string) *core.IamAuthenticator {
+			iamServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				claims := base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf(`{"iat": %d, "exp": %d}`, time.Now().Unix(), time.Now().Add(time.Hour).Unix())))
+				res.Header().Set("Content-type", "application/json")
+				fmt.Fprintf(res, `{"access_token": "e30.%s.c2ln", "refresh_token": "r", "token_type": "Bearer", "expires_in": 3600, "expiration": %d}`, claims, time.Now().Add(time.Hour).Unix())
+			}))
+			iamServers = append(iamServers, iamServer)
+			return &core.IamAuthenticator{ApiKey: apiKey, URL: iamServer.URL}
+		}
+
+		BeforeEach(func() {
+			registry = newFakeRegistry()
+			outputRegistry = strings.TrimPrefix(registry.URL, "http://")
+			createdBuildRun = nil
+		})
+		AfterEach(func() {
+			registry.Close()
+			apiServer.Close()
+			for _, iamServer := range iamServers {
+				iamServer.Close()
+			}
+			iamServers = nil
+		})
+
+		It(`Pushes the source bundle and creates a local build run`, func() {
+			startAPIServer("local")
+			sourceDir = writeSourceTree("main.go", "tmp/cache.bin")
+			Expect(os.WriteFile(filepath.Join(sourceDir, ".ceignore"), []byte("tmp\n"), 0o644)).To(Succeed())
+
+			codeEngineService := newService(&core.NoAuthAuthenticator{})
+			options := codeEngineService.NewSubmitLocalBuildRunOptions(projectID, "my-build", sourceDir).
+				SetName("my-build-run").
+				SetRegistryCredentials("iamapikey", "my-api-key").
+				SetRegistryPlainHTTP(true)
+			result, response, err := codeEngineService.SubmitLocalBuildRun(context.Background(), options)
+			Expect(err).To(BeNil())
+			Expect(response.StatusCode).To(Equal(201))
+			Expect(*result.BuildRun.Name).To(Equal("my-build-run"))
+			Expect(result.SourceImage).To(Equal(outputRegistry + "/ns/app:latest-source"))
+			Expect(result.SourceDigest).To(HavePrefix("sha256:"))
+			Expect(result.SourceImageReference()).To(Equal(outputRegistry + "/ns/app@" + result.SourceDigest))
+
+			Expect(createdBuildRun).To(Equal(map[string]interface{}{
+				"build_name":  "my-build",
+				"name":        "my-build-run",
+				"source_type": "local",
+			}))
+			Expect(registry.tokenAuth).To(Equal([]string{"iamapikey:my-api-key"}))
+			Expect(registry.manifests).ToNot(HaveKey("latest"))
+			Expect(registry.layerFiles("latest-source")).To(Equal([]string{".ceignore", "main.go"}))
+			Expect(registry.layerFiles(result.SourceDigest)).To(Equal([]string{".ceignore", "main.go"}))
+		})
+		It(`Pushes the source bundle to the source image`, func() {
+			startAPIServer("local")
+			sourceDir = writeSourceTree("main.go")
+
+			codeEngineService := newService(&core.NoAuthAuthenticator{})
+			options := codeEngineService.NewSubmitLocalBuildRunOptions(projectID, "my-build", sourceDir).
+				SetSourceImage(outputRegistry + "/ns/app:bundle").
+				SetRegistryPlainHTTP(true)
+			result, _, err := codeEngineService.SubmitLocalBuildRun(context.Background(), options)
+			Expect(err).To(BeNil())
+			Expect(result.SourceImage).To(Equal(outputRegistry + "/ns/app:bundle"))
+			Expect(registry.layerFiles("bundle")).To(Equal([]string{"main.go"}))
+			Expect(registry.layerFiles(result.SourceDigest)).To(Equal([]string{"main.go"}))
+		})
+		It(`Uses the API key of an IAM authenticator to push to IBM Cloud Container Registry`, func() {
+			registry.Close()
+			registry = newFakeTLSRegistry()
+			outputRegistry = "us.icr.io"
+			startAPIServer("local")
+			sourceDir = writeSourceTree("main.go")
+
+			codeEngineService := newService(newIAMAuthenticator("iam-api-key"))
+			options := codeEngineService.NewSubmitLocalBuildRunOptions(projectID, "my-build", sourceDir).
+				SetRegistryHTTPClient(registry.routingClient())
+			_, _, err := codeEngineService.SubmitLocalBuildRun(context.Background(), options)
+			Expect(err).To(BeNil())
+			Expect(registry.tokenAuth).To(Equal([]string{"iamapikey:iam-api-key"}))
+		})
+		It(`Does not use the API key of an IAM authenticator for other registries`, func() {
+			startAPIServer("local")
+			sourceDir = writeSourceTree("main.go")
+
+			codeEngineService := newService(newIAMAuthenticator("iam-api-key"))
+			options := codeEngineService.NewSubmitLocalBuildRunOptions(projectID, "my-build", sourceDir).SetRegistryPlainHTTP(true)
+			_, _, err := codeEngineService.SubmitLocalBuildRun(context.Background(), options)
+			Expect(err).To(BeNil())
+			Expect(registry.tokenAuth).To(Equal([]string{":"}))
+		})
+		It(`Does not send the API key of an IAM authenticator to a token realm on another host`, func() {
+			registry.Close()
+			registry = newFakeTLSRegistry()
+			registry.realm = "https://auth.example.com/token"
+			outputRegistry = "us.icr.io"
+			startAPIServer("local")
+			sourceDir = writeSourceTree("main.go")
+
+			codeEngineService := newService(newIAMAuthenticator("iam-api-key"))
+			options := codeEngineService.NewSubmitLocalBuildRunOptions(projectID, "my-build", sourceDir).
+				SetRegistryHTTPClient(registry.routingClient())
+			_, _, err := codeEngineService.SubmitLocalBuildRun(context.Background(), options)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("not on the registry host"))
+			Expect(registry.tokenAuth).To(BeEmpty())
+		})
+		It(`Rejects builds that do not use local source`, func() {
+			startAPIServer("git")
+			sourceDir = writeSourceTree("main.go")
+
+			codeEngineService := newService(&core.NoAuthAuthenticator{})
+			options := codeEngineService.NewSubmitLocalBuildRunOptions(projectID, "my-build", sourceDir).SetRegistryPlainHTTP(true)
+			_, _, err := codeEngineService.SubmitLocalBuildRun(context.Background(), options)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("source type 'local' is required"))
+			Expect(registry.manifests).To(BeEmpty())
+			Expect(createdBuildRun).To(BeNil())
+		})
+		It(`Returns an error if the registry rejects the credentials`, func() {
+			startAPIServer("local")
+			sourceDir = writeSourceTree("main.go")
+			registry.Config.Handler = http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				res.Header().Set("WWW-Authenticate", `Basic realm="fake-registry"`)
+				res.WriteHeader(http.StatusUnauthorized)
+			})
+
+			codeEngineService := newService(&core.NoAuthAuthenticator{})
+			options := codeEngineService.NewSubmitLocalBuildRunOptions(projectID, "my-build", sourceDir).
+				SetRegistryCredentials("user", "wrong").
+				SetRegistryPlainHTTP(true)
+			_, _, err := codeEngineService.SubmitLocalBuildRun(context.Background(), options)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("status 401"))
+			Expect(createdBuildRun).To(BeNil())
+		})
+		It(`Validates the options`, func() {
+			startAPIServer("local")
+			codeEngineService := newService(&core.NoAuthAuthenticator{})
+			_, _, err := codeEngineService.SubmitLocalBuildRun(context.Background(), nil)
+			Expect(err).ToNot(BeNil())
+			_, _, err = codeEngineService.SubmitLocalBuildRun(context.Background(), &codeenginev2.SubmitLocalBuildRunOptions{})
+			Expect(err).ToNot(BeNil())
+		})
+	})
+})
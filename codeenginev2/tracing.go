@@ -0,0 +1,282 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codeenginev2
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	common "github.com/IBM/code-engine-go-sdk/common"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TracerName is the name of the OpenTelemetry tracer of the SDK.
+const TracerName = "github.com/IBM/code-engine-go-sdk/codeenginev2"
+
+// The attributes of the spans of the operations.
+const (
+	// The operation ID, e.g. `GetApp`.
+	AttributeOperation = attribute.Key("codeengine.operation")
+
+	// The ID of the project of the operation.
+	AttributeProjectID = attribute.Key("codeengine.project_id")
+
+	// The name of the resource of the operation, e.g. the name of the app, or the ID of a binding.
+	AttributeResourceName = attribute.Key("codeengine.resource_name")
+
+	attributeHTTPMethod     = attribute.Key("http.request.method")
+	attributeHTTPStatusCode = attribute.Key("http.response.status_code")
+	attributeResendCount    = attribute.Key("http.request.resend_count")
+	attributeServerAddress  = attribute.Key("server.address")
+	attributeURLPath        = attribute.Key("url.path")
+	attributeErrorType      = attribute.Key("error.type")
+)
+
+// eventAttempt is the name of the span events of the attempts of an operation.
+const eventAttempt = "codeengine.attempt"
+
+// TracingOptions : The options of the OpenTelemetry tracing of a service.
+type TracingOptions struct {
+	// The provider of the tracer. Defaults to the global provider of otel.GetTracerProvider.
+	TracerProvider trace.TracerProvider
+
+	// The propagator that injects the trace context into the requests. Defaults to the W3C trace context, i.e. the
+	// `traceparent` and `tracestate` headers.
+	Propagator propagation.TextMapPropagator
+}
+
+// NewTracingOptions : Instantiate TracingOptions
+func NewTracingOptions() *TracingOptions {
+	return &TracingOptions{}
+}
+
+// SetTracerProvider : Allow user to set TracerProvider
+func (_options *TracingOptions) SetTracerProvider(tracerProvider trace.TracerProvider) *TracingOptions {
+	_options.TracerProvider = tracerProvider
+	return _options
+}
+
+// SetPropagator : Allow user to set Propagator
+func (_options *TracingOptions) SetPropagator(propagator propagation.TextMapPropagator) *TracingOptions {
+	_options.Propagator = propagator
+	return _options
+}
+
+// EnableTracing : Trace the operations of the service with OpenTelemetry
+// Each call of an operation creates a client span that is named after its operation ID, e.g. `ListApps`, and a child of
+// the span of the context of the call. The span has the project ID, the resource name, the HTTP status code and the
+// number of retries as attributes, records each attempt of the call as an event, and records the error of a failed
+// call. The trace context is injected into the request headers. Like the rate limiter, the tracing applies to the
+// service and all its clones.
+func (codeEngine *CodeEngineV2) EnableTracing(tracingOptions *TracingOptions) {
+	if tracingOptions == nil {
+		tracingOptions = NewTracingOptions()
+	}
+	tracerProvider := tracingOptions.TracerProvider
+	if tracerProvider == nil {
+		tracerProvider = otel.GetTracerProvider()
+	}
+	propagator := tracingOptions.Propagator
+	if propagator == nil {
+		propagator = propagation.TraceContext{}
+	}
+	codeEngine.setTransportLayer(rankTracing, &tracedTransport{
+		tracer:     tracerProvider.Tracer(TracerName, trace.WithInstrumentationVersion(common.Version)),
+		propagator: propagator,
+	})
+}
+
+// DisableTracing : Stop tracing the operations of the service
+func (codeEngine *CodeEngineV2) DisableTracing() {
+	codeEngine.setTransportLayer(rankTracing, nil)
+}
+
+// tracedTransport creates a span for each call of an operation whose attempts pass the transport of an HTTP client.
+type tracedTransport struct {
+	tracer     trace.Tracer
+	propagator propagation.TextMapPropagator
+	next       http.RoundTripper
+}
+
+func (transport *tracedTransport) rank() int {
+	return rankTracing
+}
+
+func (transport *tracedTransport) wrap(next http.RoundTripper) transportLayer {
+	wrapped := *transport
+	wrapped.next = next
+	return &wrapped
+}
+
+func (transport *tracedTransport) unwrap() http.RoundTripper {
+	return transport.next
+}
+
+func (transport *tracedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	state, standalone := callStateOf(req, rankTracing, func() callState {
+		return &tracedCall{}
+	})
+	call := state.(*tracedCall)
+	if standalone {
+		defer call.end()
+	}
+	ctx := call.begin(transport.tracer, req)
+
+	// The request is copied shallowly, so that the layers below see its URL, and with a copy of its headers.
+	req = req.WithContext(ctx)
+	req.Header = req.Header.Clone()
+	transport.propagator.Inject(ctx, propagation.HeaderCarrier(req.Header))
+	resp, err := transport.next.RoundTrip(req)
+	call.attempted(resendCount(req), resp, err)
+	return resp, err
+}
+
+// tracedCall is the span of a call of an operation, which is started by the first attempt of the call.
+type tracedCall struct {
+	mutex sync.Mutex
+	span  trace.Span
+
+	// The resend count and the status code or error of the last attempt.
+	resendCount int64
+	statusCode  int
+	err         error
+}
+
+// begin starts the span of the call on its first attempt and returns the context of the request with the span.
+func (call *tracedCall) begin(tracer trace.Tracer, req *http.Request) context.Context {
+	call.mutex.Lock()
+	defer call.mutex.Unlock()
+	if call.span == nil {
+		operation := operationOf(req)
+		name := operation.operation
+		if name == "" {
+			name = "HTTP " + req.Method
+		}
+		attributes := []attribute.KeyValue{
+			attributeHTTPMethod.String(req.Method),
+			attributeServerAddress.String(req.URL.Hostname()),
+			attributeURLPath.String(req.URL.Path),
+		}
+		if operation.operation != "" {
+			attributes = append(attributes, AttributeOperation.String(operation.operation))
+		}
+		if operation.projectID != "" {
+			attributes = append(attributes, AttributeProjectID.String(operation.projectID))
+		}
+		if operation.resourceName != "" {
+			attributes = append(attributes, AttributeResourceName.String(operation.resourceName))
+		}
+		_, call.span = tracer.Start(req.Context(), name, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attributes...))
+	}
+	return trace.ContextWithSpan(req.Context(), call.span)
+}
+
+// attempted records the response or error of an attempt as an event of the span.
+func (call *tracedCall) attempted(resendCount int64, resp *http.Response, err error) {
+	call.mutex.Lock()
+	defer call.mutex.Unlock()
+	call.resendCount, call.statusCode, call.err = resendCount, 0, err
+	attributes := []attribute.KeyValue{attributeResendCount.Int64(resendCount)}
+	if err != nil {
+		attributes = append(attributes, attributeErrorType.String(fmt.Sprintf("%T", err)))
+	} else {
+		call.statusCode = resp.StatusCode
+		attributes = append(attributes, attributeHTTPStatusCode.Int(resp.StatusCode))
+	}
+	call.span.AddEvent(eventAttempt, trace.WithAttributes(attributes...))
+}
+
+// end records the outcome of the last attempt and ends the span.
+func (call *tracedCall) end() {
+	call.mutex.Lock()
+	defer call.mutex.Unlock()
+	span := call.span
+	span.SetAttributes(attributeResendCount.Int64(call.resendCount))
+	switch {
+	case call.err != nil:
+		span.SetAttributes(attributeErrorType.String(fmt.Sprintf("%T", call.err)))
+		span.RecordError(call.err)
+		span.SetStatus(codes.Error, call.err.Error())
+	case call.statusCode >= http.StatusBadRequest:
+		status := fmt.Sprintf("%d %s", call.statusCode, http.StatusText(call.statusCode))
+		span.SetAttributes(attributeHTTPStatusCode.Int(call.statusCode), attributeErrorType.String(strconv.Itoa(call.statusCode)))
+		span.RecordError(fmt.Errorf("the request failed with status %s", status))
+		span.SetStatus(codes.Error, status)
+	default:
+		span.SetAttributes(attributeHTTPStatusCode.Int(call.statusCode))
+	}
+	span.End()
+}
+
+// operationRoute is the route of an operation, e.g. `/projects/{project_id}/apps/{name}` for GetApp.
+type operationRoute struct {
+	path      string
+	paginated bool
+}
+
+// operationCall is the operation of a request with the project and resource of its path.
+type operationCall struct {
+	operation    string
+	paginated    bool
+	projectID    string
+	resourceName string
+}
+
+// operationOf returns the operation of a request, whose ID the operation has passed to common.GetSdkHeaders, with
+// the project and resource of its path. The path of the request starts with the path of the service URL, e.g. `/v2`,
+// so the route of the operation is matched against the end of the path. The operation of a request that has not been
+// sent by an operation is empty.
+func operationOf(req *http.Request) (call operationCall) {
+	call.operation = common.GetOperationId(req.Header)
+	route, found := operationRoutes[call.operation]
+	if !found {
+		return
+	}
+	call.paginated = route.paginated
+
+	template := strings.Split(strings.Trim(route.path, "/"), "/")
+	segments := strings.Split(strings.Trim(req.URL.Path, "/"), "/")
+	if len(segments) < len(template) {
+		return
+	}
+	params := map[string]string{}
+	for i, segment := range segments[len(segments)-len(template):] {
+		if strings.HasPrefix(template[i], "{") && strings.HasSuffix(template[i], "}") {
+			params[strings.Trim(template[i], "{}")] = segment
+		}
+	}
+
+	call.projectID, call.resourceName = params["project_id"], params["name"]
+	switch {
+	case call.projectID == "":
+		// The path of the project operations is `/projects/{id}`.
+		call.projectID = params["id"]
+	case call.resourceName == "" && params["id"] != "":
+		call.resourceName = params["id"]
+	case call.resourceName == "":
+		call.resourceName = params["app_name"]
+	}
+	return
+}
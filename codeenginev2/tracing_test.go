@@ -0,0 +1,194 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codeenginev2_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/IBM/code-engine-go-sdk/codeenginev2"
+	"github.com/IBM/code-engine-go-sdk/codeenginev2/codeenginev2test"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var _ = Describe(`CodeEngineV2 tracing`, func() {
+	var server *codeenginev2test.Server
+	var codeEngineService *codeenginev2.CodeEngineV2
+	var projectID string
+	var recorder *tracetest.SpanRecorder
+	var tracerProvider *sdktrace.TracerProvider
+	ctx := context.Background()
+
+	attributesOf := func(span sdktrace.ReadOnlySpan) map[attribute.Key]attribute.Value {
+		attributes := map[attribute.Key]attribute.Value{}
+		for _, kv := range span.Attributes() {
+			attributes[kv.Key] = kv.Value
+		}
+		return attributes
+	}
+
+	BeforeEach(func() {
+		server = codeenginev2test.NewServer()
+		var err error
+		codeEngineService, err = server.NewCodeEngineV2()
+		Expect(err).To(BeNil())
+		projectID = server.AddProject("my-project")
+
+		recorder = tracetest.NewSpanRecorder()
+		tracerProvider = sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+		codeEngineService.EnableTracing(codeenginev2.NewTracingOptions().SetTracerProvider(tracerProvider))
+	})
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It(`Creates a span named after the operation with its project, resource and status`, func() {
+		_, _, err := codeEngineService.CreateApp(codeEngineService.NewCreateAppOptions(projectID, "icr.io/codeengine/helloworld", "my-app"))
+		Expect(err).To(BeNil())
+
+		parentCtx, parent := tracerProvider.Tracer("test").Start(ctx, "reconcile")
+		_, _, err = codeEngineService.Clone().GetAppWithContext(parentCtx, codeEngineService.NewGetAppOptions(projectID, "my-app"))
+		parent.End()
+		Expect(err).To(BeNil())
+
+		spans := recorder.Ended()
+		Expect(spans).To(HaveLen(3))
+		Expect(spans[0].Name()).To(Equal("CreateApp"))
+		span := spans[1]
+		Expect(span.Name()).To(Equal("GetApp"))
+		Expect(span.SpanKind()).To(Equal(trace.SpanKindClient))
+		Expect(span.Parent().SpanID()).To(Equal(parent.SpanContext().SpanID()))
+		Expect(span.Status().Code).To(Equal(codes.Unset))
+		attributes := attributesOf(span)
+		Expect(attributes[codeenginev2.AttributeOperation].AsString()).To(Equal("GetApp"))
+		Expect(attributes[codeenginev2.AttributeProjectID].AsString()).To(Equal(projectID))
+		Expect(attributes[codeenginev2.AttributeResourceName].AsString()).To(Equal("my-app"))
+		Expect(attributes["http.request.method"].AsString()).To(Equal(http.MethodGet))
+		Expect(attributes["http.response.status_code"].AsInt64()).To(Equal(int64(http.StatusOK)))
+		Expect(attributes["http.request.resend_count"].AsInt64()).To(Equal(int64(0)))
+	})
+	It(`Records the error of a failed request`, func() {
+		_, _, err := codeEngineService.GetApp(codeEngineService.NewGetAppOptions(projectID, "missing-app"))
+		Expect(codeenginev2.IsNotFound(err)).To(BeTrue())
+
+		spans := recorder.Ended()
+		Expect(spans).To(HaveLen(1))
+		Expect(spans[0].Status().Code).To(Equal(codes.Error))
+		Expect(attributesOf(spans[0])["error.type"].AsString()).To(Equal("404"))
+		Expect(spans[0].Events()).To(HaveLen(2))
+		Expect(spans[0].Events()[0].Name).To(Equal("codeengine.attempt"))
+		Expect(spans[0].Events()[1].Name).To(Equal("exception"))
+	})
+	It(`Records the attempts of a retried request in the span of its operation`, func() {
+		codeEngineService.EnableRetries(2, 10*time.Millisecond)
+		server.FailNext(http.MethodGet, "/projects/"+projectID+"/config_maps", http.StatusServiceUnavailable, http.Header{"Retry-After": {"0"}})
+
+		_, _, err := codeEngineService.Clone().ListConfigMaps(codeEngineService.NewListConfigMapsOptions(projectID))
+		Expect(err).To(BeNil())
+
+		spans := recorder.Ended()
+		Expect(spans).To(HaveLen(1))
+		span := spans[0]
+		Expect(span.Name()).To(Equal("ListConfigMaps"))
+		Expect(span.Status().Code).To(Equal(codes.Unset))
+		attributes := attributesOf(span)
+		Expect(attributes["http.request.resend_count"].AsInt64()).To(Equal(int64(1)))
+		Expect(attributes["http.response.status_code"].AsInt64()).To(Equal(int64(http.StatusOK)))
+		Expect(span.Events()).To(HaveLen(2))
+		for i, event := range span.Events() {
+			Expect(event.Name).To(Equal("codeengine.attempt"))
+			eventAttributes := map[attribute.Key]attribute.Value{}
+			for _, kv := range event.Attributes {
+				eventAttributes[kv.Key] = kv.Value
+			}
+			Expect(eventAttributes["http.request.resend_count"].AsInt64()).To(Equal(int64(i)))
+		}
+	})
+	It(`Ends the span of a retried request when the retries are exhausted or the context ends`, func() {
+		retryAfter := "0"
+		httpServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			if retryAfter != "" {
+				res.Header().Set("Retry-After", retryAfter)
+			}
+			res.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer httpServer.Close()
+		service, err := codeenginev2.NewCodeEngineV2(&codeenginev2.CodeEngineV2Options{
+			URL:           httpServer.URL + "/v2",
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+		service.EnableTracing(codeenginev2.NewTracingOptions().SetTracerProvider(tracerProvider))
+		service.EnableRetries(1, 10*time.Millisecond)
+
+		_, _, err = service.GetProject(service.NewGetProjectOptions("15314cc3-85b4-4338-903f-c28cdee6d005"))
+		Expect(err).ToNot(BeNil())
+		spans := recorder.Ended()
+		Expect(spans).To(HaveLen(1))
+		Expect(spans[0].Status().Code).To(Equal(codes.Error))
+		Expect(attributesOf(spans[0])["http.request.resend_count"].AsInt64()).To(Equal(int64(1)))
+		Expect(attributesOf(spans[0])["error.type"].AsString()).To(Equal("503"))
+
+		retryAfter = ""
+		service.EnableRetries(1, time.Minute)
+		cancelCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+		defer cancel()
+		_, _, err = service.GetProjectWithContext(cancelCtx, service.NewGetProjectOptions("15314cc3-85b4-4338-903f-c28cdee6d005"))
+		Expect(err).ToNot(BeNil())
+		Eventually(recorder.Ended).Should(HaveLen(2))
+		Expect(attributesOf(recorder.Ended()[1])["http.request.resend_count"].AsInt64()).To(Equal(int64(0)))
+	})
+	It(`Injects the W3C trace context and stops tracing when disabled`, func() {
+		var traceparent string
+		httpServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			traceparent = req.Header.Get("traceparent")
+			res.Header().Set("Content-Type", "application/json")
+			_, _ = res.Write([]byte(`{"id": "15314cc3-85b4-4338-903f-c28cdee6d005", "name": "my-project"}`))
+		}))
+		defer httpServer.Close()
+		service, err := codeenginev2.NewCodeEngineV2(&codeenginev2.CodeEngineV2Options{
+			URL:           httpServer.URL + "/v2",
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+		service.EnableTracing(codeenginev2.NewTracingOptions().SetTracerProvider(tracerProvider))
+
+		_, _, err = service.GetProject(service.NewGetProjectOptions("15314cc3-85b4-4338-903f-c28cdee6d005"))
+		Expect(err).To(BeNil())
+		spans := recorder.Ended()
+		Expect(spans).To(HaveLen(1))
+		Expect(spans[0].Name()).To(Equal("GetProject"))
+		Expect(attributesOf(spans[0])[codeenginev2.AttributeProjectID].AsString()).To(Equal("15314cc3-85b4-4338-903f-c28cdee6d005"))
+		Expect(traceparent).To(ContainSubstring(spans[0].SpanContext().TraceID().String()))
+		Expect(traceparent).To(ContainSubstring(spans[0].SpanContext().SpanID().String()))
+
+		service.DisableTracing()
+		_, _, err = service.GetProject(service.NewGetProjectOptions("15314cc3-85b4-4338-903f-c28cdee6d005"))
+		Expect(err).To(BeNil())
+		Expect(recorder.Ended()).To(HaveLen(1))
+		Expect(traceparent).To(BeEmpty())
+	})
+})
@@ -0,0 +1,288 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codeenginev2
+
+import (
+	"context"
+	"maps"
+	"net/http"
+	"slices"
+	"sync"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+// The ranks of the transport layers. Layers with a lower rank wrap those with a higher rank, so the tracing layer
+// observes the time that a request waits for the rate limiter, and the metrics and logging layers do not.
+const (
+	rankTracing = iota
+	rankRateLimit
+	rankMetrics
+	rankLogging
+)
+
+// transportLayer is a layer that the SDK adds to the transport of the HTTP client of a service, e.g. the rate limiter.
+// The layers wrap the transport of the HTTP client, so they are shared by the clones of the service and apply to each
+// attempt of a retried request. The layers tell the attempts of a call apart with callStateOf and resendCount.
+type transportLayer interface {
+	http.RoundTripper
+
+	// rank returns the rank of the layer, which orders the layers of a transport.
+	rank() int
+
+	// wrap returns a copy of the layer that passes the requests to the transport.
+	wrap(next http.RoundTripper) transportLayer
+
+	// unwrap returns the transport that the layer passes the requests to.
+	unwrap() http.RoundTripper
+}
+
+// getTransportLayer returns the layer of the rank, or nil if the transport of the service does not have one.
+func (codeEngine *CodeEngineV2) getTransportLayer(rank int) transportLayer {
+	client := codeEngine.Service.GetHTTPClient()
+	if client == nil {
+		return nil
+	}
+	layers, _ := splitTransport(client.Transport)
+	for _, layer := range layers {
+		if layer.rank() == rank {
+			return layer
+		}
+	}
+	return nil
+}
+
+// setTransportLayer adds the layer to the transport of the service, replacing the layer of the same rank, or removes
+// the layer of the rank if the layer is nil.
+func (codeEngine *CodeEngineV2) setTransportLayer(rank int, layer transportLayer) {
+	client := codeEngine.Service.GetHTTPClient()
+	if client == nil {
+		client = core.DefaultHTTPClient()
+		codeEngine.Service.SetHTTPClient(client)
+	}
+	layers, base := splitTransport(client.Transport)
+	layers = slices.DeleteFunc(layers, func(current transportLayer) bool {
+		return current.rank() == rank
+	})
+	if layer != nil {
+		layers = append(layers, layer)
+		slices.SortFunc(layers, func(a transportLayer, b transportLayer) int {
+			return a.rank() - b.rank()
+		})
+	}
+	client.Transport = joinTransport(layers, base)
+	codeEngine.hookRetries()
+}
+
+// DisableSSLVerification disables the verification of server certificates and hostnames, like the method of the
+// embedded core.BaseService, and keeps the rate limiter, tracing, metrics and logging of the service. This should be
+// used only for testing or in secure environments.
+func (codeEngine *CodeEngineV2) DisableSSLVerification() {
+	codeEngine.withoutTransportLayers(codeEngine.Service.DisableSSLVerification)
+}
+
+// SetHTTPClient sets the HTTP client of the service, like the method of the embedded core.BaseService, and keeps the
+// rate limiter, tracing, metrics and logging of the service. The service uses a shallow copy of the client, so that
+// the transport layers are never installed on a client that is shared with other code, such as http.DefaultClient.
+func (codeEngine *CodeEngineV2) SetHTTPClient(client *http.Client) {
+	if client != nil {
+		copied := *client
+		client = &copied
+	}
+	var layers []transportLayer
+	if current := codeEngine.Service.GetHTTPClient(); current != nil {
+		layers, _ = splitTransport(current.Transport)
+	}
+	codeEngine.Service.SetHTTPClient(client)
+	if len(layers) > 0 {
+		_, base := splitTransport(client.Transport)
+		client.Transport = joinTransport(layers, base)
+	}
+}
+
+// configureService applies the external configuration to the service. The transport layers are removed while the
+// configuration is applied, so that the `DISABLE_SSL` property reaches the transport of the HTTP client.
+func (codeEngine *CodeEngineV2) configureService(serviceName string) (err error) {
+	codeEngine.withoutTransportLayers(func() {
+		err = codeEngine.Service.ConfigureService(serviceName)
+	})
+	return
+}
+
+// withoutTransportLayers removes the layers from the transport of the service while the configuration function runs.
+func (codeEngine *CodeEngineV2) withoutTransportLayers(configure func()) {
+	client := codeEngine.Service.GetHTTPClient()
+	if client == nil {
+		configure()
+		return
+	}
+	var layers []transportLayer
+	layers, client.Transport = splitTransport(client.Transport)
+	defer func() {
+		client.Transport = joinTransport(layers, client.Transport)
+	}()
+	configure()
+}
+
+// splitTransport returns the layers of a transport, from the outermost to the innermost, and the transport that they
+// wrap.
+func splitTransport(transport http.RoundTripper) (layers []transportLayer, base http.RoundTripper) {
+	for {
+		layer, ok := transport.(transportLayer)
+		if !ok {
+			return layers, transport
+		}
+		layers = append(layers, layer)
+		transport = layer.unwrap()
+	}
+}
+
+// joinTransport wraps the transport in the layers, of which the first is the outermost.
+func joinTransport(layers []transportLayer, base http.RoundTripper) http.RoundTripper {
+	if len(layers) > 0 && base == nil {
+		base = http.DefaultTransport
+	}
+	transport := base
+	for i := len(layers) - 1; i >= 0; i-- {
+		transport = layers[i].wrap(transport)
+	}
+	return transport
+}
+
+// hookRetries adds hooks to the retryable client of the service, if retries are enabled, that pass each call of an
+// operation to the transport layers in the context of its attempts, so that the layers can tell the attempts of a call
+// apart and observe the end of the call. A retryable client that already has a request hook is left unchanged.
+func (codeEngine *CodeEngineV2) hookRetries() {
+	if codeEngine.Service.Client == nil {
+		return
+	}
+	retryable, ok := codeEngine.Service.Client.Transport.(*retryablehttp.RoundTripper)
+	if !ok || retryable.Client == nil || retryable.Client.RequestLogHook != nil {
+		return
+	}
+	client := retryable.Client
+	client.RequestLogHook = func(_ retryablehttp.Logger, req *http.Request, attempt int) {
+		if attempt == 0 {
+			*req = *req.WithContext(context.WithValue(req.Context(), retriedCallKey{}, &retriedCall{}))
+		} else if call, ok := req.Context().Value(retriedCallKey{}).(*retriedCall); ok {
+			call.retry(attempt)
+		}
+	}
+	checkRetry := client.CheckRetry
+	client.CheckRetry = func(ctx context.Context, resp *http.Response, err error) (bool, error) {
+		retry, checkErr := checkRetry(ctx, resp, err)
+		if call, ok := ctx.Value(retriedCallKey{}).(*retriedCall); ok {
+			call.checked(ctx, retry && call.attempts() <= client.RetryMax)
+		}
+		return retry, checkErr
+	}
+}
+
+// callState is the state of a transport layer for a call of an operation, which is ended with the call.
+type callState interface {
+	end()
+}
+
+// callStateOf returns the state of the layer of the rank for the call of a request, which newState creates on the
+// first attempt of the call. The state of a request without retries is standalone and must be ended by the layer once
+// the request has been sent.
+func callStateOf(req *http.Request, rank int, newState func() callState) (state callState, standalone bool) {
+	call, ok := req.Context().Value(retriedCallKey{}).(*retriedCall)
+	if !ok {
+		return newState(), true
+	}
+	call.mutex.Lock()
+	defer call.mutex.Unlock()
+	if call.states == nil {
+		call.states = map[int]callState{}
+	}
+	state, ok = call.states[rank]
+	if !ok {
+		state = newState()
+		call.states[rank] = state
+	}
+	return state, false
+}
+
+// resendCount returns the number of times that the request has been sent before, which is 0 without retries.
+func resendCount(req *http.Request) int64 {
+	if call, ok := req.Context().Value(retriedCallKey{}).(*retriedCall); ok {
+		return int64(call.attempts() - 1)
+	}
+	return 0
+}
+
+// retriedCallKey is the context key of the retriedCall of a request.
+type retriedCallKey struct{}
+
+// retriedCall is a call of an operation whose attempts are sent by the retryable client of a service.
+type retriedCall struct {
+	mutex   sync.Mutex
+	attempt int
+	ended   bool
+	states  map[int]callState
+
+	// stopEnd stops ending the call when the context of a retried call ends.
+	stopEnd func() bool
+}
+
+// retry starts another attempt of the call.
+func (call *retriedCall) retry(attempt int) {
+	call.mutex.Lock()
+	defer call.mutex.Unlock()
+	if call.stopEnd != nil {
+		call.stopEnd()
+		call.stopEnd = nil
+	}
+	call.attempt = attempt
+}
+
+// attempts returns the number of attempts of the call so far.
+func (call *retriedCall) attempts() int {
+	call.mutex.Lock()
+	defer call.mutex.Unlock()
+	return call.attempt + 1
+}
+
+// checked ends the call after an attempt unless the attempt is retried. A retried call ends if its context ends while
+// it waits for the retry.
+func (call *retriedCall) checked(ctx context.Context, retried bool) {
+	if !retried {
+		call.end()
+		return
+	}
+	call.mutex.Lock()
+	defer call.mutex.Unlock()
+	call.stopEnd = context.AfterFunc(ctx, call.end)
+}
+
+// end ends the states of the layers, from the outermost to the innermost layer.
+func (call *retriedCall) end() {
+	call.mutex.Lock()
+	if call.ended {
+		call.mutex.Unlock()
+		return
+	}
+	call.ended = true
+	ranks := slices.Sorted(maps.Keys(call.states))
+	states := call.states
+	call.mutex.Unlock()
+	for _, rank := range ranks {
+		states[rank].end()
+	}
+}
@@ -0,0 +1,537 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codeenginev2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	common "github.com/IBM/code-engine-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Default values used by the Wait* helpers when the corresponding WaitOptions field is not set.
+const (
+	DefaultWaitInitialInterval = 2 * time.Second
+	DefaultWaitMaxInterval     = 30 * time.Second
+	DefaultWaitMultiplier      = 1.5
+)
+
+// WaitOptions : Options that control how the Wait* helpers poll a resource.
+type WaitOptions struct {
+	// The delay between the first and the second poll. The first poll is issued immediately.
+	// Defaults to DefaultWaitInitialInterval.
+	InitialInterval time.Duration
+
+	// The upper bound for the delay between two polls. Defaults to DefaultWaitMaxInterval.
+	MaxInterval time.Duration
+
+	// The factor that the delay is multiplied with after every poll. Values below 1 are treated as 1.
+	// Defaults to DefaultWaitMultiplier.
+	Multiplier float64
+
+	// The maximum time to wait in total. If not set, the wait is only bounded by the context.
+	Timeout time.Duration
+
+	// Allows users to set headers on the API requests issued while polling.
+	Headers map[string]string
+}
+
+// NewWaitOptions : Instantiate WaitOptions
+func NewWaitOptions() *WaitOptions {
+	return &WaitOptions{}
+}
+
+// SetInitialInterval : Allow user to set InitialInterval
+func (_options *WaitOptions) SetInitialInterval(initialInterval time.Duration) *WaitOptions {
+	_options.InitialInterval = initialInterval
+	return _options
+}
+
+// SetMaxInterval : Allow user to set MaxInterval
+func (_options *WaitOptions) SetMaxInterval(maxInterval time.Duration) *WaitOptions {
+	_options.MaxInterval = maxInterval
+	return _options
+}
+
+// SetMultiplier : Allow user to set Multiplier
+func (_options *WaitOptions) SetMultiplier(multiplier float64) *WaitOptions {
+	_options.Multiplier = multiplier
+	return _options
+}
+
+// SetTimeout : Allow user to set Timeout
+func (_options *WaitOptions) SetTimeout(timeout time.Duration) *WaitOptions {
+	_options.Timeout = timeout
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (_options *WaitOptions) SetHeaders(param map[string]string) *WaitOptions {
+	_options.Headers = param
+	return _options
+}
+
+// ResourceFailedError is returned by the Wait* helpers if the resource being waited for
+// reaches a terminal failure status. Use errors.As to retrieve it from the returned error.
+type ResourceFailedError struct {
+	// The kind of resource, e.g. "app" or "build_run".
+	ResourceType string
+
+	// The ID of the project that contains the resource.
+	ProjectID string
+
+	// The name of the resource.
+	Name string
+
+	// The final status of the resource.
+	Status string
+
+	// The reason reported in the status details of the resource, if any.
+	Reason string
+}
+
+// Error returns a human-readable description of the failure.
+func (e *ResourceFailedError) Error() string {
+	msg := fmt.Sprintf("%s '%s' in project '%s' reached status '%s'", e.ResourceType, e.Name, e.ProjectID, e.Status)
+	if e.Reason != "" {
+		msg += fmt.Sprintf(" (reason: %s)", e.Reason)
+	}
+	return msg
+}
+
+// WaitForAppReady polls the specified app until its status is App_Status_Ready or App_Status_Failed.
+// If the app fails, the returned error wraps a *ResourceFailedError carrying the final StatusDetails.Reason.
+// The last observed app is returned in all cases where it could be retrieved.
+func (codeEngine *CodeEngineV2) WaitForAppReady(ctx context.Context, projectID string, name string, waitOptions *WaitOptions) (result *App, err error) {
+	projectID, err = codeEngine.ResolveProjectID(ctx, projectID)
+	if err != nil {
+		return
+	}
+	getAppOptions := codeEngine.NewGetAppOptions(projectID, name)
+	getAppOptions.Headers = waitOptions.headers()
+
+	err = waitOptions.poll(ctx, func(ctx context.Context) (bool, error) {
+		app, _, err := codeEngine.GetAppWithContext(ctx, getAppOptions)
+		if err != nil {
+			return false, err
+		}
+		result = app
+
+		switch core.StringNilMapper(app.Status) {
+		case App_Status_Ready:
+			return true, nil
+		case App_Status_Failed:
+			failedErr := &ResourceFailedError{
+				ResourceType: "app",
+				ProjectID:    projectID,
+				Name:         name,
+				Status:       App_Status_Failed,
+			}
+			if app.StatusDetails != nil {
+				failedErr.Reason = core.StringNilMapper(app.StatusDetails.Reason)
+			}
+			return true, failedErr
+		}
+		return false, nil
+	})
+	if err != nil {
+		err = core.SDKErrorf(err, "", "wait-app-ready-error", common.GetComponentInfo())
+	}
+	return
+}
+
+// WaitForFunctionReady polls the specified function until its status is Function_Status_Ready or Function_Status_Failed.
+// If the function fails, the returned error wraps a *ResourceFailedError carrying the final StatusDetails.Reason.
+// The last observed function is returned in all cases where it could be retrieved.
+func (codeEngine *CodeEngineV2) WaitForFunctionReady(ctx context.Context, projectID string, name string, waitOptions *WaitOptions) (result *Function, err error) {
+	projectID, err = codeEngine.ResolveProjectID(ctx, projectID)
+	if err != nil {
+		return
+	}
+	getFunctionOptions := codeEngine.NewGetFunctionOptions(projectID, name)
+	getFunctionOptions.Headers = waitOptions.headers()
+
+	err = waitOptions.poll(ctx, func(ctx context.Context) (bool, error) {
+		function, _, err := codeEngine.GetFunctionWithContext(ctx, getFunctionOptions)
+		if err != nil {
+			return false, err
+		}
+		result = function
+
+		switch core.StringNilMapper(function.Status) {
+		case Function_Status_Ready:
+			return true, nil
+		case Function_Status_Failed:
+			failedErr := &ResourceFailedError{
+				ResourceType: "function",
+				ProjectID:    projectID,
+				Name:         name,
+				Status:       Function_Status_Failed,
+			}
+			if function.StatusDetails != nil {
+				failedErr.Reason = core.StringNilMapper(function.StatusDetails.Reason)
+			}
+			return true, failedErr
+		}
+		return false, nil
+	})
+	if err != nil {
+		err = core.SDKErrorf(err, "", "wait-function-ready-error", common.GetComponentInfo())
+	}
+	return
+}
+
+// WaitForDomainMappingReady polls the specified domain mapping until its status is DomainMapping_Status_Ready or
+// DomainMapping_Status_Failed. If the domain mapping fails, the returned error wraps a *ResourceFailedError carrying
+// the final StatusDetails.Reason. The last observed domain mapping is returned in all cases where it could be retrieved.
+func (codeEngine *CodeEngineV2) WaitForDomainMappingReady(ctx context.Context, projectID string, name string, waitOptions *WaitOptions) (result *DomainMapping, err error) {
+	projectID, err = codeEngine.ResolveProjectID(ctx, projectID)
+	if err != nil {
+		return
+	}
+	getDomainMappingOptions := codeEngine.NewGetDomainMappingOptions(projectID, name)
+	getDomainMappingOptions.Headers = waitOptions.headers()
+
+	err = waitOptions.poll(ctx, func(ctx context.Context) (bool, error) {
+		domainMapping, _, err := codeEngine.GetDomainMappingWithContext(ctx, getDomainMappingOptions)
+		if err != nil {
+			return false, err
+		}
+		result = domainMapping
+
+		switch core.StringNilMapper(domainMapping.Status) {
+		case DomainMapping_Status_Ready:
+			return true, nil
+		case DomainMapping_Status_Failed:
+			failedErr := &ResourceFailedError{
+				ResourceType: "domain_mapping",
+				ProjectID:    projectID,
+				Name:         name,
+				Status:       DomainMapping_Status_Failed,
+			}
+			if domainMapping.StatusDetails != nil {
+				failedErr.Reason = core.StringNilMapper(domainMapping.StatusDetails.Reason)
+			}
+			return true, failedErr
+		}
+		return false, nil
+	})
+	if err != nil {
+		err = core.SDKErrorf(err, "", "wait-domain-mapping-ready-error", common.GetComponentInfo())
+	}
+	return
+}
+
+// JobRunProgress : A snapshot of the progress of a job run, as observed by one poll of WaitForJobRunCompletion.
+type JobRunProgress struct {
+	// The job run as returned by the poll.
+	JobRun *JobRun
+
+	// The current status of the job run.
+	Status string
+
+	// Number of requested job run instances.
+	Requested int64
+
+	// Number of succeeded job run instances.
+	Succeeded int64
+
+	// Number of failed job run instances.
+	Failed int64
+
+	// Number of running job run instances.
+	Running int64
+
+	// Number of pending job run instances.
+	Pending int64
+
+	// Number of job run instances with unknown state.
+	Unknown int64
+
+	// The job run indices that failed, parsed from `FailedIndices`. Empty if the indices cannot be parsed.
+	FailedIndices IndexRanges
+
+	// The job run indices that failed, as reported by the API, e.g. `2,5-7`.
+	RawFailedIndices string
+}
+
+// JobRunProgressFunc is invoked by WaitForJobRunCompletion with the progress observed by every poll.
+type JobRunProgressFunc func(progress *JobRunProgress)
+
+// NewJobRunProgressChannelFunc returns a JobRunProgressFunc that sends every progress event to "progress".
+// Each send blocks until the event is received or "ctx" is done, so that the final event is not lost.
+func NewJobRunProgressChannelFunc(ctx context.Context, progress chan<- *JobRunProgress) JobRunProgressFunc {
+	return func(jobRunProgress *JobRunProgress) {
+		select {
+		case progress <- jobRunProgress:
+		case <-ctx.Done():
+		}
+	}
+}
+
+// newJobRunProgress builds the progress snapshot of the specified job run. Failed indices that cannot be parsed are
+// only reported in RawFailedIndices, so that a malformed progress field does not end the wait for the job run.
+func newJobRunProgress(jobRun *JobRun) (progress *JobRunProgress) {
+	progress = &JobRunProgress{
+		JobRun: jobRun,
+		Status: core.StringNilMapper(jobRun.Status),
+	}
+	if jobRun.StatusDetails == nil {
+		return
+	}
+
+	statusDetails := jobRun.StatusDetails
+	progress.Requested = int64NilMapper(statusDetails.Requested)
+	progress.Succeeded = int64NilMapper(statusDetails.Succeeded)
+	progress.Failed = int64NilMapper(statusDetails.Failed)
+	progress.Running = int64NilMapper(statusDetails.Running)
+	progress.Pending = int64NilMapper(statusDetails.Pending)
+	progress.Unknown = int64NilMapper(statusDetails.Unknown)
+	progress.RawFailedIndices = core.StringNilMapper(statusDetails.FailedIndices)
+	if failedIndices, err := ParseIndexRanges(progress.RawFailedIndices); err == nil {
+		progress.FailedIndices = failedIndices
+	}
+	return
+}
+
+// WaitForJobRunCompletion polls the specified job run until its status is JobRun_Status_Completed or
+// JobRun_Status_Failed. If "onProgress" is not nil, it is invoked synchronously with the progress observed by every
+// poll, including the final one. The final progress is returned as a summary of the run, including the parsed ranges
+// of failed indices. If the job run fails, the returned error wraps a *ResourceFailedError whose Reason is the last
+// failure reason of the lowest failed index, if the API reported one.
+//
+// Job runs in `daemon` run mode do not complete and must be waited for with a Timeout or a cancellable context.
+func (codeEngine *CodeEngineV2) WaitForJobRunCompletion(ctx context.Context, projectID string, name string, waitOptions *WaitOptions, onProgress JobRunProgressFunc) (result *JobRunProgress, err error) {
+	projectID, err = codeEngine.ResolveProjectID(ctx, projectID)
+	if err != nil {
+		return
+	}
+	getJobRunOptions := codeEngine.NewGetJobRunOptions(projectID, name)
+	getJobRunOptions.Headers = waitOptions.headers()
+
+	err = waitOptions.poll(ctx, func(ctx context.Context) (bool, error) {
+		jobRun, _, err := codeEngine.GetJobRunWithContext(ctx, getJobRunOptions)
+		if err != nil {
+			return false, err
+		}
+		progress := newJobRunProgress(jobRun)
+		result = progress
+		if onProgress != nil {
+			onProgress(progress)
+		}
+
+		switch progress.Status {
+		case JobRun_Status_Completed:
+			return true, nil
+		case JobRun_Status_Failed:
+			failedErr := &ResourceFailedError{
+				ResourceType: "job_run",
+				ProjectID:    projectID,
+				Name:         name,
+				Status:       JobRun_Status_Failed,
+			}
+			if len(progress.FailedIndices) > 0 && jobRun.StatusDetails.IndicesDetails != nil {
+				indexDetails := jobRun.StatusDetails.IndicesDetails[fmt.Sprint(progress.FailedIndices[0].Start)]
+				failedErr.Reason = core.StringNilMapper(indexDetails.LastFailureReason)
+			}
+			return true, failedErr
+		}
+		return false, nil
+	})
+	if err != nil {
+		err = core.SDKErrorf(err, "", "wait-job-run-completion-error", common.GetComponentInfo())
+	}
+	return
+}
+
+// BuildRunResult : The outcome of a build run, as returned by WaitForBuildRunCompletion.
+type BuildRunResult struct {
+	// The build run as returned by the last poll.
+	BuildRun *BuildRun
+
+	// The name of the image the build run pushed to.
+	OutputImage string
+
+	// The digest of the image the build run pushed, e.g. `sha256:...`.
+	OutputDigest string
+
+	// The SHA of the git commit that was built. Only set for git sources.
+	GitCommitSha string
+}
+
+// ImageReference returns the digest-pinned reference of the built image, e.g. `icr.io/ns/app@sha256:...`,
+// which is suitable to be used as `ImageReference` of an app or job. If the digest is not known, the output
+// image is returned unchanged.
+func (buildRunResult *BuildRunResult) ImageReference() string {
+	if buildRunResult.OutputDigest == "" {
+		return buildRunResult.OutputImage
+	}
+	image := buildRunResult.OutputImage
+	if i := strings.LastIndex(image, "@"); i >= 0 {
+		image = image[:i]
+	} else if i := strings.LastIndex(image, ":"); i > strings.LastIndex(image, "/") {
+		image = image[:i]
+	}
+	return image + "@" + buildRunResult.OutputDigest
+}
+
+// WaitForBuildRunCompletion polls the specified build run until its status is BuildRun_Status_Succeeded or
+// BuildRun_Status_Failed. On success, the result carries the output digest and git commit SHA of the build.
+// If the build run fails, the returned error wraps a *ResourceFailedError carrying the final StatusDetails.Reason.
+// The last observed build run is returned in all cases where it could be retrieved.
+func (codeEngine *CodeEngineV2) WaitForBuildRunCompletion(ctx context.Context, projectID string, name string, waitOptions *WaitOptions) (result *BuildRunResult, err error) {
+	projectID, err = codeEngine.ResolveProjectID(ctx, projectID)
+	if err != nil {
+		return
+	}
+	getBuildRunOptions := codeEngine.NewGetBuildRunOptions(projectID, name)
+	getBuildRunOptions.Headers = waitOptions.headers()
+
+	err = waitOptions.poll(ctx, func(ctx context.Context) (bool, error) {
+		buildRun, _, err := codeEngine.GetBuildRunWithContext(ctx, getBuildRunOptions)
+		if err != nil {
+			return false, err
+		}
+		result = &BuildRunResult{
+			BuildRun:    buildRun,
+			OutputImage: core.StringNilMapper(buildRun.OutputImage),
+		}
+		if buildRun.StatusDetails != nil {
+			result.OutputDigest = core.StringNilMapper(buildRun.StatusDetails.OutputDigest)
+			result.GitCommitSha = core.StringNilMapper(buildRun.StatusDetails.GitCommitSha)
+		}
+
+		switch core.StringNilMapper(buildRun.Status) {
+		case BuildRun_Status_Succeeded:
+			return true, nil
+		case BuildRun_Status_Failed:
+			failedErr := &ResourceFailedError{
+				ResourceType: "build_run",
+				ProjectID:    projectID,
+				Name:         name,
+				Status:       BuildRun_Status_Failed,
+			}
+			if buildRun.StatusDetails != nil {
+				failedErr.Reason = core.StringNilMapper(buildRun.StatusDetails.Reason)
+			}
+			return true, failedErr
+		}
+		return false, nil
+	})
+	if err != nil {
+		err = core.SDKErrorf(err, "", "wait-build-run-completion-error", common.GetComponentInfo())
+	}
+	return
+}
+
+// headers returns the headers to be set on the polling requests.
+func (_options *WaitOptions) headers() map[string]string {
+	if _options == nil {
+		return nil
+	}
+	return _options.Headers
+}
+
+// poll invokes "condition" until it reports completion, returns an error that is not retryable, or the context is
+// done. The delay between two invocations grows exponentially according to the wait options. A retryable error, see
+// retryDelay, does not end the wait: the condition is invoked again after the delay, or after the `Retry-After` delay
+// of the error if it is longer. If the context is done after a retryable error, the message of the returned error
+// includes it.
+func (_options *WaitOptions) poll(ctx context.Context, condition func(context.Context) (bool, error)) error {
+	initialInterval := DefaultWaitInitialInterval
+	maxInterval := DefaultWaitMaxInterval
+	multiplier := DefaultWaitMultiplier
+	var timeout time.Duration
+	if _options != nil {
+		if _options.InitialInterval > 0 {
+			initialInterval = _options.InitialInterval
+		}
+		if _options.MaxInterval > 0 {
+			maxInterval = _options.MaxInterval
+		}
+		if _options.Multiplier > 0 {
+			multiplier = max(_options.Multiplier, 1)
+		}
+		timeout = _options.Timeout
+	}
+
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	interval := min(initialInterval, maxInterval)
+	var lastErr error
+	for {
+		done, err := condition(ctx)
+		if done {
+			return err
+		}
+		delay := interval
+		if err != nil && ctx.Err() == nil {
+			retryAfter, retryable := retryDelay(err)
+			if !retryable {
+				return err
+			}
+			lastErr = err
+			delay = max(delay, retryAfter)
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			switch {
+			case lastErr != nil:
+				return fmt.Errorf("%w, the last poll failed: %v", ctx.Err(), lastErr)
+			case err != nil:
+				return err
+			}
+			return ctx.Err()
+		case <-timer.C:
+		}
+
+		interval = min(time.Duration(float64(interval)*multiplier), maxInterval)
+	}
+}
+
+// retryDelay returns whether the error of a poll is retryable, and the delay that the server requested before the
+// next request, if any. Errors responses with a 5xx status code and rate limited requests (429) are retryable, as are
+// requests that failed without a response.
+func retryDelay(err error) (time.Duration, bool) {
+	if apiErr, ok := AsAPIError(err); ok {
+		retryable := apiErr.StatusCode >= http.StatusInternalServerError || apiErr.StatusCode == http.StatusTooManyRequests
+		return apiErr.RetryAfter, retryable
+	}
+	var urlErr *url.Error
+	return 0, errors.As(err, &urlErr) && !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+}
+
+// int64NilMapper de-references the specified pointer, mapping nil to 0.
+func int64NilMapper(i *int64) int64 {
+	if i == nil {
+		return 0
+	}
+	return *i
+}
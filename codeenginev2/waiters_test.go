@@ -0,0 +1,335 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codeenginev2_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/IBM/code-engine-go-sdk/codeenginev2"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`CodeEngineV2 waiters`, func() {
+	var testServer *httptest.Server
+	projectID := "15314cc3-85b4-4338-903f-c28cdee6d005"
+	waitOptions := codeenginev2.NewWaitOptions().
+		SetInitialInterval(time.Millisecond).
+		SetMaxInterval(5 * time.Millisecond)
+
+	// statusServer serves the given status bodies in sequence for the given path, repeating the last one.
+	statusServer := func(path string, bodies ...string) *httptest.Server {
+		calls := 0
+		return httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			Expect(req.URL.EscapedPath()).To(Equal(path))
+			Expect(req.Method).To(Equal("GET"))
+			Expect(req.Header["X-Custom-Header"]).To(Equal([]string{"x-custom-value"}))
+			body := bodies[min(calls, len(bodies)-1)]
+			calls++
+
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(200)
+			fmt.Fprint(res, body)
+		}))
+	}
+
+	newService := func() *codeenginev2.CodeEngineV2 {
+		codeEngineService, serviceErr := codeenginev2.NewCodeEngineV2(&codeenginev2.CodeEngineV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		Expect(codeEngineService).ToNot(BeNil())
+		return codeEngineService
+	}
+
+	BeforeEach(func() {
+		waitOptions.SetHeaders(map[string]string{"x-custom-header": "x-custom-value"})
+	})
+	AfterEach(func() {
+		if testServer != nil {
+			testServer.Close()
+		}
+	})
+
+	Describe(`WaitForAppReady(ctx, projectID, name, waitOptions)`, func() {
+		path := "/projects/" + projectID + "/apps/my-app"
+		It(`Returns the app once it is ready`, func() {
+			testServer = statusServer(path,
+				`{"name": "my-app", "status": "deploying"}`,
+				`{"name": "my-app", "status": "deploying"}`,
+				`{"name": "my-app", "status": "ready", "status_details": {"reason": "ready"}}`)
+
+			app, err := newService().WaitForAppReady(context.Background(), projectID, "my-app", waitOptions)
+			Expect(err).To(BeNil())
+			Expect(app).ToNot(BeNil())
+			Expect(*app.Status).To(Equal(codeenginev2.App_Status_Ready))
+		})
+		It(`Returns a ResourceFailedError once the app failed`, func() {
+			testServer = statusServer(path,
+				`{"name": "my-app", "status": "deploying"}`,
+				`{"name": "my-app", "status": "failed", "status_details": {"reason": "image_pull_back_off"}}`)
+
+			app, err := newService().WaitForAppReady(context.Background(), projectID, "my-app", waitOptions)
+			Expect(err).ToNot(BeNil())
+			Expect(app).ToNot(BeNil())
+			Expect(*app.Status).To(Equal(codeenginev2.App_Status_Failed))
+
+			var failedErr *codeenginev2.ResourceFailedError
+			Expect(errors.As(err, &failedErr)).To(BeTrue())
+			Expect(failedErr.ResourceType).To(Equal("app"))
+			Expect(failedErr.Name).To(Equal("my-app"))
+			Expect(failedErr.Status).To(Equal(codeenginev2.App_Status_Failed))
+			Expect(failedErr.Reason).To(Equal("image_pull_back_off"))
+			Expect(err.Error()).To(ContainSubstring("image_pull_back_off"))
+		})
+		It(`Stops polling when the timeout expires`, func() {
+			testServer = statusServer(path, `{"name": "my-app", "status": "deploying"}`)
+
+			timeoutOptions := *waitOptions
+			timeoutOptions.Timeout = 20 * time.Millisecond
+			app, err := newService().WaitForAppReady(context.Background(), projectID, "my-app", &timeoutOptions)
+			Expect(err).ToNot(BeNil())
+			Expect(errors.Is(err, context.DeadlineExceeded)).To(BeTrue())
+			Expect(app).ToNot(BeNil())
+			Expect(*app.Status).To(Equal(codeenginev2.App_Status_Deploying))
+		})
+		It(`Returns the request error if the app cannot be retrieved`, func() {
+			testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				res.Header().Set("Content-type", "application/json")
+				res.WriteHeader(404)
+				fmt.Fprint(res, `{"errors": [{"code": "not_found", "message": "app not found"}]}`)
+			}))
+
+			app, err := newService().WaitForAppReady(context.Background(), projectID, "my-app", nil)
+			Expect(err).ToNot(BeNil())
+			Expect(app).To(BeNil())
+			var httpErr *core.HTTPProblem
+			Expect(errors.As(err, &httpErr)).To(BeTrue())
+			Expect(httpErr.Response.GetStatusCode()).To(Equal(404))
+		})
+	})
+
+	Describe(`Retryable errors`, func() {
+		path := "/projects/" + projectID + "/apps/my-app"
+		It(`Keeps polling after server errors, rate limiting and dropped connections`, func() {
+			calls := 0
+			testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				Expect(req.URL.EscapedPath()).To(Equal(path))
+				calls++
+				res.Header().Set("Content-type", "application/json")
+				switch calls {
+				case 1:
+					res.WriteHeader(503)
+					fmt.Fprint(res, `{"errors": [{"code": "service_unavailable", "message": "try again"}]}`)
+				case 2:
+					res.Header().Set("Retry-After", "1")
+					res.WriteHeader(429)
+					fmt.Fprint(res, `{"errors": [{"code": "too_many_requests", "message": "slow down"}]}`)
+				case 3:
+					conn, _, err := res.(http.Hijacker).Hijack()
+					Expect(err).To(BeNil())
+					conn.Close()
+				default:
+					res.WriteHeader(200)
+					fmt.Fprint(res, `{"name": "my-app", "status": "ready"}`)
+				}
+			}))
+
+			start := time.Now()
+			app, err := newService().WaitForAppReady(context.Background(), projectID, "my-app", waitOptions)
+			Expect(err).To(BeNil())
+			Expect(*app.Status).To(Equal(codeenginev2.App_Status_Ready))
+			Expect(calls).To(Equal(4))
+			Expect(time.Since(start)).To(BeNumerically(">=", time.Second))
+		})
+		It(`Reports the last retryable error when the timeout expires`, func() {
+			testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				res.Header().Set("Content-type", "application/json")
+				res.WriteHeader(502)
+				fmt.Fprint(res, `{"errors": [{"code": "bad_gateway", "message": "bad gateway"}]}`)
+			}))
+
+			timeoutOptions := *waitOptions
+			timeoutOptions.Timeout = 20 * time.Millisecond
+			_, err := newService().WaitForAppReady(context.Background(), projectID, "my-app", &timeoutOptions)
+			Expect(err).ToNot(BeNil())
+			Expect(errors.Is(err, context.DeadlineExceeded)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("the last poll failed: bad gateway"))
+		})
+	})
+
+	Describe(`WaitForFunctionReady(ctx, projectID, name, waitOptions)`, func() {
+		path := "/projects/" + projectID + "/functions/my-function"
+		It(`Returns the function once it is ready`, func() {
+			testServer = statusServer(path,
+				`{"name": "my-function", "status": "deploying"}`,
+				`{"name": "my-function", "status": "ready"}`)
+
+			function, err := newService().WaitForFunctionReady(context.Background(), projectID, "my-function", waitOptions)
+			Expect(err).To(BeNil())
+			Expect(*function.Status).To(Equal(codeenginev2.Function_Status_Ready))
+		})
+		It(`Returns a ResourceFailedError once the function failed`, func() {
+			testServer = statusServer(path,
+				`{"name": "my-function", "status": "failed", "status_details": {"reason": "no_code_bundle"}}`)
+
+			_, err := newService().WaitForFunctionReady(context.Background(), projectID, "my-function", waitOptions)
+			var failedErr *codeenginev2.ResourceFailedError
+			Expect(errors.As(err, &failedErr)).To(BeTrue())
+			Expect(failedErr.ResourceType).To(Equal("function"))
+			Expect(failedErr.Reason).To(Equal("no_code_bundle"))
+		})
+	})
+
+	Describe(`WaitForDomainMappingReady(ctx, projectID, name, waitOptions)`, func() {
+		path := "/projects/" + projectID + "/domain_mappings/www.example.com"
+		It(`Returns the domain mapping once it is ready`, func() {
+			testServer = statusServer(path,
+				`{"name": "www.example.com", "status": "deploying"}`,
+				`{"name": "www.example.com", "status": "ready"}`)
+
+			domainMapping, err := newService().WaitForDomainMappingReady(context.Background(), projectID, "www.example.com", waitOptions)
+			Expect(err).To(BeNil())
+			Expect(*domainMapping.Status).To(Equal(codeenginev2.DomainMapping_Status_Ready))
+		})
+		It(`Returns a ResourceFailedError once the domain mapping failed`, func() {
+			testServer = statusServer(path,
+				`{"name": "www.example.com", "status": "failed", "status_details": {"reason": "domain_already_claimed"}}`)
+
+			_, err := newService().WaitForDomainMappingReady(context.Background(), projectID, "www.example.com", waitOptions)
+			var failedErr *codeenginev2.ResourceFailedError
+			Expect(errors.As(err, &failedErr)).To(BeTrue())
+			Expect(failedErr.ResourceType).To(Equal("domain_mapping"))
+			Expect(failedErr.Reason).To(Equal("domain_already_claimed"))
+		})
+	})
+
+	Describe(`WaitForJobRunCompletion(ctx, projectID, name, waitOptions, onProgress)`, func() {
+		path := "/projects/" + projectID + "/job_runs/my-job-run"
+		It(`Reports progress on every poll and returns the final summary`, func() {
+			testServer = statusServer(path,
+				`{"name": "my-job-run", "status": "pending", "status_details": {"requested": 10, "pending": 10}}`,
+				`{"name": "my-job-run", "status": "running", "status_details": {"requested": 10, "running": 6, "succeeded": 4, "pending": 0}}`,
+				`{"name": "my-job-run", "status": "completed", "status_details": {"requested": 10, "succeeded": 10}}`)
+
+			var observed []*codeenginev2.JobRunProgress
+			result, err := newService().WaitForJobRunCompletion(context.Background(), projectID, "my-job-run", waitOptions, func(progress *codeenginev2.JobRunProgress) {
+				observed = append(observed, progress)
+			})
+			Expect(err).To(BeNil())
+			Expect(observed).To(HaveLen(3))
+			Expect(observed[0].Status).To(Equal(codeenginev2.JobRun_Status_Pending))
+			Expect(observed[0].Pending).To(Equal(int64(10)))
+			Expect(observed[1].Running).To(Equal(int64(6)))
+			Expect(observed[1].Succeeded).To(Equal(int64(4)))
+			Expect(result).To(Equal(observed[2]))
+			Expect(result.Status).To(Equal(codeenginev2.JobRun_Status_Completed))
+			Expect(result.Succeeded).To(Equal(int64(10)))
+			Expect(result.FailedIndices).To(BeEmpty())
+		})
+		It(`Returns the failed indices and a ResourceFailedError once the job run failed`, func() {
+			testServer = statusServer(path,
+				`{"name": "my-job-run", "status": "failed", "status_details": {"requested": 10, "succeeded": 6, "failed": 4, "failed_indices": "2,5-7", "indices_details": {"2": {"status": "failed", "last_failure_reason": "OOMKilled"}}}}`)
+
+			progressChannel := make(chan *codeenginev2.JobRunProgress, 1)
+			onProgress := codeenginev2.NewJobRunProgressChannelFunc(context.Background(), progressChannel)
+			result, err := newService().WaitForJobRunCompletion(context.Background(), projectID, "my-job-run", waitOptions, onProgress)
+			Expect(err).ToNot(BeNil())
+			Expect(<-progressChannel).To(Equal(result))
+			Expect(result.Failed).To(Equal(int64(4)))
+			Expect(result.FailedIndices).To(Equal(codeenginev2.IndexRanges{{Start: 2, End: 2}, {Start: 5, End: 7}}))
+			Expect(result.FailedIndices.Indices()).To(Equal([]int64{2, 5, 6, 7}))
+			Expect(result.RawFailedIndices).To(Equal("2,5-7"))
+
+			var failedErr *codeenginev2.ResourceFailedError
+			Expect(errors.As(err, &failedErr)).To(BeTrue())
+			Expect(failedErr.ResourceType).To(Equal("job_run"))
+			Expect(failedErr.Reason).To(Equal("OOMKilled"))
+		})
+		It(`Reports failed indices that cannot be parsed and keeps waiting`, func() {
+			testServer = statusServer(path,
+				`{"name": "my-job-run", "status": "running", "status_details": {"requested": 3, "failed": 1, "failed_indices": "2,x"}}`,
+				`{"name": "my-job-run", "status": "completed", "status_details": {"requested": 3, "succeeded": 3}}`)
+
+			var observed []*codeenginev2.JobRunProgress
+			result, err := newService().WaitForJobRunCompletion(context.Background(), projectID, "my-job-run", waitOptions, func(progress *codeenginev2.JobRunProgress) {
+				observed = append(observed, progress)
+			})
+			Expect(err).To(BeNil())
+			Expect(observed).To(HaveLen(2))
+			Expect(observed[0].RawFailedIndices).To(Equal("2,x"))
+			Expect(observed[0].FailedIndices).To(BeEmpty())
+			Expect(result.Status).To(Equal(codeenginev2.JobRun_Status_Completed))
+		})
+	})
+
+	Describe(`WaitForBuildRunCompletion(ctx, projectID, name, waitOptions)`, func() {
+		path := "/projects/" + projectID + "/build_runs/my-build-run"
+		It(`Returns the output digest and commit once the build run succeeded`, func() {
+			testServer = statusServer(path,
+				`{"name": "my-build-run", "status": "pending"}`,
+				`{"name": "my-build-run", "status": "running", "output_image": "private.de.icr.io/icr_namespace/image-name:latest"}`,
+				`{"name": "my-build-run", "status": "succeeded", "output_image": "private.de.icr.io/icr_namespace/image-name:latest", "status_details": {"output_digest": "sha256:9a3d845c629d2b4a6b271b1d526dfafc1e7d9511f8863b43b5bb0483ef626384", "git_commit_sha": "4f3e1a1"}}`)
+
+			result, err := newService().WaitForBuildRunCompletion(context.Background(), projectID, "my-build-run", waitOptions)
+			Expect(err).To(BeNil())
+			Expect(*result.BuildRun.Status).To(Equal(codeenginev2.BuildRun_Status_Succeeded))
+			Expect(result.OutputDigest).To(Equal("sha256:9a3d845c629d2b4a6b271b1d526dfafc1e7d9511f8863b43b5bb0483ef626384"))
+			Expect(result.GitCommitSha).To(Equal("4f3e1a1"))
+			Expect(result.ImageReference()).To(Equal("private.de.icr.io/icr_namespace/image-name@sha256:9a3d845c629d2b4a6b271b1d526dfafc1e7d9511f8863b43b5bb0483ef626384"))
+		})
+		It(`Returns a ResourceFailedError once the build run failed`, func() {
+			testServer = statusServer(path,
+				`{"name": "my-build-run", "status": "failed", "status_details": {"reason": "failed_to_execute_build_run"}}`)
+
+			result, err := newService().WaitForBuildRunCompletion(context.Background(), projectID, "my-build-run", waitOptions)
+			Expect(result).ToNot(BeNil())
+			var failedErr *codeenginev2.ResourceFailedError
+			Expect(errors.As(err, &failedErr)).To(BeTrue())
+			Expect(failedErr.ResourceType).To(Equal("build_run"))
+			Expect(failedErr.Reason).To(Equal("failed_to_execute_build_run"))
+		})
+	})
+
+	Describe(`BuildRunResult.ImageReference()`, func() {
+		It(`Pins the output image to the digest`, func() {
+			digest := "sha256:9a3d845c629d2b4a6b271b1d526dfafc1e7d9511f8863b43b5bb0483ef626384"
+			for image, expected := range map[string]string{
+				"icr.io/ns/app":                "icr.io/ns/app@" + digest,
+				"icr.io/ns/app:v1":             "icr.io/ns/app@" + digest,
+				"localhost:5000/ns/app":        "localhost:5000/ns/app@" + digest,
+				"localhost:5000/ns/app@sha1:0": "localhost:5000/ns/app@" + digest,
+			} {
+				result := &codeenginev2.BuildRunResult{OutputImage: image, OutputDigest: digest}
+				Expect(result.ImageReference()).To(Equal(expected))
+			}
+			result := &codeenginev2.BuildRunResult{OutputImage: "icr.io/ns/app:v1"}
+			Expect(result.ImageReference()).To(Equal("icr.io/ns/app:v1"))
+		})
+	})
+})
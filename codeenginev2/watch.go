@@ -0,0 +1,309 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codeenginev2
+
+import (
+	"context"
+	"reflect"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// DefaultWatchInterval is the default delay between two polls of a watch.
+const DefaultWatchInterval = 10 * time.Second
+
+// watchPageLimit is the page size of the lists that are polled by the watches.
+const watchPageLimit = 100
+
+// EventType : The type of a watch event.
+type EventType string
+
+// Constants associated with the EventType.
+const (
+	// The resource has been created, or existed when the watch started.
+	EventAdded EventType = "added"
+
+	// The entity tag or status of the resource has changed.
+	EventModified EventType = "modified"
+
+	// The resource has been deleted.
+	EventDeleted EventType = "deleted"
+
+	// Polling the resources failed. The watch continues with the next poll.
+	EventError EventType = "error"
+)
+
+// Event : A change of a resource that has been observed by a watch.
+type Event[T any] struct {
+	// The type of the event.
+	Type EventType
+
+	// The resource after the change. For deleted resources, the last observed resource.
+	Object T
+
+	// The resource before the change, for modified resources.
+	Previous T
+
+	// The error of a failed poll, for error events.
+	Err error
+}
+
+// WatchOptions : Options that control how the Watch* helpers poll the resources.
+type WatchOptions struct {
+	// The delay between two polls. Defaults to DefaultWatchInterval.
+	Interval time.Duration
+
+	// Allows users to set headers on the API requests issued while polling.
+	Headers map[string]string
+}
+
+// NewWatchOptions : Instantiate WatchOptions
+func NewWatchOptions() *WatchOptions {
+	return &WatchOptions{}
+}
+
+// SetInterval : Allow user to set Interval
+func (_options *WatchOptions) SetInterval(interval time.Duration) *WatchOptions {
+	_options.Interval = interval
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (_options *WatchOptions) SetHeaders(param map[string]string) *WatchOptions {
+	_options.Headers = param
+	return _options
+}
+
+// WatchApps polls the apps of the project and sends an event for each app that is added, modified or deleted, until the
+// context is done. See Watch.
+func (codeEngine *CodeEngineV2) WatchApps(ctx context.Context, projectID string, watchOptions *WatchOptions) <-chan Event[App] {
+	return Watch(ctx, func(ctx context.Context) ([]App, error) {
+		id, err := codeEngine.ResolveProjectID(ctx, projectID)
+		if err != nil {
+			return nil, err
+		}
+		listAppsOptions := codeEngine.NewListAppsOptions(id).SetLimit(watchPageLimit)
+		listAppsOptions.Headers = watchOptions.headers()
+		pager, err := codeEngine.NewAppsPager(listAppsOptions)
+		if err != nil {
+			return nil, err
+		}
+		return pager.GetAllWithContext(ctx)
+	}, watchOptions)
+}
+
+// WatchJobRuns polls the job runs of the project and sends an event for each job run that is added, modified or
+// deleted, until the context is done. See Watch.
+func (codeEngine *CodeEngineV2) WatchJobRuns(ctx context.Context, projectID string, watchOptions *WatchOptions) <-chan Event[JobRun] {
+	return Watch(ctx, func(ctx context.Context) ([]JobRun, error) {
+		id, err := codeEngine.ResolveProjectID(ctx, projectID)
+		if err != nil {
+			return nil, err
+		}
+		listJobRunsOptions := codeEngine.NewListJobRunsOptions(id).SetLimit(watchPageLimit)
+		listJobRunsOptions.Headers = watchOptions.headers()
+		pager, err := codeEngine.NewJobRunsPager(listJobRunsOptions)
+		if err != nil {
+			return nil, err
+		}
+		return pager.GetAllWithContext(ctx)
+	}, watchOptions)
+}
+
+// WatchBuildRuns polls the build runs of the project and sends an event for each build run that is added, modified or
+// deleted, until the context is done. See Watch.
+func (codeEngine *CodeEngineV2) WatchBuildRuns(ctx context.Context, projectID string, watchOptions *WatchOptions) <-chan Event[BuildRun] {
+	return Watch(ctx, func(ctx context.Context) ([]BuildRun, error) {
+		id, err := codeEngine.ResolveProjectID(ctx, projectID)
+		if err != nil {
+			return nil, err
+		}
+		listBuildRunsOptions := codeEngine.NewListBuildRunsOptions(id).SetLimit(watchPageLimit)
+		listBuildRunsOptions.Headers = watchOptions.headers()
+		pager, err := codeEngine.NewBuildRunsPager(listBuildRunsOptions)
+		if err != nil {
+			return nil, err
+		}
+		return pager.GetAllWithContext(ctx)
+	}, watchOptions)
+}
+
+// WatchDomainMappings polls the domain mappings of the project and sends an event for each domain mapping that is
+// added, modified or deleted, until the context is done. See Watch.
+func (codeEngine *CodeEngineV2) WatchDomainMappings(ctx context.Context, projectID string, watchOptions *WatchOptions) <-chan Event[DomainMapping] {
+	return Watch(ctx, func(ctx context.Context) ([]DomainMapping, error) {
+		id, err := codeEngine.ResolveProjectID(ctx, projectID)
+		if err != nil {
+			return nil, err
+		}
+		listDomainMappingsOptions := codeEngine.NewListDomainMappingsOptions(id).SetLimit(watchPageLimit)
+		listDomainMappingsOptions.Headers = watchOptions.headers()
+		pager, err := codeEngine.NewDomainMappingsPager(listDomainMappingsOptions)
+		if err != nil {
+			return nil, err
+		}
+		return pager.GetAllWithContext(ctx)
+	}, watchOptions)
+}
+
+// Watch polls the resources that the list function returns, e.g. all items of a pager, at the interval of the watch
+// options and sends an event for each resource that has been added, modified or deleted since the previous poll. The
+// first poll sends an added event for each existing resource. Resources are identified by their ID, or by their name if
+// they have no ID, and are modified if their entity tag or status has changed. A failed poll sends an error event and
+// the watch continues with the next poll.
+//
+// The returned channel is unbuffered and is closed when the context is done, so the events must be received until then.
+func Watch[T any](ctx context.Context, list func(ctx context.Context) ([]T, error), watchOptions *WatchOptions) <-chan Event[T] {
+	interval := DefaultWatchInterval
+	if watchOptions != nil && watchOptions.Interval > 0 {
+		interval = watchOptions.Interval
+	}
+
+	events := make(chan Event[T])
+	send := func(event Event[T]) bool {
+		select {
+		case events <- event:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+
+	go func() {
+		defer close(events)
+
+		var known []watchedItem[T]
+		for {
+			items, err := list(ctx)
+			switch {
+			case ctx.Err() != nil:
+				return
+			case err != nil:
+				if !send(Event[T]{Type: EventError, Err: core.RepurposeSDKProblem(err, "watch-list-error")}) {
+					return
+				}
+			default:
+				var changes []Event[T]
+				known, changes = diffSnapshots(known, items)
+				for _, event := range changes {
+					if !send(event) {
+						return
+					}
+				}
+			}
+
+			timer := time.NewTimer(interval)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return
+			case <-timer.C:
+			}
+		}
+	}()
+	return events
+}
+
+// watchedItem is a resource of a snapshot of a watch.
+type watchedItem[T any] struct {
+	key    string
+	item   T
+	entity string
+}
+
+// diffSnapshots returns the snapshot of the items and the events that lead from the previous snapshot to it. Added and
+// modified events are in the order of the items, deleted events in the order of the previous snapshot.
+func diffSnapshots[T any](previous []watchedItem[T], items []T) (snapshot []watchedItem[T], events []Event[T]) {
+	byKey := make(map[string]*watchedItem[T], len(previous))
+	for i := range previous {
+		byKey[previous[i].key] = &previous[i]
+	}
+
+	seen := make(map[string]bool, len(items))
+	for _, item := range items {
+		current := watchedItem[T]{key: watchKey(item), item: item, entity: watchEntity(item)}
+		snapshot = append(snapshot, current)
+		seen[current.key] = true
+
+		old := byKey[current.key]
+		switch {
+		case old == nil:
+			events = append(events, Event[T]{Type: EventAdded, Object: item})
+		case old.entity != current.entity:
+			events = append(events, Event[T]{Type: EventModified, Object: item, Previous: old.item})
+		}
+	}
+	for _, old := range previous {
+		if !seen[old.key] {
+			events = append(events, Event[T]{Type: EventDeleted, Object: old.item})
+		}
+	}
+	return
+}
+
+// watchKey returns the ID of a resource, or its name if it has no ID.
+func watchKey(item interface{}) string {
+	if id, ok := stringField(item, "ID"); ok {
+		return id
+	}
+	name, _ := stringField(item, "Name")
+	return name
+}
+
+// watchEntity returns the entity tag and status of a resource, which change when the resource is modified.
+func watchEntity(item interface{}) string {
+	entityTag, _ := stringField(item, "EntityTag")
+	status, _ := stringField(item, "Status")
+	return entityTag + "/" + status
+}
+
+// stringField returns the value of a *string field of an item, which is a struct, a pointer to a struct or an
+// interface like AllowedOutboundDestinationIntf.
+func stringField(item interface{}, name string) (string, bool) {
+	value := structValue(item)
+	if !value.IsValid() {
+		return "", false
+	}
+	field := value.FieldByName(name)
+	if field.Kind() != reflect.Ptr || field.IsNil() || field.Elem().Kind() != reflect.String {
+		return "", false
+	}
+	return field.Elem().String(), true
+}
+
+// structValue returns the struct value of an item, or an invalid value if the item is not a struct.
+func structValue(item interface{}) reflect.Value {
+	value := reflect.ValueOf(item)
+	for value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return reflect.Value{}
+		}
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Struct {
+		return reflect.Value{}
+	}
+	return value
+}
+
+func (_options *WatchOptions) headers() map[string]string {
+	if _options == nil {
+		return nil
+	}
+	return _options.Headers
+}
@@ -0,0 +1,159 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codeenginev2_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/IBM/code-engine-go-sdk/codeenginev2"
+	"github.com/IBM/code-engine-go-sdk/codeenginev2/codeenginev2test"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`CodeEngineV2 watches`, func() {
+	var server *codeenginev2test.Server
+	var codeEngineService *codeenginev2.CodeEngineV2
+	var projectID string
+	var ctx context.Context
+	var cancel context.CancelFunc
+	watchOptions := codeenginev2.NewWatchOptions().SetInterval(10 * time.Millisecond)
+
+	BeforeEach(func() {
+		server = codeenginev2test.NewServer()
+		var err error
+		codeEngineService, err = server.NewCodeEngineV2()
+		Expect(err).To(BeNil())
+		projectID = server.AddProject("my-project")
+		ctx, cancel = context.WithCancel(context.Background())
+	})
+	AfterEach(func() {
+		cancel()
+		server.Close()
+	})
+
+	Describe(`WatchApps(ctx, projectID, watchOptions)`, func() {
+		It(`Sends added, modified and deleted events until the context is cancelled`, func() {
+			_, _, err := codeEngineService.CreateApp(codeEngineService.NewCreateAppOptions(projectID, "icr.io/codeengine/helloworld", "existing-app"))
+			Expect(err).To(BeNil())
+
+			events := codeEngineService.WatchApps(ctx, projectID, watchOptions)
+			var event codeenginev2.Event[codeenginev2.App]
+			Eventually(events).Should(Receive(&event))
+			Expect(event.Type).To(Equal(codeenginev2.EventAdded))
+			Expect(*event.Object.Name).To(Equal("existing-app"))
+
+			_, _, err = codeEngineService.CreateApp(codeEngineService.NewCreateAppOptions(projectID, "icr.io/codeengine/helloworld", "new-app"))
+			Expect(err).To(BeNil())
+			Eventually(events).Should(Receive(&event))
+			Expect(event.Type).To(Equal(codeenginev2.EventAdded))
+			Expect(*event.Object.Name).To(Equal("new-app"))
+
+			Expect(server.ModifyResource(projectID, "apps", "existing-app", map[string]interface{}{"status": "failed"})).To(BeTrue())
+			Eventually(events).Should(Receive(&event))
+			Expect(event.Type).To(Equal(codeenginev2.EventModified))
+			Expect(*event.Object.Status).To(Equal(codeenginev2.App_Status_Failed))
+			Expect(*event.Previous.Status).ToNot(Equal(codeenginev2.App_Status_Failed))
+
+			_, err = codeEngineService.DeleteApp(codeEngineService.NewDeleteAppOptions(projectID, "new-app"))
+			Expect(err).To(BeNil())
+			Eventually(events).Should(Receive(&event))
+			Expect(event.Type).To(Equal(codeenginev2.EventDeleted))
+			Expect(*event.Object.Name).To(Equal("new-app"))
+
+			Consistently(events, 50*time.Millisecond).ShouldNot(Receive())
+			cancel()
+			Eventually(events).Should(BeClosed())
+		})
+		It(`Sends an error event and continues if the apps cannot be listed`, func() {
+			server.FailNext(http.MethodGet, "/projects/"+projectID+"/apps", http.StatusInternalServerError, nil)
+			_, _, err := codeEngineService.CreateApp(codeEngineService.NewCreateAppOptions(projectID, "icr.io/codeengine/helloworld", "my-app"))
+			Expect(err).To(BeNil())
+
+			events := codeEngineService.WatchApps(ctx, projectID, watchOptions)
+			var event codeenginev2.Event[codeenginev2.App]
+			Eventually(events).Should(Receive(&event))
+			Expect(event.Type).To(Equal(codeenginev2.EventError))
+			apiErr, ok := codeenginev2.AsAPIError(event.Err)
+			Expect(ok).To(BeTrue())
+			Expect(apiErr.StatusCode).To(Equal(http.StatusInternalServerError))
+
+			Eventually(events).Should(Receive(&event))
+			Expect(event.Type).To(Equal(codeenginev2.EventAdded))
+			Expect(*event.Object.Name).To(Equal("my-app"))
+		})
+	})
+
+	Describe(`WatchJobRuns(ctx, projectID, watchOptions)`, func() {
+		It(`Sends a modified event when the status of a job run changes`, func() {
+			_, _, err := codeEngineService.CreateJobRun(codeEngineService.NewCreateJobRunOptions(projectID).
+				SetName("my-run").SetImageReference("icr.io/codeengine/helloworld"))
+			Expect(err).To(BeNil())
+
+			events := codeEngineService.WatchJobRuns(ctx, projectID, watchOptions)
+			var event codeenginev2.Event[codeenginev2.JobRun]
+			Eventually(events).Should(Receive(&event))
+			Expect(event.Type).To(Equal(codeenginev2.EventAdded))
+
+			Expect(server.ModifyResource(projectID, "job_runs", "my-run", map[string]interface{}{"status": "failed"})).To(BeTrue())
+			Eventually(events).Should(Receive(&event))
+			Expect(event.Type).To(Equal(codeenginev2.EventModified))
+			Expect(*event.Object.Status).To(Equal(codeenginev2.JobRun_Status_Failed))
+		})
+	})
+
+	Describe(`Watch(ctx, list, watchOptions)`, func() {
+		It(`Identifies resources without ID by their name`, func() {
+			var mutex sync.Mutex
+			destinations := []codeenginev2.AllowedOutboundDestinationIntf{
+				&codeenginev2.AllowedOutboundDestination{Name: core.StringPtr("a"), EntityTag: core.StringPtr("1")},
+			}
+			list := func(context.Context) ([]codeenginev2.AllowedOutboundDestinationIntf, error) {
+				mutex.Lock()
+				defer mutex.Unlock()
+				return destinations, nil
+			}
+
+			events := codeenginev2.Watch(ctx, list, watchOptions)
+			var event codeenginev2.Event[codeenginev2.AllowedOutboundDestinationIntf]
+			Eventually(events).Should(Receive(&event))
+			Expect(event.Type).To(Equal(codeenginev2.EventAdded))
+
+			mutex.Lock()
+			destinations = []codeenginev2.AllowedOutboundDestinationIntf{
+				&codeenginev2.AllowedOutboundDestination{Name: core.StringPtr("a"), EntityTag: core.StringPtr("2")},
+			}
+			mutex.Unlock()
+			Eventually(events).Should(Receive(&event))
+			Expect(event.Type).To(Equal(codeenginev2.EventModified))
+			Expect(*event.Previous.(*codeenginev2.AllowedOutboundDestination).EntityTag).To(Equal("1"))
+		})
+		It(`Closes the channel when the context is cancelled while an event is pending`, func() {
+			list := func(context.Context) ([]codeenginev2.App, error) {
+				return nil, errors.New("unavailable")
+			}
+			events := codeenginev2.Watch(ctx, list, watchOptions)
+			cancel()
+			Eventually(events).Should(BeClosed())
+		})
+	})
+})
@@ -18,12 +18,15 @@ package common
 
 import (
 	"fmt"
+	"net/http"
 	"runtime"
+	"strings"
 )
 
 const (
-	sdkName             = "code-engine-go-sdk"
-	headerNameUserAgent = "User-Agent"
+	sdkName                = "code-engine-go-sdk"
+	headerNameUserAgent    = "User-Agent"
+	headerNameSdkAnalytics = "X-IBMCloud-SDK-Analytics"
 )
 
 // GetSdkHeaders - returns the set of SDK-specific headers to be included in an outgoing request.
@@ -65,10 +68,30 @@ func GetSdkHeaders(serviceName string, serviceVersion string, operationId string
 	sdkHeaders := make(map[string]string)
 
 	sdkHeaders[headerNameUserAgent] = GetUserAgentInfo()
+	sdkHeaders[headerNameSdkAnalytics] = fmt.Sprintf("service_name=%s;service_version=%s;operation_id=%s", serviceName, serviceVersion, operationId)
 
 	return sdkHeaders
 }
 
+// GetOperationId - returns the operationId that GetSdkHeaders has added to the headers of a request, or an empty
+// string if the request has not been sent by a generated service method. The request builder of the core adds the
+// headers under their names as is, so the name is looked up as is before it is looked up in canonical form.
+func GetOperationId(headers http.Header) string {
+	values, found := headers[headerNameSdkAnalytics]
+	if !found {
+		values = headers.Values(headerNameSdkAnalytics)
+	}
+	if len(values) == 0 {
+		return ""
+	}
+	for _, property := range strings.Split(values[0], ";") {
+		if operationId, found := strings.CutPrefix(property, "operation_id="); found {
+			return operationId
+		}
+	}
+	return ""
+}
+
 var userAgent string = fmt.Sprintf("%s/%s %s", sdkName, Version, GetSystemInfo())
 
 func GetUserAgentInfo() string {
@@ -18,6 +18,7 @@ package common
 
 import (
 	"github.com/stretchr/testify/assert"
+	"net/http"
 	"strings"
 	"testing"
 )
@@ -41,3 +42,15 @@ func TestGetSdkHeaders(t *testing.T) {
 	assert.True(t, foundIt)
 	t.Logf("user agent: %s\n", headers[headerNameUserAgent])
 }
+
+func TestGetOperationId(t *testing.T) {
+	headers := http.Header{}
+	canonicalHeaders := http.Header{}
+	for name, value := range GetSdkHeaders("myService", "v123", "myOperation") {
+		headers[name] = []string{value}
+		canonicalHeaders.Set(name, value)
+	}
+	assert.Equal(t, "myOperation", GetOperationId(headers))
+	assert.Equal(t, "myOperation", GetOperationId(canonicalHeaders))
+	assert.Equal(t, "", GetOperationId(http.Header{}))
+}
@@ -0,0 +1,314 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"maps"
+	"mime"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+)
+
+// RedactedValue replaces the sensitive values of the requests and responses that are logged by a LoggingTransport.
+const RedactedValue = "[REDACTED]"
+
+// DefaultMaxLoggedBodySize is the default number of bytes of a body that a LoggingTransport logs.
+const DefaultMaxLoggedBodySize = 4096
+
+// maxRedactedBodySize is the size of the largest body that a LoggingTransport reads to redact and log it.
+const maxRedactedBodySize = 1 << 20
+
+// sensitiveHeaders are the lower-case names of the headers whose values are always redacted.
+var sensitiveHeaders = []string{"authorization", "proxy-authorization", "cookie", "set-cookie"}
+
+// sensitiveHeaderFragments are the fragments of the lower-case names of headers whose values are always redacted, e.g.
+// the `Refresh-Token` and `X-Delegated-Refresh-Token` headers of the kubeconfig operations.
+var sensitiveHeaderFragments = []string{"token", "password", "secret", "apikey", "api-key"}
+
+// sensitiveFields are the names of the JSON fields whose values are always redacted, e.g. the TLS key of a TLS secret
+// or the HMAC keys of an HMAC secret.
+var sensitiveFields = []string{"tls_key", "ssh_key", "access_key_id", "secret_access_key", "apikey", "api_key"}
+
+// sensitiveFieldFragments are the fragments of the lower-case names of JSON fields whose values are always redacted.
+// Fields that hold the name of a secret, like `image_secret`, are kept.
+var sensitiveFieldFragments = []string{"token", "password", "private_key"}
+
+// LoggingTransport : An http.RoundTripper that logs the requests and responses of a service with log/slog
+// Each request is logged with its method, path, operation ID and headers before it is sent, and each response with
+// its status code and duration, or the error of the request. The JSON bodies are logged if LogBodies is set. The
+// values of sensitive headers and JSON fields are replaced by RedactedValue, see RedactHeaders and RedactBody, and
+// bodies that are not JSON, e.g. kubeconfig files or source archives, are never logged.
+type LoggingTransport struct {
+	// The logger of the requests. Defaults to slog.Default().
+	Logger *slog.Logger
+
+	// The level of the log records. Defaults to slog.LevelDebug.
+	Level slog.Leveler
+
+	// Whether to log the JSON bodies of the requests and responses.
+	LogBodies bool
+
+	// The number of bytes of a redacted body that are logged. Defaults to DefaultMaxLoggedBodySize.
+	MaxBodySize int
+
+	// Returns the operation ID of a request, e.g. `ListApps`, or an empty string if the request does not belong to an
+	// operation.
+	Operation func(req *http.Request) string
+
+	// The transport that sends the requests. Defaults to http.DefaultTransport.
+	Next http.RoundTripper
+}
+
+// RoundTrip logs the request, sends it with the next transport and logs its response.
+func (transport *LoggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	next := transport.Next
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	logger := transport.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+	var level slog.Level = slog.LevelDebug
+	if transport.Level != nil {
+		level = transport.Level.Level()
+	}
+	ctx := req.Context()
+	if !logger.Enabled(ctx, level) {
+		return next.RoundTrip(req)
+	}
+
+	attrs := []slog.Attr{slog.String("method", req.Method), slog.String("path", req.URL.Path)}
+	if transport.Operation != nil {
+		if operation := transport.Operation(req); operation != "" {
+			attrs = append(attrs, slog.String("operation", operation))
+		}
+	}
+	requestAttrs := append(slices.Clip(attrs), headersAttr(req.Header))
+	if transport.LogBodies {
+		var body string
+		var ok bool
+		req, body, ok = transport.requestBody(req)
+		if ok {
+			requestAttrs = append(requestAttrs, slog.String("body", body))
+		}
+	}
+	logger.LogAttrs(ctx, level, "Code Engine API request", requestAttrs...)
+
+	start := time.Now()
+	resp, err := next.RoundTrip(req)
+	attrs = append(attrs, slog.Duration("duration", time.Since(start)))
+	if err != nil {
+		logger.LogAttrs(ctx, level, "Code Engine API request failed", append(attrs, slog.Any("error", err))...)
+		return resp, err
+	}
+
+	attrs = append(attrs, slog.Int("status", resp.StatusCode), headersAttr(resp.Header))
+	if transport.LogBodies {
+		if body, ok := transport.responseBody(req.URL.Path, resp); ok {
+			attrs = append(attrs, slog.String("body", body))
+		}
+	}
+	logger.LogAttrs(ctx, level, "Code Engine API response", attrs...)
+	return resp, nil
+}
+
+// requestBody returns the redacted JSON body of a request. The body is read from a copy of the request if possible,
+// or else from the request, which is then replaced by a shallow copy with a new body.
+func (transport *LoggingTransport) requestBody(req *http.Request) (*http.Request, string, bool) {
+	if req.Body == nil || req.Body == http.NoBody || !isJSON(req.Header.Get("Content-Type")) {
+		return req, "", false
+	}
+	var data []byte
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return req, "", false
+		}
+		data, err = io.ReadAll(io.LimitReader(body, maxRedactedBodySize+1))
+		body.Close()
+		if err != nil {
+			return req, "", false
+		}
+	} else {
+		var err error
+		data, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		copied := *req
+		copied.Body = io.NopCloser(bytes.NewReader(data))
+		req = &copied
+		if err != nil {
+			return req, "", false
+		}
+	}
+	body, ok := transport.formatBody(req.URL.Path, data)
+	return req, body, ok
+}
+
+// responseBody returns the redacted JSON body of a response. The body of the response is replaced by a body that
+// returns the bytes that have been read, followed by the rest of the original body.
+func (transport *LoggingTransport) responseBody(path string, resp *http.Response) (string, bool) {
+	if resp.Body == nil || resp.Body == http.NoBody || !isJSON(resp.Header.Get("Content-Type")) {
+		return "", false
+	}
+	original := resp.Body
+	data, err := io.ReadAll(io.LimitReader(original, maxRedactedBodySize+1))
+	resp.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(data), original), original}
+	if err != nil {
+		return "", false
+	}
+	return transport.formatBody(path, data)
+}
+
+// formatBody returns the redacted body, truncated to the maximum body size, or false if the body is too large or not
+// valid JSON.
+func (transport *LoggingTransport) formatBody(path string, data []byte) (string, bool) {
+	if len(data) > maxRedactedBodySize {
+		return "", false
+	}
+	redacted, ok := RedactBody(path, data)
+	if !ok {
+		return "", false
+	}
+	maxBodySize := transport.MaxBodySize
+	if maxBodySize <= 0 {
+		maxBodySize = DefaultMaxLoggedBodySize
+	}
+	if len(redacted) > maxBodySize {
+		return string(redacted[:maxBodySize]) + "...", true
+	}
+	return string(redacted), true
+}
+
+// RedactHeaders returns a copy of the headers in which the values of sensitive headers are replaced by RedactedValue.
+// Sensitive headers are the authorization and cookie headers, and the headers whose name contains `token`, `password`
+// `secret` or `apikey`, like the `Refresh-Token` and `X-Delegated-Refresh-Token` headers of the kubeconfig operations.
+func RedactHeaders(header http.Header) http.Header {
+	redacted := header.Clone()
+	for name, values := range redacted {
+		lowerName := strings.ToLower(name)
+		if slices.Contains(sensitiveHeaders, lowerName) || containsAny(lowerName, sensitiveHeaderFragments) {
+			redacted[name] = slices.Repeat([]string{RedactedValue}, len(values))
+		}
+	}
+	return redacted
+}
+
+// RedactBody returns the JSON body of a request or response of the path in which the values of sensitive fields are
+// replaced by RedactedValue, or false if the body is not valid JSON. Sensitive values are:
+//
+//   - the values of the `data` of secrets and persistent data stores; only the data of config maps is kept,
+//   - passwords, tokens, TLS and SSH keys, HMAC keys and API keys, and
+//   - the code of functions that is inlined as a data URL, e.g. a binary code bundle.
+func RedactBody(path string, body []byte) ([]byte, bool) {
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil || decoder.More() {
+		return nil, false
+	}
+	redactData := !strings.Contains(path, "/config_maps")
+	redacted, err := json.Marshal(redactValue(value, redactData))
+	if err != nil {
+		return nil, false
+	}
+	return redacted, true
+}
+
+// redactValue replaces the sensitive values of a decoded JSON value.
+func redactValue(value interface{}, redactData bool) interface{} {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		for name, field := range value {
+			lowerName := strings.ToLower(name)
+			switch {
+			case field == nil:
+			case lowerName == "data" && redactData:
+				value[name] = redactAll(field)
+			case lowerName == "code_reference":
+				if reference, ok := field.(string); ok && strings.HasPrefix(reference, "data:") {
+					value[name] = RedactedValue
+				}
+			case slices.Contains(sensitiveFields, lowerName) || containsAny(lowerName, sensitiveFieldFragments):
+				value[name] = redactAll(field)
+			default:
+				value[name] = redactValue(field, redactData)
+			}
+		}
+	case []interface{}:
+		for i, element := range value {
+			value[i] = redactValue(element, redactData)
+		}
+	}
+	return value
+}
+
+// redactAll replaces all values of a decoded JSON value and keeps the names of the fields of objects. Booleans, like
+// `run_compute_resource_token_enabled`, are kept.
+func redactAll(value interface{}) interface{} {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		for name, field := range value {
+			value[name] = redactAll(field)
+		}
+		return value
+	case []interface{}:
+		for i, element := range value {
+			value[i] = redactAll(element)
+		}
+		return value
+	case bool, nil:
+		return value
+	default:
+		return RedactedValue
+	}
+}
+
+// containsAny returns true if the name contains one of the fragments.
+func containsAny(name string, fragments []string) bool {
+	for _, fragment := range fragments {
+		if strings.Contains(name, fragment) {
+			return true
+		}
+	}
+	return false
+}
+
+// headersAttr returns the redacted headers as a group of attributes.
+func headersAttr(header http.Header) slog.Attr {
+	redacted := RedactHeaders(header)
+	attrs := make([]any, 0, len(redacted))
+	for _, name := range slices.Sorted(maps.Keys(redacted)) {
+		attrs = append(attrs, slog.String(name, strings.Join(redacted[name], ", ")))
+	}
+	return slog.Group("headers", attrs...)
+}
+
+// isJSON returns true if the content type is JSON, e.g. `application/json` or `application/merge-patch+json`.
+func isJSON(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	return err == nil && (mediaType == "application/json" || strings.HasSuffix(mediaType, "+json"))
+}
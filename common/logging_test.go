@@ -0,0 +1,66 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedactHeaders(t *testing.T) {
+	header := http.Header{
+		"Authorization":             {"Bearer my-token"},
+		"Refresh-Token":             {"my-refresh-token"},
+		"X-Delegated-Refresh-Token": {"my-delegated-refresh-token"},
+		"Content-Type":              {"application/json"},
+	}
+	redacted := RedactHeaders(header)
+	assert.Equal(t, []string{RedactedValue}, redacted["Authorization"])
+	assert.Equal(t, []string{RedactedValue}, redacted["Refresh-Token"])
+	assert.Equal(t, []string{RedactedValue}, redacted["X-Delegated-Refresh-Token"])
+	assert.Equal(t, []string{"application/json"}, redacted["Content-Type"])
+	assert.Equal(t, "Bearer my-token", header.Get("Authorization"))
+}
+
+func TestRedactBody(t *testing.T) {
+	secret := `{"name":"tls","format":"tls","data":{"tls_cert":"my-cert","tls_key":"my-key"},"secrets":[{"data":{"password":"hunter2"}}]}`
+	redacted, ok := RedactBody("/projects/1/secrets/tls", []byte(secret))
+	assert.True(t, ok)
+	assert.JSONEq(t, `{"name":"tls","format":"tls","data":{"tls_cert":"[REDACTED]","tls_key":"[REDACTED]"},"secrets":[{"data":{"password":"[REDACTED]"}}]}`, string(redacted))
+
+	configMap := `{"name":"settings","data":{"color":"blue"}}`
+	redacted, ok = RedactBody("/projects/1/config_maps", []byte(configMap))
+	assert.True(t, ok)
+	assert.JSONEq(t, configMap, string(redacted))
+
+	function := `{"name":"fn","code_binary":true,"code_reference":"data:application/zip;base64,UEsDBA==","image_secret":"registry","run_compute_resource_token_enabled":true}`
+	redacted, ok = RedactBody("/projects/1/functions", []byte(function))
+	assert.True(t, ok)
+	assert.JSONEq(t, `{"name":"fn","code_binary":true,"code_reference":"[REDACTED]","image_secret":"registry","run_compute_resource_token_enabled":true}`, string(redacted))
+
+	hmac := `{"access_key_id":"my-id","secret_access_key":"my-secret","password":"my-password","count":1}`
+	redacted, ok = RedactBody("/projects/1/persistent_data_stores", []byte(hmac))
+	assert.True(t, ok)
+	assert.JSONEq(t, `{"access_key_id":"[REDACTED]","secret_access_key":"[REDACTED]","password":"[REDACTED]","count":1}`, string(redacted))
+
+	_, ok = RedactBody("/projects/1/secrets", []byte(`{"data":`))
+	assert.False(t, ok)
+	_, ok = RedactBody("/projects/1/secrets", []byte(`{} {"data":"x"}`))
+	assert.False(t, ok)
+}
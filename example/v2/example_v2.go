@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -90,38 +91,16 @@ func main() {
 	}
 	fmt.Printf("Found %d projects.\n", len(listResult.Projects))
 
-	// Create a new Code Engine project using the Code Engine Client
+	// Create a new Code Engine project using the Code Engine Client and wait until it is active
 	projectName := "project-sdk-go-e2e--crud--" + time.Now().Format("060102-150405")
-	createdProject, _, err := codeEngineService.CreateProject(&codeenginev2.CreateProjectOptions{
-		Name: &projectName,
-	})
+	createdProject, err := codeEngineService.CreateProjectAndWait(context.Background(), codeEngineService.NewCreateProjectOptions(projectName),
+		codeenginev2.NewWaitOptions().SetInitialInterval(10*time.Second).SetTimeout(200*time.Second))
 	if err != nil {
-		fmt.Printf("CreateProject error: %s\n", err.Error())
+		fmt.Printf("CreateProjectAndWait error: %s\n", err.Error())
 		os.Exit(1)
 		return
 	}
-	fmt.Printf("Created projects '%s' (guid: '%s').\n", *createdProject.Name, *createdProject.ID)
-
-	//
-	// Assume that the project creation takes some time
-	for x := 0; x < 20; x++ {
-		// sleep for 10 seconds and then try to fetch the project
-		time.Sleep(10 * time.Second)
-
-		getProjectOptions := codeEngineService.NewGetProjectOptions(
-			*createdProject.ID,
-		)
-		obtainedProject, _, err := codeEngineService.GetProject(getProjectOptions)
-		if err != nil && !strings.Contains(err.Error(), "Project is not yet active") {
-			fmt.Printf("GetProject error: %s\n", err.Error())
-			os.Exit(1)
-			return
-		}
-		fmt.Printf("Obtained status of project '%s' (guid: '%s'): %s.\n", *obtainedProject.Name, *obtainedProject.ID, *obtainedProject.Status)
-		if *obtainedProject.Status == "active" {
-			break
-		}
-	}
+	fmt.Printf("Created project '%s' (guid: '%s'): %s.\n", *createdProject.Name, *createdProject.ID, *createdProject.Status)
 
 	// Create ssh secret
 	createSecretOpts := codeEngineService.NewCreateSecretOptions(
@@ -1042,13 +1021,24 @@ func main() {
 	}
 	fmt.Printf("Deleted job: '%d'\n", resp.StatusCode)
 
-	resp, err = codeEngineService.DeleteProject(deleteProjectOptions)
+	// Delete the project, wait until it is soft-deleted and purge its reclamation, so that its name can be reused
+	reclaimer, err := codeenginev2.NewResourceControllerReclaimer(codeenginev2.NewResourceControllerReclaimerOptions(authenticator).
+		SetURL(rcEndpoint))
+	if err != nil {
+		fmt.Printf("NewResourceControllerReclaimer error: %s\n", err.Error())
+		os.Exit(1)
+		return
+	}
+	_, err = codeEngineService.DeleteProjectAndWait(context.Background(), codeEngineService.NewDeleteProjectAndWaitOptions(*deleteProjectOptions.ID).
+		SetPurge(true).
+		SetReclaimer(reclaimer).
+		SetWaitOptions(codeenginev2.NewWaitOptions().SetInitialInterval(10*time.Second).SetTimeout(10*time.Minute)))
 	if err != nil {
-		fmt.Printf("DeleteProject error: %s (transaction-id: '%s')\n", err.Error(), resp.Headers.Get("X-Transaction-Id"))
+		fmt.Printf("DeleteProjectAndWait error: %s\n", err.Error())
 		os.Exit(1)
 		return
 	}
-	fmt.Printf("Deleted project: '%d'\n", resp.StatusCode)
+	fmt.Printf("Deleted and purged project: '%s'\n", *deleteProjectOptions.ID)
 
 	listResult, _, err = codeEngineService.ListProjects(&codeenginev2.ListProjectsOptions{})
 	if err != nil {
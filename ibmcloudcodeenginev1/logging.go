@@ -0,0 +1,114 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ibmcloudcodeenginev1
+
+import (
+	"log/slog"
+	"net/http"
+	"strings"
+
+	common "github.com/IBM/code-engine-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// LoggingOptions : The options of the structured logging of the requests of a service.
+type LoggingOptions struct {
+	// The logger of the requests. Defaults to slog.Default().
+	Logger *slog.Logger
+
+	// The level of the log records. Defaults to slog.LevelDebug.
+	Level slog.Leveler
+}
+
+// NewLoggingOptions : Instantiate LoggingOptions
+func NewLoggingOptions() *LoggingOptions {
+	return &LoggingOptions{}
+}
+
+// SetLogger : Allow user to set Logger
+func (_options *LoggingOptions) SetLogger(logger *slog.Logger) *LoggingOptions {
+	_options.Logger = logger
+	return _options
+}
+
+// SetLevel : Allow user to set Level
+func (_options *LoggingOptions) SetLevel(level slog.Leveler) *LoggingOptions {
+	_options.Level = level
+	return _options
+}
+
+// EnableLogging : Log the requests and responses of the service with log/slog
+// Each request is logged with its method, path, operation ID and headers, and its response with the status code and
+// duration. The values of the `Refresh-Token`, `X-Delegated-Refresh-Token` and authorization headers are redacted, see
+// common.RedactHeaders. The kubeconfig files of the responses are never logged. The logging is a layer of the
+// transport of the HTTP client of the service, so it must be enabled after the HTTP client has been configured, e.g.
+// with SetHTTPClient or DisableSSLVerification.
+func (ibmCloudCodeEngine *IbmCloudCodeEngineV1) EnableLogging(loggingOptions *LoggingOptions) {
+	if loggingOptions == nil {
+		loggingOptions = NewLoggingOptions()
+	}
+	client := ibmCloudCodeEngine.copyHTTPClient()
+	client.Transport = &common.LoggingTransport{
+		Logger:    loggingOptions.Logger,
+		Level:     loggingOptions.Level,
+		Operation: operation,
+		Next:      withoutLogging(client.Transport),
+	}
+}
+
+// DisableLogging : Stop logging the requests of the service
+func (ibmCloudCodeEngine *IbmCloudCodeEngineV1) DisableLogging() {
+	if client := ibmCloudCodeEngine.Service.GetHTTPClient(); client != nil {
+		if _, ok := client.Transport.(*common.LoggingTransport); ok {
+			client = ibmCloudCodeEngine.copyHTTPClient()
+			client.Transport = withoutLogging(client.Transport)
+		}
+	}
+}
+
+// copyHTTPClient sets a shallow copy of the HTTP client of the service as its client and returns the copy, so that the
+// logging transport is never installed on a client that is shared with other code, such as http.DefaultClient.
+func (ibmCloudCodeEngine *IbmCloudCodeEngineV1) copyHTTPClient() *http.Client {
+	client := core.DefaultHTTPClient()
+	if current := ibmCloudCodeEngine.Service.GetHTTPClient(); current != nil {
+		copied := *current
+		client = &copied
+	}
+	ibmCloudCodeEngine.Service.SetHTTPClient(client)
+	return client
+}
+
+// withoutLogging returns the transport that a logging transport wraps, or the transport if it does not log.
+func withoutLogging(transport http.RoundTripper) http.RoundTripper {
+	if logging, ok := transport.(*common.LoggingTransport); ok {
+		return logging.Next
+	}
+	return transport
+}
+
+// operation returns the operation ID of a request.
+func operation(req *http.Request) string {
+	switch {
+	case req.Method != http.MethodGet || !strings.HasSuffix(req.URL.Path, "/config"):
+		return ""
+	case strings.Contains(req.URL.Path, "/namespaces/"):
+		return "ListKubeconfig"
+	case strings.Contains(req.URL.Path, "/project/"):
+		return "GetKubeconfig"
+	}
+	return ""
+}
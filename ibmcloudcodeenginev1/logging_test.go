@@ -0,0 +1,88 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ibmcloudcodeenginev1_test
+
+import (
+	"bytes"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/code-engine-go-sdk/ibmcloudcodeenginev1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`IbmCloudCodeEngineV1 logging`, func() {
+	var testServer *httptest.Server
+	var ibmCloudCodeEngineService *ibmcloudcodeenginev1.IbmCloudCodeEngineV1
+	var output *bytes.Buffer
+
+	BeforeEach(func() {
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			res.Header().Set("Content-type", "text/plain")
+			res.WriteHeader(200)
+			fmt.Fprintf(res, "%s", `users: [{name: admin, user: {token: kubeconfig-token}}]`)
+		}))
+		var serviceErr error
+		ibmCloudCodeEngineService, serviceErr = ibmcloudcodeenginev1.NewIbmCloudCodeEngineV1(&ibmcloudcodeenginev1.IbmCloudCodeEngineV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		output = &bytes.Buffer{}
+		logger := slog.New(slog.NewTextHandler(output, &slog.HandlerOptions{Level: slog.LevelDebug}))
+		ibmCloudCodeEngineService.EnableLogging(ibmcloudcodeenginev1.NewLoggingOptions().SetLogger(logger))
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Redacts the refresh tokens of the kubeconfig operations`, func() {
+		_, _, err := ibmCloudCodeEngineService.ListKubeconfig(ibmCloudCodeEngineService.NewListKubeconfigOptions("my-refresh-token", "my-namespace"))
+		Expect(err).To(BeNil())
+		_, _, err = ibmCloudCodeEngineService.GetKubeconfig(ibmCloudCodeEngineService.NewGetKubeconfigOptions("my-delegated-refresh-token", "my-project"))
+		Expect(err).To(BeNil())
+
+		Expect(output.String()).To(ContainSubstring("operation=ListKubeconfig"))
+		Expect(output.String()).To(ContainSubstring("operation=GetKubeconfig"))
+		Expect(output.String()).To(ContainSubstring("headers.Refresh-Token=[REDACTED]"))
+		Expect(output.String()).To(ContainSubstring("headers.X-Delegated-Refresh-Token=[REDACTED]"))
+		Expect(output.String()).ToNot(ContainSubstring("my-refresh-token"))
+		Expect(output.String()).ToNot(ContainSubstring("my-delegated-refresh-token"))
+		Expect(output.String()).ToNot(ContainSubstring("kubeconfig-token"))
+	})
+	It(`Stops logging when logging is disabled`, func() {
+		ibmCloudCodeEngineService.DisableLogging()
+		_, _, err := ibmCloudCodeEngineService.ListKubeconfig(ibmCloudCodeEngineService.NewListKubeconfigOptions("my-refresh-token", "my-namespace"))
+		Expect(err).To(BeNil())
+		Expect(output.String()).To(BeEmpty())
+	})
+	It(`Does not modify an HTTP client that is shared with other code`, func() {
+		client := &http.Client{}
+		ibmCloudCodeEngineService.Service.SetHTTPClient(client)
+		ibmCloudCodeEngineService.EnableLogging(ibmcloudcodeenginev1.NewLoggingOptions())
+		Expect(client.Transport).To(BeNil())
+		Expect(ibmCloudCodeEngineService.Service.GetHTTPClient()).ToNot(BeIdenticalTo(client))
+
+		ibmCloudCodeEngineService.Service.SetHTTPClient(client)
+		ibmCloudCodeEngineService.DisableLogging()
+		Expect(ibmCloudCodeEngineService.Service.GetHTTPClient()).To(BeIdenticalTo(client))
+	})
+})